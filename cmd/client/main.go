@@ -38,7 +38,16 @@ func main() {
 	// Создание клиента сервиса, локального хранилища и экземпляра cli приложения
 	client := pb.NewGophKeeperServiceClient(conn)
 	cliStorage := storage.NewStorage()
+	cliStorage.SetIndexValues(cfg.SearchValues)
 	cliclient := cli.NewCli(client, context.Background(), cliStorage)
+	cliclient.SetBreachCheck(cfg.BreachData, cfg.BreachServer)
+
+	// Команда в аргументах выполняется без интерактивного меню, код завершения сообщает результат
+	if len(os.Args) > 1 {
+		code := cliclient.Run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr)
+		conn.Close()
+		os.Exit(code)
+	}
 
 	// Обработка сигналов для возможности выхода по запросу пользователя
 	sigCh := make(chan os.Signal, 1)
@@ -46,6 +55,7 @@ func main() {
 	go func() {
 		<-sigCh
 		// cliclient.Dump()
+		cliclient.RestoreTerminal()
 		fmt.Println("Выход из приложения")
 		os.Exit(0)
 	}()
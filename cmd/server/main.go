@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
@@ -30,7 +31,14 @@ func main() {
 		log.Fatalf("Storage creation failed %v", err)
 	}
 	// создаем сктруктуру управляющую бизнес логикой приложения
-	logic := goph.New(storage, cfg)
+	logic, err := goph.New(storage, cfg)
+	if err != nil {
+		log.Fatalf("Business logic creation failed %v", err)
+	}
+	// запускаем очистку корзин от данных старше срока хранения
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go logic.RunPurger(ctx)
 	// создаем обработчик grpc методов
 	server := ghandlers.New(logic, cfg)
 
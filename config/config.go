@@ -3,6 +3,7 @@ package config
 
 import (
 	"log"
+	"time"
 
 	"github.com/caarlos0/env/v6"
 )
@@ -12,12 +13,34 @@ type ServerConfig struct {
 	Port        string `env:"SERVER_PORT"`
 	Address     string `env:"SERVER_ADDRESS"`
 	DatabaseDSN string `env:"DATABASE_DSN"`
+	// Параметры Argon2id для хеширования паролей пользователей
+	Argon2Time    uint32 `env:"ARGON2_TIME" envDefault:"3"`
+	Argon2Memory  uint32 `env:"ARGON2_MEMORY" envDefault:"65536"` // КиБ
+	Argon2Threads uint8  `env:"ARGON2_THREADS" envDefault:"2"`
+	// Ключи подписи токенов в формате "kid1:hexkey1,kid2:hexkey2" и идентификатор активного ключа.
+	// Для ротации добавьте новый ключ и сделайте его активным, старый ключ удалите после истечения выданных им токенов.
+	TokenKeys       string        `env:"TOKEN_KEYS"`
+	TokenActiveKey  string        `env:"TOKEN_ACTIVE_KEY"`
+	AccessTokenTTL  time.Duration `env:"ACCESS_TOKEN_TTL" envDefault:"15m"`
+	RefreshTokenTTL time.Duration `env:"REFRESH_TOKEN_TTL" envDefault:"720h"`
+	// Срок хранения удаленных данных в корзине и интервал окончательной очистки корзин.
+	TrashRetention time.Duration `env:"TRASH_RETENTION" envDefault:"720h"`
+	PurgeInterval  time.Duration `env:"PURGE_INTERVAL" envDefault:"1h"`
+	// BreachData - база утекших паролей в формате HIBP "range" (папка файлов диапазонов или один файл)
+	// для метода CheckPasswordPrefix. Если не задана, метод недоступен.
+	BreachData string `env:"BREACH_DATA"`
 }
 
 // ClientConfig - конфигурация для клиента
 type ClientConfig struct {
 	ServerAddress string `env:"SERVER_ADDRESS"`
 	Port          string `env:"SERVER_PORT"`
+	// SearchValues - включает поиск по значениям данных, а не только по описаниям, именам файлов и ключам.
+	SearchValues bool `env:"SEARCH_INDEX_VALUES"`
+	// BreachData - локальная база утекших паролей в формате HIBP "range" для проверки паролей при добавлении и в audit.
+	// BreachServer - проверять пароли по базе сервера, передавая ему только префиксы хешей.
+	BreachData   string `env:"BREACH_DATA"`
+	BreachServer bool   `env:"BREACH_CHECK_SERVER"`
 }
 
 // LoadFromEnv заполняет конфигурацию сервера из переменных окружения.
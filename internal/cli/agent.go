@@ -0,0 +1,522 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/bubu256/gophkeeper_pet/internal/proto/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// envAgentSocket - переменная окружения с путем к сокету агента, по умолчанию agent/agent.sock в директории приложения.
+const envAgentSocket = "GOPHKEEPER_AGENT_SOCK"
+
+const (
+	// defaultAgentTimeout - время простоя, после которого агент блокируется.
+	defaultAgentTimeout = 15 * time.Minute
+	// agentSyncInterval - период загрузки изменений с сервера в фоне.
+	agentSyncInterval = 30 * time.Second
+	// agentCallTimeout - время ожидания ответа агента, после которого команда выполняется без него.
+	agentCallTimeout = 2 * time.Second
+)
+
+// errAgentNotRunning возвращается командами управления агентом, если агент не запущен.
+var errAgentNotRunning = &commandError{code: ExitUnavailable, err: errors.New("агент не запущен")}
+
+// agentServer - фоновый агент: хранит расшифрованную локальную копию данных пользователя в памяти
+// и отдает ее запускам клиента через Unix-сокет, чтобы им не требовался мастер-пароль.
+// После timeout без запросов агент блокируется: забывает ключи и данные до разблокировки мастер-паролем.
+type agentServer struct {
+	pb.UnimplementedAgentServiceServer
+	// mu - Cli не безопасен для одновременного использования: вход, синхронизация и блокировка выполняются по очереди.
+	mu       sync.Mutex
+	cli      *Cli
+	username string
+	timeout  time.Duration
+	stop     func()
+	// syncNow - запрос внеочередной синхронизации, например после разблокировки.
+	syncNow chan struct{}
+
+	// viewMu защищает view, timer и lockAt. Запросы данных берут только viewMu,
+	// поэтому не ждут синхронизацию, которая выполняется под mu.
+	viewMu sync.Mutex
+	// view - данные для ответов на запросы, nil у заблокированного агента.
+	view   *agentView
+	timer  *time.Timer
+	lockAt time.Time
+}
+
+// agentView - копия локальных данных, которую агент отдает запросам. Заменяется целиком после входа
+// и каждой синхронизации и не изменяется, поэтому ее можно читать без блокировки Cli.
+type agentView struct {
+	info    []*pb.InfoCell
+	cells   map[int64]*pb.MemoryCell
+	folders []*pb.Folder
+	offline bool
+}
+
+// agentSocketPath - возвращает путь к сокету агента.
+func (c *Cli) agentSocketPath() string {
+	if path := os.Getenv(envAgentSocket); path != "" {
+		return path
+	}
+	return filepath.Join(c.storage.Dir(), "agent", "agent.sock")
+}
+
+// listenAgent - создает сокет агента, доступный только владельцу. Права уже существующей директории
+// MkdirAll не меняет, а GOPHKEEPER_AGENT_SOCK может указывать в общую директорию вроде /tmp,
+// поэтому сокет создается сразу с правами 0600 (см. listenUnix), а не получает их после создания.
+func listenAgent(path string) (net.Listener, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("не удалось создать директорию сокета: %w", err)
+	}
+	if conn, err := net.DialTimeout("unix", path, time.Second); err == nil {
+		conn.Close()
+		return nil, errors.New("агент уже запущен: " + path)
+	}
+	// сокет, оставшийся после аварийного завершения агента
+	os.Remove(path)
+
+	listener, err := listenUnix(path)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось создать сокет агента: %w", err)
+	}
+	if err := os.Chmod(path, 0600); err != nil {
+		listener.Close()
+		return nil, fmt.Errorf("не удалось установить права сокета агента: %w", err)
+	}
+	return listener, nil
+}
+
+// runAgent - команда agent: запускает агент или управляет запущенным агентом.
+func (c *Cli) runAgent(opts globalOptions, args []string) error {
+	fs := c.newFlagSet("agent")
+	timeout := fs.Duration("timeout", defaultAgentTimeout, "время простоя, после которого агент блокируется")
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 1 {
+		return usageError("лишние аргументы: %v", positional[1:])
+	}
+	action := "start"
+	if len(positional) == 1 {
+		action = positional[0]
+	}
+
+	switch action {
+	case "start":
+		if *timeout <= 0 {
+			return usageError("время простоя должно быть положительным")
+		}
+		return c.startAgent(opts, *timeout)
+	case "status":
+		return c.agentStatus()
+	case "lock":
+		return c.callAgent(func(ctx context.Context, agent pb.AgentServiceClient) error {
+			_, err := agent.Lock(ctx, &pb.AgentLockRequest{})
+			return err
+		}, "- Агент заблокирован.")
+	case "stop":
+		return c.callAgent(func(ctx context.Context, agent pb.AgentServiceClient) error {
+			_, err := agent.Stop(ctx, &pb.AgentStopRequest{})
+			return err
+		}, "- Агент остановлен.")
+	}
+	return usageError("неизвестное действие %q, доступны: start, status, lock, stop", action)
+}
+
+// startAgent - выполняет вход и обслуживает запросы к агенту до остановки командой agent stop или сигналом.
+func (c *Cli) startAgent(opts globalOptions, timeout time.Duration) error {
+	if err := c.authenticate(opts); err != nil {
+		return err
+	}
+	if !c.offline {
+		if err := c.synchronize(); err != nil {
+			fmt.Fprintln(c.messages, "- Не удалось синхронизировать данные:", err)
+		}
+	}
+
+	path := c.agentSocketPath()
+	listener, err := listenAgent(path)
+	if err != nil {
+		return err
+	}
+
+	server := grpc.NewServer()
+	agent := &agentServer{
+		cli:      c,
+		username: c.username,
+		timeout:  timeout,
+		stop:     server.GracefulStop,
+		syncNow:  make(chan struct{}, 1),
+	}
+	agent.viewMu.Lock()
+	agent.view = agent.snapshot()
+	agent.timer = time.AfterFunc(timeout, agent.autoLock)
+	agent.lockAt = time.Now().Add(timeout)
+	agent.viewMu.Unlock()
+	pb.RegisterAgentServiceServer(server, agent)
+
+	stopSync := make(chan struct{})
+	syncDone := make(chan struct{})
+	go func() {
+		agent.syncLoop(stopSync)
+		close(syncDone)
+	}()
+
+	ctx, stop := signal.NotifyContext(c.ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		server.GracefulStop()
+	}()
+
+	fmt.Fprintf(c.messages, "- Агент запущен, блокировка после %s простоя.\n", timeout)
+	fmt.Fprintf(c.stdout, "%s=%s\n", envAgentSocket, path)
+	err = server.Serve(listener)
+	close(stopSync)
+	<-syncDone
+
+	agent.hide()
+	agent.mu.Lock()
+	agent.forget()
+	agent.mu.Unlock()
+	return err
+}
+
+// agentStatus - выводит пользователя и состояние агента.
+func (c *Cli) agentStatus() error {
+	var response *pb.AgentStatusResponse
+	err := c.callAgent(func(ctx context.Context, agent pb.AgentServiceClient) error {
+		var err error
+		response, err = agent.Status(ctx, &pb.AgentStatusRequest{})
+		return err
+	}, "")
+	if err != nil {
+		return err
+	}
+
+	doc := agentDocument{User: response.Username, Locked: response.Locked, Offline: response.Offline}
+	if !response.Locked {
+		doc.LockAt = response.LockAt.AsTime().Local().Format(time.RFC3339)
+	}
+	if c.structured() {
+		return c.writeDocument(doc)
+	}
+	fmt.Fprintf(c.stdout, "user\t%s\nlocked\t%t\noffline\t%t\n", doc.User, doc.Locked, doc.Offline)
+	if doc.LockAt != "" {
+		fmt.Fprintf(c.stdout, "lock-at\t%s\n", doc.LockAt)
+	}
+	return nil
+}
+
+// callAgent - выполняет запрос управления агентом и выводит сообщение об успехе.
+func (c *Cli) callAgent(call func(ctx context.Context, agent pb.AgentServiceClient) error, message string) error {
+	conn, err := c.dialAgent()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(c.ctx, agentCallTimeout)
+	defer cancel()
+	if err := call(ctx, pb.NewAgentServiceClient(conn)); err != nil {
+		if isUnavailable(err) {
+			return errAgentNotRunning
+		}
+		return err
+	}
+	if message != "" {
+		fmt.Fprintln(c.messages, message)
+	}
+	return nil
+}
+
+// dialAgent - подключается к сокету агента.
+func (c *Cli) dialAgent() (*grpc.ClientConn, error) {
+	path := c.agentSocketPath()
+	if _, err := os.Stat(path); err != nil {
+		return nil, errAgentNotRunning
+	}
+	conn, err := grpc.Dial("unix://"+path, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, errAgentNotRunning
+	}
+	return conn, nil
+}
+
+// agentList - получает список данных и папки у агента. Возвращает false, если агент не запущен,
+// заблокирован или хранит данные другого пользователя: тогда команда выполняется без агента.
+func (c *Cli) agentList(opts globalOptions) (*pb.AgentListResponse, bool) {
+	var response *pb.AgentListResponse
+	ok := c.askAgent(func(ctx context.Context, agent pb.AgentServiceClient) (err error) {
+		response, err = agent.List(ctx, &pb.AgentListRequest{Username: opts.user})
+		return err
+	})
+	return response, ok
+}
+
+// agentGet - получает данные у агента. Возвращает false, если команда выполняется без агента,
+// и errDataNotFound, если у агента нет данных с указанным InfoID.
+func (c *Cli) agentGet(opts globalOptions, infoID int64) (*pb.AgentGetResponse, bool, error) {
+	var response *pb.AgentGetResponse
+	var notFound bool
+	ok := c.askAgent(func(ctx context.Context, agent pb.AgentServiceClient) (err error) {
+		response, err = agent.Get(ctx, &pb.AgentGetRequest{Username: opts.user, Id: infoID})
+		if status.Code(err) == codes.NotFound {
+			notFound = true
+			return nil
+		}
+		return err
+	})
+	if ok && notFound {
+		return nil, true, errDataNotFound
+	}
+	return response, ok, nil
+}
+
+// askAgent - выполняет запрос к агенту, если он запущен. Заблокированный агент будет разблокирован
+// мастер-паролем, который команда запросит для работы без агента.
+func (c *Cli) askAgent(call func(ctx context.Context, agent pb.AgentServiceClient) error) bool {
+	conn, err := c.dialAgent()
+	if err != nil {
+		return false
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(c.ctx, agentCallTimeout)
+	defer cancel()
+	err = call(ctx, pb.NewAgentServiceClient(conn))
+	if status.Code(err) == codes.FailedPrecondition {
+		c.agentLocked = true
+	}
+	return err == nil
+}
+
+// unlockAgent - разблокирует агент мастер-паролем, введенным для команды.
+func (c *Cli) unlockAgent(username, password string) {
+	c.agentLocked = false
+	c.callAgent(func(ctx context.Context, agent pb.AgentServiceClient) error {
+		_, err := agent.Unlock(ctx, &pb.AgentUnlockRequest{Username: username, Password: password})
+		return err
+	}, "- Агент разблокирован.")
+}
+
+// Status - возвращает пользователя и состояние агента.
+func (a *agentServer) Status(ctx context.Context, request *pb.AgentStatusRequest) (*pb.AgentStatusResponse, error) {
+	a.viewMu.Lock()
+	defer a.viewMu.Unlock()
+
+	response := &pb.AgentStatusResponse{Username: a.username, Locked: a.view == nil}
+	if a.view != nil {
+		response.Offline = a.view.offline
+		response.LockAt = timestamppb.New(a.lockAt)
+	}
+	return response, nil
+}
+
+// List - возвращает информацию о данных и папки пользователя из локальной копии.
+func (a *agentServer) List(ctx context.Context, request *pb.AgentListRequest) (*pb.AgentListResponse, error) {
+	view, err := a.check(request.Username)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AgentListResponse{Info: view.info, Folders: view.folders}, nil
+}
+
+// Get - возвращает расшифрованные данные по InfoID из локальной копии.
+func (a *agentServer) Get(ctx context.Context, request *pb.AgentGetRequest) (*pb.AgentGetResponse, error) {
+	view, err := a.check(request.Username)
+	if err != nil {
+		return nil, err
+	}
+	cell, ok := view.cells[request.Id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "данные не найдены")
+	}
+	return &pb.AgentGetResponse{Data: cell, Folders: view.folders}, nil
+}
+
+// Unlock - разблокирует агент мастер-паролем пользователя агента.
+func (a *agentServer) Unlock(ctx context.Context, request *pb.AgentUnlockRequest) (*pb.AgentUnlockResponse, error) {
+	if request.Username != a.username {
+		return nil, status.Error(codes.PermissionDenied, "агент запущен для другого пользователя")
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if !a.unlocked() {
+		if err := a.cli.login(a.username, request.Password); err != nil {
+			if _, ok := status.FromError(err); ok {
+				return nil, err
+			}
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		fmt.Fprintln(a.cli.messages, "- Агент разблокирован.")
+	}
+
+	a.viewMu.Lock()
+	defer a.viewMu.Unlock()
+	if a.view == nil {
+		// данные загружены из локальной копии, изменения с сервера загрузятся в фоне
+		a.view = a.snapshot()
+		select {
+		case a.syncNow <- struct{}{}:
+		default:
+		}
+	}
+	a.touch()
+	return &pb.AgentUnlockResponse{}, nil
+}
+
+// Lock - блокирует агент.
+func (a *agentServer) Lock(ctx context.Context, request *pb.AgentLockRequest) (*pb.AgentLockResponse, error) {
+	a.lock()
+	return &pb.AgentLockResponse{}, nil
+}
+
+// Stop - блокирует и останавливает агент.
+func (a *agentServer) Stop(ctx context.Context, request *pb.AgentStopRequest) (*pb.AgentStopResponse, error) {
+	a.lock()
+	// остановка сервера ждет завершения запросов, в том числе этого
+	go a.stop()
+	return &pb.AgentStopResponse{}, nil
+}
+
+// unlocked - сообщает, что ключи и данные пользователя загружены. Вызывается под mu.
+func (a *agentServer) unlocked() bool {
+	return a.cli.vaultKey != nil
+}
+
+// check - проверяет, что агент разблокирован и запрос относится к его пользователю, откладывает блокировку
+// и возвращает данные для ответа.
+func (a *agentServer) check(username string) (*agentView, error) {
+	if username != "" && username != a.username {
+		return nil, status.Error(codes.PermissionDenied, "агент запущен для другого пользователя")
+	}
+	a.viewMu.Lock()
+	defer a.viewMu.Unlock()
+	if a.view == nil {
+		return nil, status.Error(codes.FailedPrecondition, "агент заблокирован")
+	}
+	a.touch()
+	return a.view, nil
+}
+
+// touch - откладывает автоматическую блокировку: время простоя отсчитывается заново. Вызывается под viewMu.
+func (a *agentServer) touch() {
+	a.lockAt = time.Now().Add(a.timeout)
+	a.timer.Reset(a.timeout)
+}
+
+// snapshot - возвращает копию локальных данных для ответов на запросы. Вызывается под mu
+// или до запуска фоновой синхронизации.
+func (a *agentServer) snapshot() *agentView {
+	storage := a.cli.storage
+	view := &agentView{
+		cells:   make(map[int64]*pb.MemoryCell),
+		folders: make([]*pb.Folder, 0, len(storage.Folders())),
+		offline: a.cli.offline,
+	}
+	for _, cell := range storage.GetData() {
+		cell = proto.Clone(cell).(*pb.MemoryCell)
+		view.cells[cell.Info.Id] = cell
+		view.info = append(view.info, cell.Info)
+	}
+	for _, folder := range storage.Folders() {
+		view.folders = append(view.folders, proto.Clone(folder).(*pb.Folder))
+	}
+	return view
+}
+
+// syncLoop - загружает изменения с сервера каждые agentSyncInterval и по запросу syncNow, пока не закрыт канал stop.
+// Запросы тем временем отвечают по предыдущей копии данных.
+func (a *agentServer) syncLoop(stop <-chan struct{}) {
+	ticker := time.NewTicker(agentSyncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		case <-a.syncNow:
+		}
+		a.refresh()
+	}
+}
+
+// refresh - загружает изменения с сервера и заменяет данные для ответов на запросы.
+// Если сервер недоступен, агент продолжает отвечать по локальной копии.
+func (a *agentServer) refresh() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if !a.unlocked() {
+		return
+	}
+	a.cli.refreshSession()
+	if err := a.cli.synchronize(); err != nil {
+		log.Printf("agent: failed to sync: %v", err)
+	}
+	view := a.snapshot()
+
+	a.viewMu.Lock()
+	defer a.viewMu.Unlock()
+	// агент, заблокированный во время синхронизации, данные не отдает
+	if a.view != nil {
+		a.view = view
+	}
+}
+
+// lock - сразу перестает отдавать данные, затем забывает ключи. Синхронизация, которая выполняется
+// в этот момент, не задерживает прекращение ответов.
+func (a *agentServer) lock() {
+	a.hide()
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.forget()
+}
+
+// hide - перестает отдавать данные запросам и отменяет автоматическую блокировку.
+func (a *agentServer) hide() {
+	a.viewMu.Lock()
+	defer a.viewMu.Unlock()
+	a.view = nil
+	a.timer.Stop()
+}
+
+// autoLock - блокирует агент по истечении времени простоя.
+func (a *agentServer) autoLock() {
+	a.viewMu.Lock()
+	// таймер сработал одновременно с запросом, отложившим блокировку
+	idle := !time.Now().Before(a.lockAt)
+	a.viewMu.Unlock()
+	if idle {
+		a.lock()
+	}
+}
+
+// forget - сохраняет локальную копию и забывает ключи и данные пользователя. Сохраненная сессия остается,
+// поэтому разблокировка не требует входа на сервере. Вызывается под mu.
+func (a *agentServer) forget() {
+	if !a.unlocked() {
+		return
+	}
+	a.cli.saveLocal()
+	a.cli.forgetUser()
+	fmt.Fprintln(a.cli.messages, "- Агент заблокирован.")
+}
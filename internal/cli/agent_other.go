@@ -0,0 +1,10 @@
+//go:build !unix
+
+package cli
+
+import "net"
+
+// listenUnix - создает Unix-сокет. Umask на этих системах нет, права сокета устанавливает listenAgent.
+func listenUnix(path string) (net.Listener, error) {
+	return net.Listen("unix", path)
+}
@@ -0,0 +1,101 @@
+package cli_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bubu256/gophkeeper_pet/internal/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startAgent - запускает агент в фоне и ждет, пока он начнет принимать запросы.
+// Возвращает канал, в который будет записан код завершения агента.
+func startAgent(t *testing.T, server *fakeServer, timeout string) <-chan int {
+	done := make(chan int, 1)
+	go func() {
+		code, _, _ := runCommand(server, testPassword+"\n", "--user", testUser, "--password-stdin", "agent", "--timeout", timeout)
+		done <- code
+	}()
+	require.Eventually(t, func() bool {
+		code, _, _ := runCommand(server, "", "agent", "status")
+		return code == cli.ExitOK
+	}, 10*time.Second, 20*time.Millisecond)
+	return done
+}
+
+func TestRun_Agent(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	socket := filepath.Join(t.TempDir(), "agent.sock")
+	t.Setenv("GOPHKEEPER_AGENT_SOCK", socket)
+	server := newFakeServer()
+	login := []string{"--user", testUser, "--password-stdin"}
+
+	code, _, _ := runCommand(server, "", "agent", "status")
+	assert.Equal(t, cli.ExitUnavailable, code)
+
+	code, _, stderr := runCommand(server, testPassword+"\nsecret\n", append(login, "add", "login", "--description", "Почта", "--url", "mail.example.com", "--secret-stdin")...)
+	require.Equal(t, cli.ExitOK, code, stderr)
+
+	done := startAgent(t, server, "1h")
+	info, err := os.Stat(socket)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	// агент отвечает без мастер-пароля
+	code, stdout, stderr := runCommand(server, "", "list")
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.Equal(t, "1\tlogin\tПочта\n", stdout)
+	code, stdout, _ = runCommand(server, "", "get", "1", "--field", "password")
+	assert.Equal(t, cli.ExitOK, code)
+	assert.Equal(t, "secret\n", stdout)
+	code, _, _ = runCommand(server, "", "get", "2")
+	assert.Equal(t, cli.ExitNotFound, code)
+
+	// данные другого пользователя агент не отдает
+	code, _, _ = runCommand(server, "", "--user", "bob", "list")
+	assert.Equal(t, cli.ExitUsage, code)
+
+	// заблокированный агент требует мастер-пароль и разблокируется им
+	code, _, _ = runCommand(server, "", "agent", "lock")
+	require.Equal(t, cli.ExitOK, code)
+	code, stdout, _ = runCommand(server, "", "agent", "status")
+	assert.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, stdout, "locked\ttrue")
+	code, _, _ = runCommand(server, "", "list")
+	assert.Equal(t, cli.ExitUsage, code)
+	code, _, stderr = runCommand(server, testPassword+"\n", "--password-stdin", "list")
+	require.Equal(t, cli.ExitOK, code, stderr)
+	code, _, stderr = runCommand(server, "", "list")
+	assert.Equal(t, cli.ExitOK, code, stderr)
+
+	code, _, _ = runCommand(server, "", "agent", "stop")
+	require.Equal(t, cli.ExitOK, code)
+	select {
+	case code := <-done:
+		assert.Equal(t, cli.ExitOK, code)
+	case <-time.After(10 * time.Second):
+		t.Fatal("агент не остановился")
+	}
+	code, _, _ = runCommand(server, "", "agent", "status")
+	assert.Equal(t, cli.ExitUnavailable, code)
+}
+
+func TestRun_AgentIdleLock(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("GOPHKEEPER_AGENT_SOCK", filepath.Join(t.TempDir(), "agent.sock"))
+	server := newFakeServer()
+
+	done := startAgent(t, server, "200ms")
+	assert.Eventually(t, func() bool {
+		_, stdout, _ := runCommand(server, "", "agent", "status")
+		return strings.Contains(stdout, "locked\ttrue")
+	}, 10*time.Second, 50*time.Millisecond)
+
+	code, _, _ := runCommand(server, "", "agent", "stop")
+	require.Equal(t, cli.ExitOK, code)
+	<-done
+}
@@ -0,0 +1,17 @@
+//go:build unix
+
+package cli
+
+import (
+	"net"
+	"syscall"
+)
+
+// listenUnix - создает Unix-сокет с umask 0077: права 0600 у сокета с момента создания,
+// в какой бы директории он ни находился. Umask общий для процесса, поэтому файлы, созданные
+// в это время другими горутинами, тоже получат права не шире 0700.
+func listenUnix(path string) (net.Listener, error) {
+	old := syscall.Umask(0077)
+	defer syscall.Umask(old)
+	return net.Listen("unix", path)
+}
@@ -0,0 +1,157 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/bubu256/gophkeeper_pet/internal/proto/pb"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/client/audit"
+)
+
+// day - сутки, в которых пороги проверки задаются флагами.
+const day = 24 * time.Hour
+
+// runAudit - команда audit: проверяет данные из локальной копии и выводит отчет о слабых и повторяющихся
+// паролях, паролях из известных утечек, давно не менявшихся паролях и картах с истекающим сроком действия.
+// Пароли проверяются по базе утечек, только если она задана флагами или конфигурацией клиента.
+func (c *Cli) runAudit(opts globalOptions, args []string) error {
+	fs := c.newFlagSet("audit")
+	options := audit.DefaultOptions()
+	fs.IntVar(&options.MinScore, "min-score", options.MinScore, fmt.Sprintf("пароль с оценкой ниже указанной (0-%d) считается слабым", audit.MaxScore))
+	maxAge := fs.Int("max-age", int(options.MaxAge/day), "пароль, не менявшийся больше указанного количества дней, рекомендуется сменить")
+	expiryWarning := fs.Int("expiry-days", int(options.ExpiryWarning/day), "сообщать о картах, срок действия которых истекает в течение указанного количества дней")
+	breachData := fs.String("breach-data", c.breachData, "проверить пароли по локальной базе утечек в формате HIBP range: папке файлов диапазонов или файлу хешей")
+	breachServer := fs.Bool("breach-server", c.breachServer, "проверить пароли по базе утечек сервера, серверу передаются только префиксы хешей")
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return usageError("лишние аргументы: %v", positional)
+	}
+	if options.MinScore < 0 || options.MinScore > audit.MaxScore || *maxAge <= 0 || *expiryWarning < 0 {
+		return usageError("некорректные пороги проверки")
+	}
+	options.MaxAge = time.Duration(*maxAge) * day
+	options.ExpiryWarning = time.Duration(*expiryWarning) * day
+
+	if err := c.authenticate(opts); err != nil {
+		return err
+	}
+	if !c.offline {
+		if err := c.synchronize(); err != nil {
+			fmt.Fprintln(c.messages, "- Не удалось синхронизировать данные, проверяется локальная копия:", err)
+		}
+	}
+
+	entries := auditEntries(c.storage.GetData())
+	report := audit.Run(entries, options, time.Now())
+	source, err := c.breachSource(*breachData, *breachServer)
+	if err == nil && source != nil {
+		err = report.CheckBreaches(entries, source)
+	}
+	if err != nil {
+		return fmt.Errorf("ошибка проверки по базе утечек: %w", err)
+	}
+	if c.structured() {
+		return c.writeDocument(auditDocumentOf(report))
+	}
+	writeAuditReport(c.stdout, report, options)
+	return nil
+}
+
+// Audit - проверка надежности данных в меню.
+func (c *Cli) Audit() {
+	if c.vaultKey == nil {
+		fmt.Println("- Выполните аутентификацию.")
+		return
+	}
+	options := audit.DefaultOptions()
+	entries := auditEntries(c.storage.GetData())
+	report := audit.Run(entries, options, time.Now())
+	source, err := c.breachSource(c.breachData, c.breachServer)
+	if err == nil && source != nil {
+		err = report.CheckBreaches(entries, source)
+	}
+	if err != nil {
+		fmt.Println("- Не удалось проверить пароли по базе утечек:", err)
+	}
+	writeAuditReport(os.Stdout, report, options)
+}
+
+// auditEntries - возвращает проверяемые поля данных.
+func auditEntries(cells []*pb.MemoryCell) []audit.Entry {
+	entries := make([]audit.Entry, 0, len(cells))
+	for _, cell := range cells {
+		entry := audit.Entry{ID: cell.Info.Id, Kind: cell.Info.DataType, Description: cell.Info.Description}
+		if login := cell.GetLogin(); login != nil {
+			entry.URL, entry.Username, entry.Password = login.Url, login.Username, login.Password
+		}
+		if card := cell.GetCard(); card != nil {
+			entry.Expiry = card.Expiry
+		}
+		if cell.Info.UpdatedAt != nil {
+			entry.UpdatedAt = cell.Info.UpdatedAt.AsTime()
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// writeAuditReport - выводит отчет о проверке в текстовом виде.
+func writeAuditReport(w io.Writer, report audit.Report, options audit.Options) {
+	fmt.Fprintf(w, "Проверено данных: %d (с паролями: %d, карт: %d).\n", report.Checked, report.Passwords, report.Cards)
+
+	if len(report.Weak) > 0 {
+		fmt.Fprintf(w, "\nСлабые пароли: %d\n", len(report.Weak))
+		for _, weak := range report.Weak {
+			fmt.Fprintf(w, "\t%d\t%s\tоценка %d из %d\n", weak.ID, weak.Description, weak.Score, audit.MaxScore)
+		}
+	}
+	if len(report.Reused) > 0 {
+		fmt.Fprintf(w, "\nОдинаковые пароли: %d\n", len(report.Reused))
+		for _, group := range report.Reused {
+			items := make([]string, 0, len(group))
+			for _, item := range group {
+				items = append(items, fmt.Sprintf("%d %s", item.ID, item.Description))
+			}
+			fmt.Fprintf(w, "\t%s\n", strings.Join(items, ", "))
+		}
+	}
+	if len(report.Breached) > 0 {
+		fmt.Fprintf(w, "\nПароли из известных утечек: %d\n", len(report.Breached))
+		for _, breached := range report.Breached {
+			fmt.Fprintf(w, "\t%d\t%s\tвстречается в утечках %d раз\n", breached.ID, breached.Description, breached.Count)
+		}
+	}
+	if len(report.Old) > 0 {
+		fmt.Fprintf(w, "\nПароли, не менявшиеся больше %d дней: %d\n", int(options.MaxAge/day), len(report.Old))
+		for _, old := range report.Old {
+			fmt.Fprintf(w, "\t%d\t%s\tизменен %s\n", old.ID, old.Description, old.UpdatedAt.Local().Format("2006-01-02"))
+		}
+	}
+	if len(report.Expiring) > 0 {
+		fmt.Fprintf(w, "\nКарты с истекающим сроком действия: %d\n", len(report.Expiring))
+		for _, card := range report.Expiring {
+			state := fmt.Sprintf("осталось дней: %d", daysLeft(card.Left))
+			if card.Expired {
+				state = "срок действия истек"
+			}
+			fmt.Fprintf(w, "\t%d\t%s\t%s, %s\n", card.ID, card.Description, card.Expiry, state)
+		}
+	}
+
+	if report.Problems() == 0 {
+		fmt.Fprintln(w, "\nЗамечаний нет.")
+		return
+	}
+	fmt.Fprintf(w, "\nЗамечаний: %d.\n", report.Problems())
+}
+
+// daysLeft - возвращает количество оставшихся дней, неполный день считается целым.
+func daysLeft(left time.Duration) int {
+	return int((left + day - 1) / day)
+}
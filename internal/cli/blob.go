@@ -0,0 +1,317 @@
+package cli
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/bubu256/gophkeeper_pet/internal/proto/pb"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/client/storage"
+)
+
+// blobThreshold - файлы больше этого размера загружаются на сервер потоком, а не в составе ячейки.
+const blobThreshold = 1 << 20
+
+// blobChunkSize - размер части данных в одном сообщении потоковой загрузки.
+const blobChunkSize = 256 << 10
+
+// maxBlobSize - максимальный размер зашифрованного файла, который принимает сервер.
+const maxBlobSize = math.MaxInt32
+
+// attachFile - добавляет файл к ячейке.
+// Небольшие файлы передаются в составе ячейки, для файлов больше blobThreshold
+// в ячейке сохраняется только имя, а путь к файлу возвращается для потоковой загрузки.
+func attachFile(cell *pb.MemoryCell, path string) (string, error) {
+	filename, err := GetFileNameFromPath(path)
+	if err != nil {
+		return "", err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", fmt.Errorf("ошибка чтения файла: %w", err)
+	}
+
+	if storage.EncryptedStreamSize(info.Size()) > maxBlobSize {
+		return "", fmt.Errorf("файл слишком большой: %d байт, допустимо до %d байт с учетом шифрования", info.Size(), int64(maxBlobSize))
+	}
+	if info.Size() > blobThreshold {
+		cell.FileName = filename
+		cell.BinaryData = nil
+		return path, nil
+	}
+	filebytes, err := ReadFileBytes(path)
+	if err != nil {
+		return "", err
+	}
+	cell.FileName = filename
+	cell.BinaryData = filebytes
+	return "", nil
+}
+
+// uploadFile - шифрует и загружает большой файл на сервер потоком, сообщая пользователю о результате.
+// Если загрузка прервется, она будет продолжена при следующей синхронизации.
+func (c *Cli) uploadFile(infoID int64, path string) {
+	encPath, err := c.prepareUpload(infoID, path)
+	if err != nil {
+		fmt.Fprintln(c.messages, "- Ошибка при подготовке файла:", err)
+		return
+	}
+	if c.offline || infoID < 0 {
+		fmt.Fprintln(c.messages, "- Файл будет загружен на сервер при синхронизации.")
+		return
+	}
+	if err := c.uploadBlob(infoID, encPath); err != nil {
+		fmt.Fprintln(c.messages, "- Ошибка при загрузке файла:", err)
+		fmt.Fprintln(c.messages, "- Загрузка будет продолжена при следующей синхронизации.")
+		return
+	}
+	fmt.Fprintln(c.messages, "- Файл загружен.")
+}
+
+// uploadsDir - директория пользователя с зашифрованными копиями файлов, ожидающих загрузки на сервер.
+func (c *Cli) uploadsDir() string {
+	return c.storage.ProfilePath(".uploads")
+}
+
+// prepareUpload - шифрует файл во временный файл для потоковой загрузки.
+// Зашифрованная копия хранится до завершения загрузки, чтобы прерванную загрузку можно было продолжить.
+func (c *Cli) prepareUpload(infoID int64, path string) (string, error) {
+	if err := os.MkdirAll(c.uploadsDir(), 0700); err != nil {
+		return "", fmt.Errorf("ошибка создания папки: %w", err)
+	}
+
+	src, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("ошибка чтения файла: %w", err)
+	}
+	defer src.Close()
+
+	encPath := filepath.Join(c.uploadsDir(), fmt.Sprintf("%d.enc", infoID))
+	dst, err := os.OpenFile(encPath+".tmp", os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return "", fmt.Errorf("ошибка создания файла: %w", err)
+	}
+	if err := storage.EncryptStream(dst, src, c.vaultKey); err != nil {
+		dst.Close()
+		os.Remove(dst.Name())
+		return "", fmt.Errorf("ошибка шифрования файла: %w", err)
+	}
+	if err := dst.Close(); err != nil {
+		return "", fmt.Errorf("ошибка записи файла: %w", err)
+	}
+
+	return encPath, os.Rename(encPath+".tmp", encPath)
+}
+
+// uploadBlob - загружает зашифрованный файл на сервер потоком.
+// Если сервер уже получил часть этого файла, загрузка продолжается с места остановки.
+// После успешной загрузки зашифрованная копия удаляется.
+func (c *Cli) uploadBlob(infoID int64, encPath string) error {
+	file, err := os.Open(encPath)
+	if err != nil {
+		return fmt.Errorf("ошибка чтения файла: %w", err)
+	}
+	defer file.Close()
+
+	totalSize, checksum, err := fileChecksum(file)
+	if err != nil {
+		return err
+	}
+
+	state, err := c.client.BlobStatus(c.ctx, &pb.BlobStatusRequest{InfoId: infoID})
+	if err != nil {
+		return err
+	}
+	var offset int64
+	if state.TotalSize == totalSize && state.Sha256 == checksum {
+		if state.Complete {
+			file.Close()
+			return os.Remove(encPath)
+		}
+		offset = state.Size
+	}
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	stream, err := c.client.UploadBlob(c.ctx)
+	if err != nil {
+		return err
+	}
+	header := &pb.BlobHeader{InfoId: infoID, Offset: offset, TotalSize: totalSize, Sha256: checksum}
+	if err := stream.Send(&pb.UploadBlobRequest{Payload: &pb.UploadBlobRequest_Header{Header: header}}); err != nil {
+		return err
+	}
+
+	buf := make([]byte, blobChunkSize)
+	sent := offset
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			chunk := &pb.UploadBlobRequest_Chunk{Chunk: buf[:n]}
+			if err := stream.Send(&pb.UploadBlobRequest{Payload: chunk}); err != nil {
+				// причину ошибки сервер возвращает при закрытии потока
+				_, err = stream.CloseAndRecv()
+				return err
+			}
+			sent += int64(n)
+			printProgress(c.messages, "Загрузка", sent, totalSize)
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	fmt.Fprintln(c.messages)
+
+	response, err := stream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+	if !response.Complete {
+		return fmt.Errorf("сервер получил %d из %d байт", response.Size, totalSize)
+	}
+
+	file.Close()
+	return os.Remove(encPath)
+}
+
+// resumeUploads - продолжает загрузку файлов, прерванную в прошлых сеансах.
+func (c *Cli) resumeUploads() {
+	entries, err := os.ReadDir(c.uploadsDir())
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasSuffix(name, ".enc") {
+			continue
+		}
+		infoID, err := strconv.ParseInt(strings.TrimSuffix(name, ".enc"), 10, 64)
+		// файлы еще не отправленных данных загружаются после их добавления на сервер
+		if err != nil || infoID < 0 {
+			continue
+		}
+
+		fmt.Fprintf(c.messages, "- Продолжение загрузки файла для данных %d\n", infoID)
+		if err := c.uploadBlob(infoID, filepath.Join(c.uploadsDir(), name)); err != nil {
+			fmt.Fprintln(c.messages, "- Ошибка при загрузке файла:", err)
+			continue
+		}
+		fmt.Fprintln(c.messages, "- Файл загружен.")
+	}
+}
+
+// downloadBlob - скачивает файл потоком, проверяет контрольную сумму и расшифровывает его в указанную папку.
+// Зашифрованные данные сохраняются во временный файл .part, и прерванное скачивание продолжается с места остановки.
+func (c *Cli) downloadBlob(infoID int64, directoryPath, fileName string) error {
+	if err := os.MkdirAll(directoryPath, 0755); err != nil {
+		return fmt.Errorf("ошибка создания папки: %w", err)
+	}
+	filePath := filepath.Join(directoryPath, fileName)
+	partPath := filePath + ".part"
+
+	part, err := os.OpenFile(partPath, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return fmt.Errorf("ошибка создания файла: %w", err)
+	}
+	defer part.Close()
+	offset, err := part.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+
+	stream, err := c.client.DownloadBlob(c.ctx, &pb.DownloadBlobRequest{InfoId: infoID, Offset: offset})
+	if err != nil {
+		return err
+	}
+	var checksum string
+	totalSize := offset
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if response.Offset != offset {
+			return fmt.Errorf("получена часть со смещением %d вместо %d", response.Offset, offset)
+		}
+		if _, err := part.Write(response.Chunk); err != nil {
+			return fmt.Errorf("ошибка записи в файл: %w", err)
+		}
+		offset += int64(len(response.Chunk))
+		totalSize, checksum = response.TotalSize, response.Sha256
+		printProgress(c.messages, "Скачивание", offset, totalSize)
+	}
+	fmt.Fprintln(c.messages)
+
+	size, sum, err := fileChecksum(part)
+	if err != nil {
+		return err
+	}
+	if checksum == "" {
+		// все данные были получены ранее, контрольную сумму запрашиваем отдельно
+		state, err := c.client.BlobStatus(c.ctx, &pb.BlobStatusRequest{InfoId: infoID})
+		if err != nil {
+			return err
+		}
+		totalSize, checksum = state.TotalSize, state.Sha256
+	}
+	if size != totalSize || sum != checksum {
+		part.Close()
+		os.Remove(partPath)
+		return errors.New("контрольная сумма файла не совпадает, повторите скачивание")
+	}
+
+	if _, err := part.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	dst, err := os.OpenFile(filePath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("ошибка создания файла: %w", err)
+	}
+	if err := storage.DecryptStream(dst, part, c.vaultKey); err != nil {
+		dst.Close()
+		os.Remove(filePath)
+		return fmt.Errorf("ошибка расшифровки файла: %w", err)
+	}
+	if err := dst.Close(); err != nil {
+		return fmt.Errorf("ошибка записи в файл: %w", err)
+	}
+
+	part.Close()
+	return os.Remove(partPath)
+}
+
+// fileChecksum - возвращает размер и SHA-256 содержимого файла.
+func fileChecksum(file *os.File) (int64, string, error) {
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return 0, "", err
+	}
+	h := sha256.New()
+	size, err := io.Copy(h, file)
+	if err != nil {
+		return 0, "", fmt.Errorf("ошибка чтения файла: %w", err)
+	}
+	return size, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// printProgress - выводит прогресс передачи данных в одной строке.
+func printProgress(w io.Writer, label string, done, total int64) {
+	percent := int64(100)
+	if total > 0 {
+		percent = done * 100 / total
+	}
+	fmt.Fprintf(w, "\r\t%s: %d из %d байт (%d%%)", label, done, total, percent)
+}
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bubu256/gophkeeper_pet/internal/proto/pb"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/breach"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// serverBreaches - база утечек на сервере. Серверу передаются только префиксы хешей паролей.
+type serverBreaches struct {
+	c *Cli
+}
+
+// Range - запрашивает у сервера суффиксы хешей утекших паролей с префиксом prefix.
+func (s serverBreaches) Range(prefix string) (map[string]int64, error) {
+	response, err := s.c.client.CheckPasswordPrefix(s.c.ctx, &pb.CheckPasswordPrefixRequest{Prefix: prefix})
+	if status.Code(err) == codes.Unimplemented {
+		return nil, errors.New("на сервере не настроена база утечек")
+	}
+	if err != nil {
+		return nil, err
+	}
+	suffixes := make(map[string]int64, len(response.Suffixes))
+	for _, suffix := range response.Suffixes {
+		suffixes[suffix.Suffix] = suffix.Count
+	}
+	return suffixes, nil
+}
+
+// SetBreachCheck - задает базу утекших паролей, по которой проверяются пароли при добавлении данных и в audit:
+// локальную базу в формате HIBP "range" по пути path или, если server, базу сервера.
+func (c *Cli) SetBreachCheck(path string, server bool) {
+	c.breachData = path
+	c.breachServer = server
+}
+
+// breachSource - возвращает локальную базу утечек по пути path или базу сервера. Возвращает nil, если проверка не задана.
+func (c *Cli) breachSource(path string, server bool) (breach.Source, error) {
+	switch {
+	case path != "":
+		return breach.Open(path)
+	case server && c.offline:
+		return nil, errors.New("база утечек сервера недоступна в автономном режиме")
+	case server:
+		return serverBreaches{c}, nil
+	}
+	return nil, nil
+}
+
+// warnBreached - предупреждает, если пароль данных встречается в известных утечках.
+// Ошибка проверки не мешает сохранению данных.
+func (c *Cli) warnBreached(cell *pb.MemoryCell) {
+	login := cell.GetLogin()
+	if login == nil || login.Password == "" {
+		return
+	}
+	source, err := c.breachSource(c.breachData, c.breachServer)
+	if source == nil && err == nil {
+		return
+	}
+	var count int64
+	if err == nil {
+		count, err = breach.Count(source, login.Password)
+	}
+	if err != nil {
+		fmt.Fprintln(c.messages, "- Не удалось проверить пароль по базе утечек:", err)
+		return
+	}
+	if count > 0 {
+		fmt.Fprintf(c.messages, "- Внимание: пароль встречается в известных утечках (%d раз), рекомендуется использовать другой.\n", count)
+	}
+}
@@ -4,42 +4,88 @@ package cli
 import (
 	"bufio"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/bubu256/gophkeeper_pet/internal/kinds"
 	"github.com/bubu256/gophkeeper_pet/internal/proto/pb"
+	"github.com/bubu256/gophkeeper_pet/internal/schema"
 	"github.com/bubu256/gophkeeper_pet/pkg/keeper/client/storage"
+	"golang.org/x/term"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 // Cli - структура для реализации работы меню приложения и его взаимодействия с grpc серверомю
 type Cli struct {
-	token   string
-	client  pb.GophKeeperServiceClient
-	ctx     context.Context
-	storage *storage.Storage
-	Cancel  context.CancelFunc
+	username       string
+	token          string
+	refreshToken   string
+	tokenExpiresAt time.Time
+	vaultKey       []byte
+	authKey        string
+	offline        bool
+	journal        *storage.Journal
+	client         pb.GophKeeperServiceClient
+	ctx            context.Context
+	storage        *storage.Storage
+	// stdin, stdout - ввод и вывод результата команд. Сообщения о ходе работы (переход в автономный режим,
+	// загрузка файлов) выводятся в messages, чтобы в режиме команд не смешиваться с результатом.
+	stdin    *bufio.Reader
+	stdout   io.Writer
+	messages io.Writer
+	// stdinFd - дескриптор стандартного ввода для ввода секретов без отображения, -1, если ввод не файл.
+	// terminalState - состояние терминала на время ввода секрета, восстанавливается при прерывании.
+	stdinFd       int
+	terminalMu    sync.Mutex
+	terminalState *term.State
+	// format - формат вывода результата команд: text, json или yaml.
+	format string
+	// agentLocked - агент заблокирован, его нужно разблокировать мастер-паролем, введенным для команды.
+	agentLocked bool
+	// breachData, breachServer - база утекших паролей для проверки паролей, см. SetBreachCheck.
+	breachData   string
+	breachServer bool
+	Cancel       context.CancelFunc
 }
 
+// tokenRefreshMargin - за сколько до окончания действия токена доступа он обновляется.
+const tokenRefreshMargin = time.Minute
+
 // NewCli - возвращает экземпляр Cli
 func NewCli(client pb.GophKeeperServiceClient, ctx context.Context, storage *storage.Storage) *Cli {
 	ctx, cancel := context.WithCancel(ctx)
 	return &Cli{
-		client:  client,
-		ctx:     ctx,
-		storage: storage,
-		Cancel:  cancel,
+		client:   client,
+		ctx:      ctx,
+		storage:  storage,
+		stdin:    bufio.NewReader(os.Stdin),
+		stdinFd:  int(os.Stdin.Fd()),
+		stdout:   os.Stdout,
+		messages: os.Stdout,
+		Cancel:   cancel,
 	}
 }
 
 // RunMenu - запускает интерактивное меню приложения
 func (c *Cli) RunMenu() {
+	c.resumeLastSession()
 	for {
-		fmt.Println("Меню:  1. Регистрация;  2. Авторизация;  3. Получение информации;  4. Получение данных по InfoID;  5. Сохранить новые данные;     0. Выход")
+		if c.offline {
+			fmt.Printf("[автономный режим, изменений ожидает отправки: %d]\n", c.journal.Len())
+		}
+		fmt.Println("Меню:  1. Регистрация;  2. Авторизация;  3. Получение информации;  4. Получение данных по InfoID;  5. Сохранить новые данные;  6. Изменить данные;  7. Удалить данные;  8. Выход из аккаунта;  9. Синхронизация;  10. История версий;  11. Корзина;  12. Папки и метки;  13. Поиск;  14. Генератор паролей;  15. Проверка надежности;  16. Одноразовые коды;     0. Выход")
 
 		var choice string
 		fmt.Print("Выберите пункт меню: ")
@@ -50,6 +96,7 @@ func (c *Cli) RunMenu() {
 			fmt.Println("Выход из приложения")
 			return
 		default:
+			c.refreshSession()
 			switch choice {
 			case "1":
 				c.Register()
@@ -61,6 +108,28 @@ func (c *Cli) RunMenu() {
 				c.RetrieveDataByID()
 			case "5":
 				c.AddData()
+			case "6":
+				c.UpdateData()
+			case "7":
+				c.DeleteData()
+			case "8":
+				c.Logout()
+			case "9":
+				c.Sync()
+			case "10":
+				c.Versions()
+			case "11":
+				c.Trash()
+			case "12":
+				c.Folders()
+			case "13":
+				c.Search()
+			case "14":
+				c.Generate()
+			case "15":
+				c.Audit()
+			case "16":
+				c.OTP()
 			case "0":
 				c.Dump()
 				fmt.Println("Выход из приложения")
@@ -77,7 +146,7 @@ func (c *Cli) RunMenu() {
 // Register - регистрация пользователя
 func (c *Cli) Register() {
 	// запрос данных у пользователя
-	reader := bufio.NewReader(os.Stdin)
+	reader := c.stdin
 
 	fmt.Print("Введите логин: ")
 	username, err := reader.ReadString('\n')
@@ -86,17 +155,25 @@ func (c *Cli) Register() {
 	}
 	username = strings.TrimSpace(username)
 
-	fmt.Print("Введите пароль: ")
-	password, err := reader.ReadString('\n')
+	// мастер-пароль не отображается при вводе и запрашивается повторно: опечатку в нем нельзя исправить
+	password, err := c.readNewSecret("Введите пароль: ")
 	if err != nil {
-		log.Fatalf("Ошибка при чтении пароля: %v", err)
+		fmt.Println("- Ошибка при вводе пароля:", err)
+		return
 	}
-	password = strings.TrimSpace(password)
+	if password == "" {
+		fmt.Println("- Пароль не может быть пустым.")
+		return
+	}
+
+	// серверу передается ключ аутентификации, полученный из мастер-пароля, а не сам пароль
+	keys := storage.DeriveKeys(username, password)
 
 	// создание запроса регистрации
 	request := &pb.RegistrationRequest{
 		Username: username,
-		Password: password,
+		Password: keys.Auth,
+		Kdf:      schema.ClientKDFArgon2id,
 	}
 
 	// отправка запроса на сервер
@@ -115,7 +192,7 @@ func (c *Cli) Register() {
 
 // Authenticate - аутентификация пользователя
 func (c *Cli) Authenticate() {
-	reader := bufio.NewReader(os.Stdin)
+	reader := c.stdin
 
 	fmt.Print("Введите логин: ")
 	username, err := reader.ReadString('\n')
@@ -125,49 +202,205 @@ func (c *Cli) Authenticate() {
 	}
 	username = strings.TrimSpace(username)
 
-	fmt.Print("Введите пароль: ")
-	password, err := reader.ReadString('\n')
+	password, err := c.readSecret("Введите пароль: ")
 	if err != nil {
 		fmt.Println("Ошибка чтения ввода пользователя:", err)
 		return
 	}
-	password = strings.TrimSpace(password)
 
+	c.completeLogin(username, password)
+}
+
+// completeLogin - выполняет вход, сообщает о результате и синхронизирует клиент.
+func (c *Cli) completeLogin(username, password string) {
+	if err := c.login(username, password); err != nil {
+		fmt.Println("- Ошибка при аутентификации:", err)
+		return
+	}
+	if c.offline {
+		fmt.Println("- Сервер недоступен, вход выполнен по локальной копии данных (автономный режим).")
+		return
+	}
+	fmt.Println("- Аутентификация прошла успешно.")
+	// синхронизация клиента
+	c.Sync()
+}
+
+// login - выполняет вход на сервер и получает ключ шифрования данных из мастер-пароля.
+// Мастер-пароль серверу не передается: вместо него отправляется ключ аутентификации.
+// Если сессия пользователя сохранена предыдущим запуском клиента и еще действует, она продолжается без входа на сервере.
+// Учетные записи, созданные старыми версиями клиента, после входа переводятся на ключ аутентификации.
+// Если сервер недоступен, вход выполняется по локальной копии данных.
+func (c *Cli) login(username, password string) error {
+	keys := storage.DeriveKeys(username, password)
+	resumed, err := c.resumeSession(username, keys)
+	if isUnavailable(err) {
+		return c.loginOffline(username, keys)
+	}
+	if err != nil {
+		return err
+	}
+	if resumed {
+		return nil
+	}
+
+	preLogin, err := c.client.PreLogin(c.ctx, &pb.PreLoginRequest{Username: username})
+	if isUnavailable(err) {
+		return c.loginOffline(username, keys)
+	}
+	if err != nil {
+		return err
+	}
+
+	legacy := preLogin.Kdf == schema.ClientKDFNone
 	request := &pb.AuthenticationRequest{
 		Username: username,
-		Password: password,
+		Password: keys.Auth,
+	}
+	if legacy {
+		request.Password = password
 	}
 
 	response, err := c.client.Authenticate(c.ctx, request)
 	if err != nil {
-		fmt.Println("- Ошибка при аутентификации:", err)
+		return err
+	}
+
+	// сохраняем токены и пишем токен доступа в контекст
+	c.setSession(response.Token, response.RefreshToken, response.ExpiresAt.AsTime())
+	// ключ шифрования данных остается только на клиенте
+	c.vaultKey = keys.Vault
+	c.authKey = keys.Auth
+	c.offline = false
+	if err := c.openProfile(username); err != nil {
+		return err
+	}
+	c.saveSession()
+
+	if legacy {
+		_, err = c.client.ChangePassword(c.ctx, &pb.ChangePasswordRequest{
+			OldPassword: password,
+			NewPassword: keys.Auth,
+			Kdf:         schema.ClientKDFArgon2id,
+		})
+		if err != nil {
+			log.Printf("failed to upgrade credentials: %v", err)
+		} else {
+			fmt.Fprintln(c.messages, "- Учетная запись обновлена: мастер-пароль больше не передается на сервер.")
+		}
+	}
+
+	return nil
+}
+
+// Logout - завершение текущей сессии или всех сессий пользователя на сервере.
+func (c *Cli) Logout() {
+	if c.offline {
+		c.endSession()
+		fmt.Println("- Сессия завершена на этом устройстве. Изменения, не отправленные на сервер, сохранены.")
+		return
+	}
+	if c.token == "" {
+		fmt.Println("- Вы не авторизованы.")
 		return
 	}
 
-	// сохраняем токен и пишем в контекст
-	c.token = response.Token
-	c.ctx = SetTokenContext(c.ctx, c.token)
-	fmt.Println("- Аутентификация прошла успешно.")
-	// синхронизация клиента
-	c.Sync()
+	reader := bufio.NewReader(os.Stdin)
+	fmt.Print("Завершить сессии на всех устройствах? (y/n): ")
+	answer := readLine(reader)
+	allDevices := answer == "y" || answer == "yes"
+
+	_, err := c.client.Logout(c.ctx, &pb.LogoutRequest{AllDevices: allDevices})
+	if err != nil {
+		fmt.Println("- Ошибка при выходе из аккаунта:", err)
+		return
+	}
+
+	c.endSession()
+	fmt.Println("- Сессия завершена.")
+}
+
+// endSession - забывает токены, ключи и данные пользователя на клиенте и удаляет сохраненную сессию.
+func (c *Cli) endSession() {
+	if c.username != "" {
+		if err := c.storage.RemoveSession(); err != nil {
+			log.Printf("failed to remove session: %v", err)
+		}
+	}
+	c.forgetUser()
+}
+
+// forgetUser - забывает токены, ключи и данные пользователя на клиенте. Сохраненная сессия остается.
+func (c *Cli) forgetUser() {
+	c.setSession("", "", time.Time{})
+	c.storage.Reset()
+	c.username = ""
+	c.vaultKey = nil
+	c.authKey = ""
+	c.journal = nil
+	c.offline = false
+}
+
+// setSession - сохраняет токены сессии и записывает токен доступа в контекст запросов.
+func (c *Cli) setSession(token, refreshToken string, expiresAt time.Time) {
+	c.token = token
+	c.refreshToken = refreshToken
+	c.tokenExpiresAt = expiresAt
+	c.ctx = SetTokenContext(c.ctx, token)
+}
+
+// refreshSession - обновляет токен доступа, если срок его действия подходит к концу.
+func (c *Cli) refreshSession() {
+	if c.refreshToken == "" || time.Until(c.tokenExpiresAt) > tokenRefreshMargin {
+		return
+	}
+
+	response, err := c.client.RefreshToken(c.ctx, &pb.RefreshTokenRequest{RefreshToken: c.refreshToken})
+	if c.goOffline(err) {
+		return
+	}
+	if err != nil {
+		fmt.Fprintln(c.messages, "- Сессия истекла, выполните аутентификацию повторно:", err)
+		c.setSession("", "", time.Time{})
+		if err := c.storage.RemoveSession(); err != nil {
+			log.Printf("failed to remove session: %v", err)
+		}
+		return
+	}
+
+	c.setSession(response.Token, response.RefreshToken, response.ExpiresAt.AsTime())
+	c.saveSession()
 }
 
 // RetrieveInformation - получение мета информации о данных пользователя
 func (c *Cli) RetrieveInformation() {
-	response, err := c.client.GetInformation(c.ctx, &pb.GetInformationRequest{})
+	infoCells, err := c.information()
 	if err != nil {
 		fmt.Println("Ошибка при получении информации:", err)
 		return
 	}
+	folders, err := c.loadFolders()
+	if err != nil {
+		fmt.Println("Ошибка при получении папок:", err)
+		return
+	}
+
+	fmt.Print("Фильтр (папка - ID или путь, #метка, пустая строка - все данные): ")
+	infoCells, err = filterInfo(infoCells, folders, readLine(bufio.NewReader(os.Stdin)))
+	if err != nil {
+		fmt.Println("- Ошибка:", err)
+		return
+	}
 
 	fmt.Println("\tСписок сохраненных данных:")
 	fmt.Println("\t-------------------------------")
-	for _, info := range response.Info {
+	for _, info := range infoCells {
 		fmt.Printf("\tID: %d\n", info.Id)
-		fmt.Printf("\tТип данных: %s\n", info.DataType)
+		fmt.Printf("\tВид данных: %s\n", kindTitle(info.DataType))
 		fmt.Printf("\tРазмер данных: %d\n", info.DataSize)
 		fmt.Printf("\tОписание: %s\n", info.Description)
 		fmt.Printf("\tID владельца: %d\n", info.OwnerId)
+		printFolderAndTags(c.stdout, info, folders)
 		fmt.Println("\t-------------------------------")
 	}
 }
@@ -184,156 +417,497 @@ func (c *Cli) RetrieveDataByID() {
 		return
 	}
 
-	request := &pb.RetrieveDataRequest{
-		Ids: []int64{infoID},
-	}
-
-	response, err := c.client.RetrieveData(c.ctx, request)
+	data, err := c.getCell(infoID)
 	if err != nil {
 		fmt.Println("Ошибка при получении данных:", err)
 		return
 	}
-
-	// Проверка наличия данных
-	if len(response.Data) == 0 {
+	if data == nil {
 		fmt.Println("- Данные не найдены")
 		return
 	}
 
-	// Вывод данных MemoryCell
-	data := response.Data[0]
-	fmt.Println("\tПолученные данные:")
-	// fmt.Printf("ID: %d\n", data.Id)
-	fmt.Printf("\tInfoID: %d\n", data.Info.Id)
-	fmt.Printf("\tТип данных: %s\n", data.Info.DataType)
-	fmt.Printf("\tОписание: %s\n", data.Info.Description)
-	fmt.Printf("\tЗашифровано: %v\n", data.Encrypted)
-	fmt.Println("\tКлючи:")
-	for key, value := range data.KeyValuePairs {
-		fmt.Printf("  %s: %s\n", key, value)
-	}
-	fmt.Printf("\tBinaryData: %v байт\n", len(data.BinaryData))
-	fmt.Printf("\tFileName: %s\n", data.FileName)
-	if (len(data.BinaryData) > 0) && (data.FileName != "") {
+	printCell(c.stdout, data, c.storage.Folders())
+	if (len(data.BinaryData) > 0 || data.Blob) && (data.FileName != "") {
 		fmt.Print("\tДля сохранения файла укажите путь к папке: ")
 		var pathdir string
 		fmt.Scanln(&pathdir)
 		if pathdir == "" {
 			fmt.Print("\tФайл не сохранен.")
+		} else if err := c.saveFile(data, pathdir); err != nil {
+			log.Println(err)
 		} else {
-			err := WriteBytesToFile(data.BinaryData, pathdir, data.FileName)
-			if err != nil {
-				log.Println(err)
-			} else {
-				fmt.Println("\tФайл сохранен.")
-			}
+			fmt.Println("\tФайл сохранен.")
+		}
+	}
+}
+
+// printCell - выводит расшифрованные данные.
+func printCell(w io.Writer, data *pb.MemoryCell, folders []*pb.Folder) {
+	fmt.Fprintln(w, "\tПолученные данные:")
+	fmt.Fprintf(w, "\tInfoID: %d\n", data.Info.Id)
+	fmt.Fprintf(w, "\tВид данных: %s\n", kindTitle(data.Info.DataType))
+	fmt.Fprintf(w, "\tОписание: %s\n", data.Info.Description)
+	printFolderAndTags(w, data.Info, folders)
+	printPayload(w, data)
+	if len(data.KeyValuePairs) > 0 {
+		fmt.Fprintln(w, "\tДополнительные поля:")
+		for key, value := range data.KeyValuePairs {
+			fmt.Fprintf(w, "  %s: %s\n", key, value)
 		}
 	}
+	if data.Blob {
+		fmt.Fprintf(w, "\tBinaryData: %v байт (хранится на сервере)\n", data.Info.DataSize)
+	} else {
+		fmt.Fprintf(w, "\tBinaryData: %v байт\n", len(data.BinaryData))
+	}
+	fmt.Fprintf(w, "\tFileName: %s\n", data.FileName)
+}
+
+// saveFile - сохраняет файл из данных в папку: из ячейки или, для больших файлов, скачивая его с сервера.
+func (c *Cli) saveFile(data *pb.MemoryCell, directoryPath string) error {
+	if data.FileName == "" || (len(data.BinaryData) == 0 && !data.Blob) {
+		return errors.New("данные не содержат файла")
+	}
+	if data.Blob && c.offline {
+		return errors.New("файл хранится на сервере, скачайте его после подключения")
+	}
+	if data.Blob {
+		return c.downloadBlob(data.Info.Id, directoryPath, data.FileName)
+	}
+	return WriteBytesToFile(data.BinaryData, directoryPath, data.FileName)
 }
 
-// Добавление новых данных пользователя на сервер
+// Добавление новых данных пользователя на сервер.
+// Поля данных запрашиваются в зависимости от вида данных и проверяются до отправки.
 func (c *Cli) AddData() {
-	// Запрос данных у пользователя
-	data := pb.MemoryCell{Info: &pb.InfoCell{}}
+	data := &pb.MemoryCell{Info: &pb.InfoCell{}}
+	reader := c.stdin
 
 	fmt.Println("Введите данные для сохранения:")
-
-	fmt.Print("Тип данных: ")
-	fmt.Scanln(&data.Info.DataType)
+	kind, err := promptKind(reader, "")
+	if err != nil {
+		fmt.Println("-", err)
+		return
+	}
+	data.Info.DataType = kind
 
 	fmt.Print("Описание: ")
-	reader := bufio.NewReader(os.Stdin)
-	input, err := reader.ReadString('\n')
+	data.Info.Description = readLine(reader)
+
+	largeFile, err := c.promptPayload(data)
 	if err != nil {
-		log.Println(err)
-	} else {
-		data.Info.Description = strings.TrimSuffix(input, "\n")
+		fmt.Println("-", err)
+		return
 	}
 
-	fmt.Println("Вводите пары ключ и значение через пробел (пустую строку для завершения ввода):")
+	fmt.Println("Дополнительные поля: вводите пары ключ и значение через пробел (пустую строку для завершения ввода):")
 	data.KeyValuePairs = make(map[string]string)
+	for {
+		input := readLine(reader)
+		if input == "" {
+			break
+		}
+		key, value, _ := strings.Cut(input, " ")
+		data.KeyValuePairs[key] = strings.TrimSpace(value)
+	}
+
+	c.warnBreached(data)
+	infoID, err := c.sendNewCell(data)
+	if err != nil {
+		fmt.Println("- Ошибка при добавлении данных:", err)
+		return
+	}
 
+	fmt.Println("- Данные успешно добавлены. ID данных:", infoID)
+	if largeFile != "" {
+		c.uploadFile(infoID, largeFile)
+	}
+}
+
+// UpdateData - изменение сохраненных данных пользователя.
+// Пустой ввод оставляет текущее значение поля.
+func (c *Cli) UpdateData() {
+	fmt.Print("Введите InfoID изменяемых данных: ")
+	var infoID int64
+	_, err := fmt.Scanln(&infoID)
+	if err != nil {
+		fmt.Println("Ошибка при чтении InfoID:", err)
+		return
+	}
+
+	data, err := c.getCell(infoID)
+	if err != nil {
+		fmt.Println("Ошибка при получении данных:", err)
+		return
+	}
+	if data == nil {
+		fmt.Println("- Данные не найдены")
+		return
+	}
+	// версия до изменений нужна для слияния при конфликте
+	base := proto.Clone(data).(*pb.MemoryCell)
+	if data.KeyValuePairs == nil {
+		data.KeyValuePairs = make(map[string]string)
+	}
+
+	reader := c.stdin
+	fmt.Println("Введите новые значения (пустая строка - оставить текущее):")
+
+	kind, err := promptKind(reader, data.Info.DataType)
+	if err != nil {
+		fmt.Println("-", err)
+		return
+	}
+	data.Info.DataType = kind
+
+	fmt.Printf("Описание [%s]: ", data.Info.Description)
+	if input := readLine(reader); input != "" {
+		data.Info.Description = input
+	}
+
+	largeFile, err := c.promptPayload(data)
+	if err != nil {
+		fmt.Println("-", err)
+		return
+	}
+
+	fmt.Println("Текущие дополнительные поля:")
+	for key, value := range data.KeyValuePairs {
+		fmt.Printf("  %s: %s\n", key, value)
+	}
+	fmt.Println("Вводите пары ключ и значение через пробел, ключ без значения удаляет пару (пустую строку для завершения ввода):")
 	for {
-		var key string
-		fmt.Scanln(&key)
-		if key == "" {
+		input := readLine(reader)
+		if input == "" {
 			break
 		}
-		var value string
-		fmt.Scanln(&value)
-		data.KeyValuePairs[key] = value
+		key, value, found := strings.Cut(input, " ")
+		if !found {
+			delete(data.KeyValuePairs, key)
+			continue
+		}
+		data.KeyValuePairs[key] = strings.TrimSpace(value)
 	}
 
-	var pathfile string
-	fmt.Print("Файл: ")
-	fmt.Scanln(&pathfile)
-	if pathfile != "" {
-		filename, err := GetFileNameFromPath(pathfile)
+	// файл, сохраненный у данных другого вида до появления видов данных, можно удалить
+	if data.Info.DataType != kinds.File && data.FileName != "" {
+		fmt.Printf("Файл [%s] (\"-\" - удалить файл): ", data.FileName)
+		if readLine(reader) == "-" {
+			data.FileName = ""
+			data.BinaryData = nil
+		}
+	}
+
+	err = c.sendUpdatedCell(data, base)
+	if err != nil {
+		fmt.Println("- Ошибка при изменении данных:", err)
+		return
+	}
+
+	fmt.Println("- Данные успешно изменены.")
+	if largeFile != "" {
+		c.uploadFile(infoID, largeFile)
+	}
+}
+
+// DeleteData - удаление данных пользователя по списку InfoID.
+func (c *Cli) DeleteData() {
+	reader := bufio.NewReader(os.Stdin)
+	fmt.Print("Введите InfoID для удаления через пробел: ")
+	fields := strings.Fields(readLine(reader))
+	if len(fields) == 0 {
+		fmt.Println("- InfoID не указаны")
+		return
+	}
+
+	infoIDs := make([]int64, 0, len(fields))
+	for _, field := range fields {
+		id, err := strconv.ParseInt(field, 10, 64)
 		if err != nil {
-			log.Println(err)
-		} else {
-			data.FileName = filename
-			filebytes, err := ReadFileBytes(pathfile)
-			if err != nil {
-				log.Println(err)
-			} else {
-				data.BinaryData = filebytes
-			}
+			fmt.Println("Ошибка при чтении InfoID:", err)
+			return
 		}
+		infoIDs = append(infoIDs, id)
 	}
 
-	request := &pb.AddDataRequest{
-		Data: &data,
+	fmt.Printf("Удалить данные %v? (y/n): ", infoIDs)
+	if answer := readLine(reader); answer != "y" && answer != "yes" {
+		fmt.Println("- Удаление отменено.")
+		return
 	}
 
-	response, err := c.client.AddData(c.ctx, request)
+	err := c.deleteCells(infoIDs)
 	if err != nil {
-		fmt.Println("- Ошибка при добавлении данных:", err)
+		fmt.Println("- Ошибка при удалении данных:", err)
 		return
 	}
 
-	fmt.Println("- Данные успешно добавлены. ID данных:", response.Id)
+	fmt.Println("- Данные перемещены в корзину.")
 }
 
 // GetAllData - возвращает все данные пользователя
 func (c *Cli) GetAllData() []*pb.MemoryCell {
-	response, err := c.client.GetInformation(c.ctx, &pb.GetInformationRequest{})
+	infoCells, err := c.listInformation(&pb.GetInformationRequest{})
 	if err != nil {
 		fmt.Println("Ошибка при получении информации:", err)
 		return nil
 	}
 	// создаем слайс из всех InfoID пользователя
-	infoIDs := make([]int64, len(response.Info))
-	for i, info := range response.Info {
+	infoIDs := make([]int64, len(infoCells))
+	for i, info := range infoCells {
 		infoIDs[i] = info.Id
 	}
 	// получаем все данные пользователя
-	responseData, err := c.client.RetrieveData(c.ctx, &pb.RetrieveDataRequest{Ids: infoIDs})
+	cells, err := c.retrieveCells(infoIDs)
 	if err != nil {
 		fmt.Println("Ошибка при получении данных:", err)
 		return nil
 	}
 	// Проверка наличия данных
-	if len(responseData.Data) == 0 {
+	if len(cells) == 0 {
 		fmt.Println("- Данные не найдены")
 		return nil
 	}
-	return responseData.Data
+	return cells
+}
+
+// infoPageSize - размер страницы при получении информации о данных с сервера.
+const infoPageSize = 200
+
+// listInformation - запрашивает у сервера информацию о данных постранично и возвращает все страницы.
+func (c *Cli) listInformation(request *pb.GetInformationRequest) ([]*pb.InfoCell, error) {
+	request = proto.Clone(request).(*pb.GetInformationRequest)
+	request.PageSize = infoPageSize
+
+	var infoCells []*pb.InfoCell
+	for {
+		response, err := c.client.GetInformation(c.ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		infoCells = append(infoCells, response.Info...)
+		if response.NextPageToken == "" {
+			return infoCells, nil
+		}
+		request.PageToken = response.NextPageToken
+	}
+}
+
+// information - возвращает информацию о данных пользователя с сервера или, в автономном режиме, из локальной копии.
+func (c *Cli) information() ([]*pb.InfoCell, error) {
+	if !c.offline {
+		infoCells, err := c.listInformation(&pb.GetInformationRequest{})
+		if err == nil || !c.goOffline(err) {
+			return infoCells, err
+		}
+	}
+	return c.storage.GetInformation(), nil
+}
+
+// getCell - возвращает копию расшифрованных данных по InfoID: с сервера или, в автономном режиме
+// и для еще не отправленных данных, из локальной копии. Если данных нет, возвращает nil.
+func (c *Cli) getCell(infoID int64) (*pb.MemoryCell, error) {
+	if !c.offline && infoID > 0 {
+		cells, err := c.retrieveCells([]int64{infoID})
+		if err != nil && !c.goOffline(err) {
+			return nil, err
+		}
+		if err == nil {
+			if len(cells) == 0 {
+				return nil, nil
+			}
+			return cells[0], nil
+		}
+	}
+
+	cell, err := c.storage.GetDataByID(infoID)
+	if err != nil {
+		return nil, nil
+	}
+	return proto.Clone(cell).(*pb.MemoryCell), nil
+}
+
+// retrieveCells - запрашивает данные по списку InfoID и расшифровывает их ключом хранилища.
+func (c *Cli) retrieveCells(infoIDs []int64) ([]*pb.MemoryCell, error) {
+	response, err := c.client.RetrieveData(c.ctx, &pb.RetrieveDataRequest{Ids: infoIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.decryptCells(response.Data)
+}
+
+// decryptCells - расшифровывает ячейки, полученные с сервера.
+func (c *Cli) decryptCells(encrypted []*pb.MemoryCell) ([]*pb.MemoryCell, error) {
+	cells := make([]*pb.MemoryCell, 0, len(encrypted))
+	for _, cell := range encrypted {
+		decrypted, err := storage.DecryptCell(cell, c.vaultKey)
+		if err != nil {
+			return nil, fmt.Errorf("не удалось расшифровать данные %d: %w", cell.Info.Id, err)
+		}
+		cells = append(cells, decrypted)
+	}
+	return cells, nil
+}
+
+// sendNewCell - шифрует новые данные ключом хранилища и отправляет их на сервер.
+// В автономном режиме данные сохраняются локально и записываются в журнал изменений.
+// Возвращает InfoID сохраненных данных.
+func (c *Cli) sendNewCell(cell *pb.MemoryCell) (int64, error) {
+	if c.offline {
+		return c.recordAdd(cell)
+	}
+
+	infoID, revision, err := c.sendCell(cell, true)
+	if c.goOffline(err) {
+		return c.recordAdd(cell)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	cell.Info.Id = infoID
+	cell.Info.Revision = revision
+	c.storage.Put(cell)
+	c.saveLocal()
+	return infoID, nil
+}
+
+// sendUpdatedCell - шифрует измененные данные ключом хранилища и отправляет их на сервер.
+// base - версия данных, которую изменил пользователь. Если на сервере данные уже изменены
+// с другого устройства, пользователю предлагается разрешить конфликт.
+// В автономном режиме и для еще не отправленных данных изменение записывается в журнал.
+func (c *Cli) sendUpdatedCell(cell, base *pb.MemoryCell) error {
+	err := c.updateCell(cell, base)
+	if errors.Is(err, errConflict) {
+		return c.resolveConflict(base, cell)
+	}
+	return err
+}
+
+// errConflict возвращается updateCell, если данные изменены на сервере после версии base.
+var errConflict = errors.New("данные изменены на другом устройстве")
+
+// updateCell - отправляет измененные данные на сервер, как sendUpdatedCell, но не разрешает конфликт,
+// а возвращает errConflict.
+func (c *Cli) updateCell(cell, base *pb.MemoryCell) error {
+	if c.offline || cell.Info.Id < 0 {
+		return c.recordUpdate(cell, base)
+	}
+
+	_, revision, err := c.sendCell(cell, false)
+	if c.goOffline(err) {
+		return c.recordUpdate(cell, base)
+	}
+	if status.Code(err) == codes.Aborted {
+		return errConflict
+	}
+	if err != nil {
+		return err
+	}
+
+	cell.Info.Revision = revision
+	c.storage.Put(cell)
+	c.saveLocal()
+	return nil
+}
+
+// deleteCells - удаляет данные на сервере и в локальной копии.
+// В автономном режиме и для еще не отправленных данных удаление записывается в журнал.
+func (c *Cli) deleteCells(infoIDs []int64) error {
+	pending := false
+	for _, id := range infoIDs {
+		pending = pending || id < 0
+	}
+	if c.offline || pending {
+		return c.recordDelete(infoIDs)
+	}
+
+	_, err := c.client.DeleteData(c.ctx, &pb.DeleteDataRequest{Ids: infoIDs})
+	if c.goOffline(err) {
+		return c.recordDelete(infoIDs)
+	}
+	if err != nil {
+		return err
+	}
+
+	c.storage.Remove(infoIDs...)
+	c.saveLocal()
+	return nil
 }
 
-// Sync - синхронизует данные пользователя на клиенте и предлагает пользователю сохранить резервную копию
+// encryptCell - шифрует ячейку ключом хранилища, полученным при аутентификации.
+func (c *Cli) encryptCell(cell *pb.MemoryCell) (*pb.MemoryCell, error) {
+	if c.vaultKey == nil {
+		return nil, errors.New("ключ шифрования не получен, выполните аутентификацию")
+	}
+	encrypted, err := storage.EncryptCell(cell, c.vaultKey)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось зашифровать данные: %w", err)
+	}
+	return encrypted, nil
+}
+
+// Sync - синхронизует данные пользователя на клиенте и предлагает пользователю сохранить резервную копию.
+// С сервера загружаются только изменения после последней синхронизации.
 func (c *Cli) Sync() {
+	if c.vaultKey == nil {
+		fmt.Println("- Выполните аутентификацию.")
+		return
+	}
+	if c.offline {
+		if err := c.reconnect(); err != nil {
+			fmt.Println("- Не удалось подключиться к серверу:", err)
+			return
+		}
+		fmt.Println("- Подключение к серверу восстановлено.")
+	}
+	// отправка изменений, сделанных без подключения к серверу
+	if err := c.replayJournal(); err != nil {
+		fmt.Println("- Ошибка при отправке изменений:", err)
+		return
+	}
+
 	fmt.Print("- Синхронизация... ")
-	data := c.GetAllData()
-	c.storage.Sync(data)
+	if err := c.syncChanges(); err != nil {
+		c.goOffline(err)
+		fmt.Println("ошибка:", err)
+		return
+	}
 	fmt.Println("успешна.")
+	c.saveLocal()
+	// загрузка файлов, не загруженных в прошлых сеансах или в автономном режиме
+	c.resumeUploads()
 	c.Dump()
 }
 
+// syncChanges - запрашивает у сервера изменения после ревизии локального хранилища и применяет их.
+func (c *Cli) syncChanges() error {
+	response, err := c.client.SyncSince(c.ctx, &pb.SyncSinceRequest{Revision: c.storage.Revision()})
+	if err != nil {
+		return err
+	}
+
+	// ревизия сервера меньше локальной - локальная копия не соответствует серверу, загружаем все заново
+	if response.Revision < c.storage.Revision() {
+		c.storage.Reset()
+		return c.syncChanges()
+	}
+
+	changed, err := c.decryptCells(response.Changed)
+	if err != nil {
+		return err
+	}
+	c.storage.ApplyDelta(changed, response.DeletedIds, response.Revision)
+
+	return nil
+}
+
 // Dump - предлагает сохранение резервной копии на диск.
 // Потребуется ввод пароля для шифрования данных.
 func (c *Cli) Dump() {
-	reader := bufio.NewReader(os.Stdin)
+	reader := c.stdin
 	// Запрашиваем у пользователя требуется ли сохранение резервной копии
 	fmt.Print("Хотите сохранить резервную копию? (y/n): ")
 	saveBackup, err := reader.ReadString('\n')
@@ -346,13 +920,11 @@ func (c *Cli) Dump() {
 	// Проверяем ответ пользователя
 	if saveBackup == "y" || saveBackup == "yes" {
 		// запрашиваем пароль для шифрования и сохранения дампа
-		fmt.Print("Введите пароль: ")
-		password, err := reader.ReadString('\n')
+		password, err := c.readNewSecret("Введите пароль: ")
 		if err != nil {
 			fmt.Println("Ошибка чтения ввода пользователя:", err)
 			return
 		}
-		password = strings.TrimSpace(password)
 
 		// делаем дамп
 		err = c.storage.DumpToFile(password)
@@ -371,6 +943,15 @@ func SetTokenContext(ctx context.Context, token string) context.Context {
 	return metadata.NewOutgoingContext(ctx, md)
 }
 
+// readLine - читает строку пользовательского ввода без завершающих пробельных символов.
+func readLine(reader *bufio.Reader) string {
+	input, err := reader.ReadString('\n')
+	if err != nil && input == "" {
+		return ""
+	}
+	return strings.TrimSpace(input)
+}
+
 // ReadFileBytes - чтения файла в массив байт
 func ReadFileBytes(filePath string) ([]byte, error) {
 	// Проверяем существование файла
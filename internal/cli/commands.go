@@ -0,0 +1,712 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bubu256/gophkeeper_pet/internal/kinds"
+	"github.com/bubu256/gophkeeper_pet/internal/proto/pb"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/client/passgen"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Коды завершения команд.
+const (
+	ExitOK = 0
+	// ExitError - команда не выполнена.
+	ExitError = 1
+	// ExitUsage - неверные аргументы или флаги команды.
+	ExitUsage = 2
+	// ExitAuth - неверный логин или мастер-пароль, сессия недействительна.
+	ExitAuth = 3
+	// ExitNotFound - данные не найдены или недоступны пользователю.
+	ExitNotFound = 4
+	// ExitUnavailable - сервер недоступен, а команда требует подключения к нему.
+	ExitUnavailable = 5
+)
+
+// Переменные окружения с учетными данными для команд.
+const (
+	envUser     = "GOPHKEEPER_USER"
+	envPassword = "GOPHKEEPER_PASSWORD"
+)
+
+// globalOptions - флаги, общие для всех команд. Указываются до имени команды.
+type globalOptions struct {
+	user          string
+	passwordStdin bool
+}
+
+// command - команда командной строки.
+type command struct {
+	args    string
+	summary string
+	run     func(c *Cli, opts globalOptions, args []string) error
+}
+
+// commands - команды командной строки по имени. Заполняется в init, так как команды ссылаются на справку по командам.
+var commands map[string]command
+
+func init() {
+	commands = map[string]command{
+		"login":    {"[имя]", "вход и загрузка изменений с сервера в локальную копию", (*Cli).runLogin},
+		"list":     {"[--kind вид] [--filter папка|#метка]", "список данных: InfoID, вид и описание через табуляцию", (*Cli).runList},
+		"get":      {"<InfoID> [--field поле] [--out папка]", "вывод данных или значения одного поля, сохранение файла из данных в папку", (*Cli).runGet},
+		"add":      {"<вид> [флаги]", "добавление данных, выводит InfoID новых данных", (*Cli).runAdd},
+		"rm":       {"<InfoID>...", "перемещение данных в корзину", (*Cli).runRemove},
+		"sync":     {"", "отправка изменений, сделанных без подключения к серверу, и загрузка изменений с сервера", (*Cli).runSync},
+		"logout":   {"[--all]", "завершение сессии на сервере и удаление сессии, сохраненной на устройстве", (*Cli).runLogout},
+		"agent":    {"[start|status|lock|stop] [--timeout время]", "фоновый агент, отдающий list и get данные без мастер-пароля", (*Cli).runAgent},
+		"tui":      {"", "полноэкранный интерфейс: поиск, просмотр и изменение данных", (*Cli).runTUI},
+		"audit":    {"[--min-score 3] [--max-age дни] [--expiry-days дни]", "проверка надежности: слабые и одинаковые пароли, старые пароли, истекающие карты", (*Cli).runAudit},
+		"generate": {"[--length N] [--pronounceable] [--words N] [флаги]", "генерация пароля или парольной фразы без входа на сервер", (*Cli).runGenerate},
+		"otp":      {"<InfoID> [--watch] | import", "текущий одноразовый код с обратным отсчетом, импорт ссылок otpauth:// из стандартного ввода", (*Cli).runOTP},
+	}
+}
+
+// commandError - ошибка команды с кодом завершения.
+type commandError struct {
+	code int
+	err  error
+}
+
+func (e *commandError) Error() string {
+	return e.err.Error()
+}
+
+func (e *commandError) Unwrap() error {
+	return e.err
+}
+
+// usageError - возвращает ошибку в аргументах команды.
+func usageError(format string, args ...interface{}) error {
+	return &commandError{code: ExitUsage, err: fmt.Errorf(format, args...)}
+}
+
+// errDataNotFound возвращается, если данных с указанным InfoID нет.
+var errDataNotFound = &commandError{code: ExitNotFound, err: errors.New("данные не найдены")}
+
+// Run - выполняет команду командной строки без интерактивного меню и возвращает код завершения.
+// Результат команды выводится в stdout, сообщения о ходе работы и ошибки - в stderr.
+// Учетные данные берутся из флагов --user и --password-stdin или из переменных окружения
+// GOPHKEEPER_USER и GOPHKEEPER_PASSWORD.
+func (c *Cli) Run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	c.stdin = bufio.NewReader(stdin)
+	c.stdinFd = -1
+	if file, ok := stdin.(*os.File); ok {
+		c.stdinFd = int(file.Fd())
+	}
+	c.stdout = stdout
+	c.messages = stderr
+
+	var opts globalOptions
+	global := flag.NewFlagSet("gophkeeper", flag.ContinueOnError)
+	global.SetOutput(stderr)
+	global.StringVar(&opts.user, "user", os.Getenv(envUser), "имя пользователя, по умолчанию из "+envUser)
+	global.BoolVar(&opts.passwordStdin, "password-stdin", false, "прочитать мастер-пароль из первой строки стандартного ввода, иначе он берется из "+envPassword)
+	format := global.String("output", os.Getenv(envOutput), "формат вывода результата: text, json или yaml, по умолчанию из "+envOutput)
+	global.Usage = func() { printUsage(stderr, global) }
+	if err := global.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return ExitOK
+		}
+		return ExitUsage
+	}
+	var err error
+	if c.format, err = parseFormat(*format); err != nil {
+		fmt.Fprintln(stderr, "gophkeeper:", err)
+		return ExitUsage
+	}
+
+	args = global.Args()
+	if len(args) == 0 {
+		printUsage(stderr, global)
+		return ExitUsage
+	}
+	if args[0] == "help" {
+		printUsage(stdout, global)
+		return ExitOK
+	}
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "gophkeeper: неизвестная команда %q\n", args[0])
+		printUsage(stderr, global)
+		return ExitUsage
+	}
+
+	if err := cmd.run(c, opts, args[1:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return ExitOK
+		}
+		fmt.Fprintf(stderr, "gophkeeper %s: %v\n", args[0], err)
+		return exitCode(err)
+	}
+	return ExitOK
+}
+
+// exitCode - возвращает код завершения для ошибки команды.
+func exitCode(err error) int {
+	var cmdErr *commandError
+	if errors.As(err, &cmdErr) {
+		return cmdErr.code
+	}
+	switch status.Code(err) {
+	case codes.Unauthenticated:
+		return ExitAuth
+	case codes.NotFound, codes.PermissionDenied:
+		return ExitNotFound
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return ExitUnavailable
+	case codes.InvalidArgument:
+		return ExitUsage
+	}
+	return ExitError
+}
+
+// printUsage - выводит справку по командам.
+func printUsage(w io.Writer, global *flag.FlagSet) {
+	fmt.Fprintln(w, "Использование: gophkeeper [--user имя] [--password-stdin] [--output формат] <команда> [аргументы]")
+	fmt.Fprintln(w, "Без команды запускается интерактивное меню.")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Команды:")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(w, "  %s %s\n\t%s\n", name, commands[name].args, commands[name].summary)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Флаги:")
+	global.SetOutput(w)
+	global.PrintDefaults()
+}
+
+// newFlagSet - возвращает набор флагов команды, ошибки разбора выводятся в stderr.
+func (c *Cli) newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet("gophkeeper "+name, flag.ContinueOnError)
+	fs.SetOutput(c.messages)
+	fs.Usage = func() {
+		fmt.Fprintf(c.messages, "Использование: gophkeeper %s %s\n", name, commands[name].args)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parseFlags - разбирает флаги команды, которые могут стоять и до, и после позиционных аргументов.
+// Возвращает позиционные аргументы.
+func parseFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, &commandError{code: ExitUsage, err: err}
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// authenticate - выполняет вход с учетными данными команды. Если пользователь не указан, используется
+// пользователь, чья сессия сохранена последней; сохраненная сессия продолжается без входа на сервере. Если сервер недоступен,
+// вход выполняется по локальной копии данных, и команда работает в автономном режиме.
+func (c *Cli) authenticate(opts globalOptions) error {
+	if opts.user == "" {
+		// пользователь, чья сессия сохранена последней
+		opts.user = c.storage.LastUser()
+	}
+	if opts.user == "" {
+		return usageError("не указан пользователь: флаг --user или переменная окружения %s", envUser)
+	}
+	password, err := c.masterPassword(opts)
+	if err != nil {
+		return err
+	}
+
+	if err := c.login(opts.user, password); err != nil {
+		if _, ok := status.FromError(err); !ok {
+			// мастер-пароль не подошел к локальной копии данных
+			return &commandError{code: ExitAuth, err: err}
+		}
+		return err
+	}
+	if c.agentLocked {
+		c.unlockAgent(opts.user, password)
+	}
+	return nil
+}
+
+// masterPassword - возвращает мастер-пароль из стандартного ввода или переменной окружения.
+// Если ни один источник не указан, пароль запрашивается у пользователя.
+func (c *Cli) masterPassword(opts globalOptions) (string, error) {
+	var password string
+	switch {
+	case opts.passwordStdin:
+		password = readLine(c.stdin)
+	case os.Getenv(envPassword) != "":
+		password = os.Getenv(envPassword)
+	default:
+		var err error
+		if password, err = c.readSecret("Мастер-пароль: "); err != nil {
+			return "", err
+		}
+	}
+	if password == "" {
+		return "", usageError("мастер-пароль не указан: флаг --password-stdin или переменная окружения %s", envPassword)
+	}
+	return password, nil
+}
+
+// synchronize - отправляет изменения из журнала, загружает изменения с сервера и сохраняет локальную копию.
+func (c *Cli) synchronize() error {
+	if c.offline {
+		if err := c.reconnect(); err != nil {
+			return err
+		}
+	}
+	if err := c.replayJournal(); err != nil {
+		return err
+	}
+	if err := c.syncChanges(); err != nil {
+		c.goOffline(err)
+		return err
+	}
+	c.saveLocal()
+	c.resumeUploads()
+	return nil
+}
+
+// runLogin - команда login: проверяет учетные данные и обновляет локальную копию данных.
+func (c *Cli) runLogin(opts globalOptions, args []string) error {
+	positional, err := parseFlags(c.newFlagSet("login"), args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 1 {
+		return usageError("лишние аргументы: %v", positional[1:])
+	}
+	if len(positional) == 1 {
+		opts.user = positional[0]
+	}
+
+	if err := c.authenticate(opts); err != nil {
+		return err
+	}
+	if c.offline {
+		fmt.Fprintln(c.messages, "- Сервер недоступен, вход выполнен по локальной копии данных (автономный режим).")
+		return c.writeSession()
+	}
+	if err := c.synchronize(); err != nil {
+		return err
+	}
+	fmt.Fprintln(c.messages, "- Вход выполнен, локальная копия данных обновлена.")
+	return c.writeSession()
+}
+
+// writeSession - выводит состояние сессии и локальной копии после входа и синхронизации.
+func (c *Cli) writeSession() error {
+	if !c.structured() {
+		return nil
+	}
+	return c.writeDocument(sessionDocument{
+		User:     c.username,
+		Offline:  c.offline,
+		Revision: c.storage.Revision(),
+		Pending:  c.journal.Len(),
+	})
+}
+
+// runSync - команда sync: синхронизирует локальную копию с сервером.
+func (c *Cli) runSync(opts globalOptions, args []string) error {
+	positional, err := parseFlags(c.newFlagSet("sync"), args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return usageError("лишние аргументы: %v", positional)
+	}
+
+	if err := c.authenticate(opts); err != nil {
+		return err
+	}
+	if err := c.synchronize(); err != nil {
+		return err
+	}
+	fmt.Fprintln(c.messages, "- Синхронизация успешна.")
+	return c.writeSession()
+}
+
+// runList - команда list: выводит InfoID, вид и описание данных через табуляцию, по одной записи в строке.
+func (c *Cli) runList(opts globalOptions, args []string) error {
+	fs := c.newFlagSet("list")
+	kind := fs.String("kind", "", "вывести только данные указанного вида: "+strings.Join(kinds.All, ", "))
+	filter := fs.String("filter", "", "вывести только данные из папки (ID или путь) или с меткой (#метка)")
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return usageError("лишние аргументы: %v", positional)
+	}
+	if *kind != "" {
+		if *kind, err = kinds.Parse(*kind); err != nil {
+			return usageError("%v", err)
+		}
+	}
+
+	var infoCells []*pb.InfoCell
+	var folders []*pb.Folder
+	if response, ok := c.agentList(opts); ok {
+		infoCells, folders = response.Info, response.Folders
+	} else {
+		if err := c.authenticate(opts); err != nil {
+			return err
+		}
+		if infoCells, err = c.information(); err != nil {
+			return err
+		}
+		if *filter != "" || c.structured() {
+			if folders, err = c.loadFolders(); err != nil {
+				return err
+			}
+		}
+	}
+	if infoCells, err = filterInfo(infoCells, folders, *filter); err != nil {
+		return usageError("%v", err)
+	}
+
+	docs := make([]infoDocument, 0, len(infoCells))
+	for _, info := range infoCells {
+		if *kind != "" && info.DataType != *kind {
+			continue
+		}
+		if !c.structured() {
+			fmt.Fprintf(c.stdout, "%d\t%s\t%s\n", info.Id, info.DataType, info.Description)
+			continue
+		}
+		docs = append(docs, infoDocumentOf(info, folders))
+	}
+	if c.structured() {
+		return c.writeDocument(docs)
+	}
+	return nil
+}
+
+// runGet - команда get: выводит данные, значение одного поля или сохраняет файл из данных в папку.
+func (c *Cli) runGet(opts globalOptions, args []string) error {
+	fs := c.newFlagSet("get")
+	field := fs.String("field", "", "вывести только значение поля: description, поле содержимого (url, username, password, number, holder, expiry, cvv, text, issuer, account, secret, type, algorithm, digits, period, counter) или ключ дополнительного поля")
+	out := fs.String("out", "", "сохранить файл из данных в указанную папку")
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return usageError("укажите один InfoID")
+	}
+	infoID, err := strconv.ParseInt(positional[0], 10, 64)
+	if err != nil {
+		return usageError("неверный InfoID %q", positional[0])
+	}
+
+	var data *pb.MemoryCell
+	var folders []*pb.Folder
+	response, ok, err := c.agentGet(opts, infoID)
+	if err != nil {
+		return err
+	}
+	if ok && !(response.Data.Blob && *out != "") {
+		data, folders = response.Data, response.Folders
+	} else {
+		// большой файл загружается с сервера и расшифровывается ключом, которого у команды без входа нет
+		if err := c.authenticate(opts); err != nil {
+			return err
+		}
+		if data, err = c.getCell(infoID); err != nil {
+			return err
+		}
+		if data == nil {
+			return errDataNotFound
+		}
+		folders = c.storage.Folders()
+	}
+
+	if *field != "" {
+		value, ok := cellField(data, *field)
+		if !ok {
+			return &commandError{code: ExitNotFound, err: fmt.Errorf("поле %q не найдено", *field)}
+		}
+		if c.structured() {
+			return c.writeDocument(fieldDocument{ID: data.Info.Id, Field: *field, Value: value})
+		}
+		fmt.Fprintln(c.stdout, value)
+		return nil
+	}
+
+	doc := cellDocumentOf(data, folders)
+	if *out != "" {
+		if err := c.saveFile(data, *out); err != nil {
+			return err
+		}
+		fmt.Fprintln(c.messages, "- Файл сохранен:", filepath.Join(*out, data.FileName))
+		if !c.structured() {
+			return nil
+		}
+		savedFileDocument(&doc, *out)
+	}
+	if c.structured() {
+		return c.writeDocument(doc)
+	}
+	printCell(c.stdout, data, folders)
+	return nil
+}
+
+// cellField - возвращает значение поля данных по имени: описание, поле содержимого или дополнительное поле.
+func cellField(data *pb.MemoryCell, name string) (string, bool) {
+	if name == "description" {
+		return data.Info.Description, true
+	}
+	for _, field := range payloadFields(data) {
+		if field.name == name {
+			return *field.value, true
+		}
+	}
+	value, ok := data.KeyValuePairs[name]
+	return value, ok
+}
+
+// addFlags - флаги команды add для полей содержимого: имя флага и имя поля.
+var addFlags = []struct{ flag, field, usage string }{
+	{"url", "url", "адрес сайта (login)"},
+	{"user", "username", "логин на сайте (login)"},
+	{"holder", "holder", "владелец карты (card)"},
+	{"expiry", "expiry", "срок действия карты в формате MM/YY (card)"},
+	{"issuer", "issuer", "сервис (otp)"},
+	{"account", "account", "аккаунт в сервисе (otp)"},
+	{"otp-type", "type", "тип кодов: totp или hotp, по умолчанию totp (otp)"},
+	{"algorithm", "algorithm", "алгоритм: SHA1, SHA256 или SHA512, по умолчанию SHA1 (otp)"},
+	{"digits", "digits", "количество цифр кода: 6 или 8, по умолчанию 6 (otp)"},
+	{"period", "period", "период смены кода TOTP в секундах, по умолчанию 30 (otp)"},
+	{"counter", "counter", "начальный счетчик HOTP, по умолчанию 0 (otp)"},
+}
+
+// runAdd - команда add: добавляет данные указанного вида и выводит их InfoID.
+// Секретные поля не передаются флагами, чтобы не попасть в историю команд и список процессов:
+// они читаются из стандартного ввода по одному в строке, текст заметки - до конца ввода.
+func (c *Cli) runAdd(opts globalOptions, args []string) error {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		return usageError("укажите вид данных: %s", strings.Join(kinds.All, ", "))
+	}
+	kind, err := kinds.Parse(args[0])
+	if err != nil {
+		return usageError("%v", err)
+	}
+
+	fs := c.newFlagSet("add")
+	description := fs.String("description", "", "описание данных")
+	values := make(map[string]*string, len(addFlags))
+	for _, f := range addFlags {
+		values[f.field] = fs.String(f.flag, "", f.usage)
+	}
+	file := fs.String("file", "", "путь к файлу (file)")
+	secretStdin := fs.Bool("secret-stdin", false, "прочитать из стандартного ввода поля без флагов: пароль (login), номер карты и CVV (card), текст заметки (note), секретный ключ (otp)")
+	generate := fs.Bool("generate", false, "сгенерировать пароль (login) вместо чтения из стандартного ввода")
+	extra := keyValueFlag{}
+	fs.Var(extra, "field", "дополнительное поле в виде ключ=значение, можно указать несколько раз")
+	positional, err := parseFlags(fs, args[1:])
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return usageError("лишние аргументы: %v", positional)
+	}
+
+	cell := &pb.MemoryCell{
+		Info:          &pb.InfoCell{DataType: kind, Description: *description},
+		KeyValuePairs: extra,
+	}
+	ensurePayload(cell)
+	fields := make(map[string]payloadField)
+	for _, field := range payloadFields(cell) {
+		fields[field.name] = field
+	}
+	var flagErr error
+	fs.Visit(func(f *flag.Flag) {
+		for _, af := range addFlags {
+			if af.flag == f.Name && fields[af.field].value == nil {
+				flagErr = usageError("флаг --%s не используется для данных вида %s", f.Name, kind)
+			}
+		}
+		if f.Name == "file" && kind != kinds.File || f.Name == "secret-stdin" && kind == kinds.File || f.Name == "generate" && kind != kinds.Login {
+			flagErr = usageError("флаг --%s не используется для данных вида %s", f.Name, kind)
+		}
+	})
+	if flagErr != nil {
+		return flagErr
+	}
+
+	// мастер-пароль читается из стандартного ввода раньше секретных полей
+	if err := c.authenticate(opts); err != nil {
+		return err
+	}
+
+	var largeFile string
+	if kind == kinds.File {
+		if *file == "" {
+			return usageError("укажите путь к файлу флагом --file")
+		}
+		if largeFile, err = attachFile(cell, *file); err != nil {
+			return err
+		}
+		cell.GetFile().MediaType = mediaType(cell.FileName)
+	} else {
+		for _, field := range payloadFields(cell) {
+			value, hasFlag := values[field.name]
+			input := ""
+			switch {
+			case hasFlag:
+				input = *value
+			case *generate && field.generate:
+				var result generated
+				if result, err = generatePassword(passgen.DefaultOptions()); err == nil {
+					input = result.value
+					fmt.Fprintf(c.messages, "- %s сгенерирован (%s).\n", field.label, result)
+				}
+			case *secretStdin && field.multiline:
+				input, err = readAll(c.stdin)
+			case *secretStdin:
+				input = readLine(c.stdin)
+			}
+			if err != nil {
+				return err
+			}
+			if err := setField(field, input); err != nil {
+				if !hasFlag && !*secretStdin {
+					return usageError("%s: %v, передайте значение через --secret-stdin", field.label, err)
+				}
+				return usageError("%s: %v", field.label, err)
+			}
+		}
+		if err := normalizeOTP(cell); err != nil {
+			return usageError("%v", err)
+		}
+	}
+
+	c.warnBreached(cell)
+	infoID, err := c.sendNewCell(cell)
+	if err != nil {
+		return err
+	}
+	if infoID < 0 {
+		fmt.Fprintln(c.messages, "- Сервер недоступен, данные будут отправлены при синхронизации.")
+	}
+	if largeFile != "" {
+		c.uploadFile(infoID, largeFile)
+	}
+	if c.structured() {
+		return c.writeDocument(addedDocument{ID: infoID, Pending: infoID < 0})
+	}
+	fmt.Fprintln(c.stdout, infoID)
+	return nil
+}
+
+// runRemove - команда rm: перемещает данные в корзину.
+func (c *Cli) runRemove(opts globalOptions, args []string) error {
+	positional, err := parseFlags(c.newFlagSet("rm"), args)
+	if err != nil {
+		return err
+	}
+	infoIDs, err := parseIDs(strings.Join(positional, " "))
+	if err != nil {
+		return usageError("%v", err)
+	}
+
+	if err := c.authenticate(opts); err != nil {
+		return err
+	}
+	if err := c.deleteCells(infoIDs); err != nil {
+		return err
+	}
+	fmt.Fprintln(c.messages, "- Данные перемещены в корзину.")
+	if c.structured() {
+		return c.writeDocument(removedDocument{Removed: infoIDs})
+	}
+	return nil
+}
+
+// runLogout - команда logout: завершает сессию на сервере и удаляет сессию, сохраненную на устройстве.
+func (c *Cli) runLogout(opts globalOptions, args []string) error {
+	fs := c.newFlagSet("logout")
+	allDevices := fs.Bool("all", false, "завершить сессии пользователя на всех устройствах")
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return usageError("лишние аргументы: %v", positional)
+	}
+
+	if err := c.authenticate(opts); err != nil {
+		return err
+	}
+	username := c.username
+	if c.offline {
+		c.endSession()
+		return &commandError{code: ExitUnavailable, err: errors.New("сервер недоступен: сессия удалена на этом устройстве, но не завершена на сервере")}
+	}
+	if _, err := c.client.Logout(c.ctx, &pb.LogoutRequest{AllDevices: *allDevices}); err != nil {
+		return err
+	}
+	c.endSession()
+	fmt.Fprintln(c.messages, "- Сессия завершена.")
+	if c.structured() {
+		return c.writeDocument(logoutDocument{User: username, AllDevices: *allDevices})
+	}
+	return nil
+}
+
+// keyValueFlag - флаг ключ=значение, который можно указать несколько раз.
+type keyValueFlag map[string]string
+
+func (f keyValueFlag) String() string {
+	pairs := make([]string, 0, len(f))
+	for key, value := range f {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func (f keyValueFlag) Set(s string) error {
+	key, value, found := strings.Cut(s, "=")
+	if !found || key == "" {
+		return fmt.Errorf("ожидается ключ=значение, получено %q", s)
+	}
+	f[key] = value
+	return nil
+}
+
+// readAll - читает ввод до конца без завершающего перевода строки.
+func readAll(reader *bufio.Reader) (string, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return "", fmt.Errorf("ошибка чтения стандартного ввода: %w", err)
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
@@ -0,0 +1,598 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bubu256/gophkeeper_pet/internal/cli"
+	"github.com/bubu256/gophkeeper_pet/internal/proto/pb"
+	"github.com/bubu256/gophkeeper_pet/internal/schema"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/breach"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/client/storage"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/otp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	testUser     = "alice"
+	testPassword = "master password"
+)
+
+// fakeServer - сервер в памяти: хранит зашифрованные клиентом данные одного пользователя.
+type fakeServer struct {
+	pb.GophKeeperServiceClient
+	authKey  string
+	cells    map[int64]*pb.MemoryCell
+	nextID   int64
+	revision int64
+	// tokens, refreshTokens - действующие токены сессий, logins - количество входов по паролю.
+	tokens        map[string]bool
+	refreshTokens map[string]bool
+	sessions      int
+	logins        int
+	// breached - пароли из базы утечек сервера, prefixes - префиксы хешей, полученные сервером.
+	breached map[string]int64
+	prefixes []string
+}
+
+func newFakeServer() *fakeServer {
+	return &fakeServer{
+		authKey:       storage.DeriveKeys(testUser, testPassword).Auth,
+		cells:         make(map[int64]*pb.MemoryCell),
+		tokens:        make(map[string]bool),
+		refreshTokens: make(map[string]bool),
+	}
+}
+
+// newSession - выдает новую пару токенов.
+func (s *fakeServer) newSession() (string, string) {
+	s.sessions++
+	token, refreshToken := fmt.Sprintf("token-%d", s.sessions), fmt.Sprintf("refresh-%d", s.sessions)
+	s.tokens[token] = true
+	s.refreshTokens[refreshToken] = true
+	return token, refreshToken
+}
+
+func (s *fakeServer) PreLogin(ctx context.Context, in *pb.PreLoginRequest, opts ...grpc.CallOption) (*pb.PreLoginResponse, error) {
+	return &pb.PreLoginResponse{Kdf: schema.ClientKDFArgon2id}, nil
+}
+
+func (s *fakeServer) Authenticate(ctx context.Context, in *pb.AuthenticationRequest, opts ...grpc.CallOption) (*pb.AuthenticationResponse, error) {
+	if in.Username != testUser || in.Password != s.authKey {
+		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+	}
+	s.logins++
+	token, refreshToken := s.newSession()
+	return &pb.AuthenticationResponse{Token: token, RefreshToken: refreshToken, ExpiresAt: timestamppb.New(time.Now().Add(time.Hour))}, nil
+}
+
+func (s *fakeServer) Authorize(ctx context.Context, in *pb.AuthorizationRequest, opts ...grpc.CallOption) (*pb.AuthorizationResponse, error) {
+	return &pb.AuthorizationResponse{Success: s.tokens[in.Token]}, nil
+}
+
+func (s *fakeServer) RefreshToken(ctx context.Context, in *pb.RefreshTokenRequest, opts ...grpc.CallOption) (*pb.RefreshTokenResponse, error) {
+	if !s.refreshTokens[in.RefreshToken] {
+		return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+	}
+	delete(s.refreshTokens, in.RefreshToken)
+	token, refreshToken := s.newSession()
+	return &pb.RefreshTokenResponse{Token: token, RefreshToken: refreshToken, ExpiresAt: timestamppb.New(time.Now().Add(time.Hour))}, nil
+}
+
+func (s *fakeServer) Logout(ctx context.Context, in *pb.LogoutRequest, opts ...grpc.CallOption) (*pb.LogoutResponse, error) {
+	s.tokens = make(map[string]bool)
+	s.refreshTokens = make(map[string]bool)
+	return &pb.LogoutResponse{}, nil
+}
+
+func (s *fakeServer) AddData(ctx context.Context, in *pb.AddDataRequest, opts ...grpc.CallOption) (*pb.AddDataResponse, error) {
+	s.nextID++
+	s.revision++
+	cell := proto.Clone(in.Data).(*pb.MemoryCell)
+	cell.Info.Id = s.nextID
+	cell.Info.Revision = s.revision
+	s.cells[cell.Info.Id] = cell
+	return &pb.AddDataResponse{Id: cell.Info.Id, Revision: s.revision}, nil
+}
+
+func (s *fakeServer) UpdateData(ctx context.Context, in *pb.UpdateDataRequest, opts ...grpc.CallOption) (*pb.UpdateDataResponse, error) {
+	current, ok := s.cells[in.Data.Info.Id]
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+	if in.ExpectedRevision != 0 && in.ExpectedRevision != current.Info.Revision {
+		return nil, status.Error(codes.Aborted, "revision conflict")
+	}
+	s.revision++
+	cell := proto.Clone(in.Data).(*pb.MemoryCell)
+	cell.Info.Revision = s.revision
+	s.cells[cell.Info.Id] = cell
+	return &pb.UpdateDataResponse{Success: true, Revision: s.revision}, nil
+}
+
+func (s *fakeServer) GetInformation(ctx context.Context, in *pb.GetInformationRequest, opts ...grpc.CallOption) (*pb.GetInformationResponse, error) {
+	response := &pb.GetInformationResponse{}
+	for id := int64(1); id <= s.nextID; id++ {
+		if cell, ok := s.cells[id]; ok {
+			response.Info = append(response.Info, cell.Info)
+		}
+	}
+	return response, nil
+}
+
+func (s *fakeServer) RetrieveData(ctx context.Context, in *pb.RetrieveDataRequest, opts ...grpc.CallOption) (*pb.RetrieveDataResponse, error) {
+	response := &pb.RetrieveDataResponse{}
+	for _, id := range in.Ids {
+		if cell, ok := s.cells[id]; ok {
+			response.Data = append(response.Data, cell)
+		}
+	}
+	return response, nil
+}
+
+func (s *fakeServer) DeleteData(ctx context.Context, in *pb.DeleteDataRequest, opts ...grpc.CallOption) (*pb.DeleteDataResponse, error) {
+	for _, id := range in.Ids {
+		if _, ok := s.cells[id]; !ok {
+			return nil, status.Error(codes.PermissionDenied, "access denied")
+		}
+	}
+	for _, id := range in.Ids {
+		delete(s.cells, id)
+	}
+	s.revision++
+	return &pb.DeleteDataResponse{Success: true}, nil
+}
+
+func (s *fakeServer) SyncSince(ctx context.Context, in *pb.SyncSinceRequest, opts ...grpc.CallOption) (*pb.SyncSinceResponse, error) {
+	response := &pb.SyncSinceResponse{Revision: s.revision}
+	for _, cell := range s.cells {
+		if cell.Info.Revision > in.Revision {
+			response.Changed = append(response.Changed, cell)
+		}
+	}
+	return response, nil
+}
+
+func (s *fakeServer) CheckPasswordPrefix(ctx context.Context, in *pb.CheckPasswordPrefixRequest, opts ...grpc.CallOption) (*pb.CheckPasswordPrefixResponse, error) {
+	if s.breached == nil {
+		return nil, status.Error(codes.Unimplemented, "breach dataset is not configured")
+	}
+	s.prefixes = append(s.prefixes, in.Prefix)
+	response := &pb.CheckPasswordPrefixResponse{}
+	for password, count := range s.breached {
+		if prefix, suffix := breach.Hash(password); prefix == in.Prefix {
+			response.Suffixes = append(response.Suffixes, &pb.BreachedSuffix{Suffix: suffix, Count: count})
+		}
+	}
+	return response, nil
+}
+
+func (s *fakeServer) ListFolders(ctx context.Context, in *pb.ListFoldersRequest, opts ...grpc.CallOption) (*pb.ListFoldersResponse, error) {
+	return &pb.ListFoldersResponse{}, nil
+}
+
+// runCommand - выполняет команду новым экземпляром клиента и возвращает код завершения и вывод команды.
+func runCommand(server *fakeServer, stdin string, args ...string) (int, string, string) {
+	c := cli.NewCli(server, context.Background(), storage.NewStorage())
+	var stdout, stderr bytes.Buffer
+	code := c.Run(args, strings.NewReader(stdin), &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestRun_Usage(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	server := newFakeServer()
+
+	tests := []struct {
+		name string
+		args []string
+		code int
+	}{
+		{name: "no command", args: nil, code: cli.ExitUsage},
+		{name: "help", args: []string{"help"}, code: cli.ExitOK},
+		{name: "unknown command", args: []string{"unknown"}, code: cli.ExitUsage},
+		{name: "unknown flag", args: []string{"list", "--size", "1"}, code: cli.ExitUsage},
+		{name: "no id", args: []string{"get"}, code: cli.ExitUsage},
+		{name: "invalid id", args: []string{"--user", testUser, "get", "abc"}, code: cli.ExitUsage},
+		{name: "unknown kind", args: []string{"add", "car"}, code: cli.ExitUsage},
+		{name: "flag of another kind", args: []string{"add", "note", "--url", "example.com"}, code: cli.ExitUsage},
+		{name: "no user", args: []string{"list"}, code: cli.ExitUsage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, _, _ := runCommand(server, testPassword+"\n", tt.args...)
+			assert.Equal(t, tt.code, code)
+		})
+	}
+}
+
+func TestRun_Commands(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	server := newFakeServer()
+	login := []string{"--user", testUser, "--password-stdin"}
+
+	// мастер-пароль и пароль сайта читаются из стандартного ввода по одной строке
+	code, stdout, stderr := runCommand(server, testPassword+"\nsite password\n",
+		append(login, "add", "login", "--description", "Почта", "--url", "mail.example.com", "--user", "bob", "--field", "recovery=code", "--secret-stdin")...)
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.Equal(t, "1\n", stdout)
+	require.Contains(t, server.cells, int64(1))
+	assert.NotContains(t, server.cells[1].String(), "site password", "данные отправляются на сервер зашифрованными")
+
+	code, stdout, stderr = runCommand(server, testPassword+"\nline 1\nline 2\n", append(login, "add", "note", "--description", "Заметка", "--secret-stdin")...)
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.Equal(t, "2\n", stdout)
+
+	code, stdout, _ = runCommand(server, testPassword+"\n", append(login, "list")...)
+	assert.Equal(t, cli.ExitOK, code)
+	assert.Equal(t, "1\tlogin\tПочта\n2\tnote\tЗаметка\n", stdout)
+	code, stdout, _ = runCommand(server, testPassword+"\n", append(login, "list", "--kind", "note")...)
+	assert.Equal(t, cli.ExitOK, code)
+	assert.Equal(t, "2\tnote\tЗаметка\n", stdout)
+
+	code, stdout, _ = runCommand(server, testPassword+"\n", append(login, "get", "1", "--field", "password")...)
+	assert.Equal(t, cli.ExitOK, code)
+	assert.Equal(t, "site password\n", stdout)
+	code, stdout, _ = runCommand(server, testPassword+"\n", append(login, "get", "--field", "url", "1")...)
+	assert.Equal(t, cli.ExitOK, code)
+	assert.Equal(t, "https://mail.example.com\n", stdout)
+	code, stdout, _ = runCommand(server, testPassword+"\n", append(login, "get", "2", "--field", "text")...)
+	assert.Equal(t, cli.ExitOK, code)
+	assert.Equal(t, "line 1\nline 2\n", stdout)
+	code, _, _ = runCommand(server, testPassword+"\n", append(login, "get", "1", "--field", "missing")...)
+	assert.Equal(t, cli.ExitNotFound, code)
+	code, _, _ = runCommand(server, testPassword+"\n", append(login, "get", "99")...)
+	assert.Equal(t, cli.ExitNotFound, code)
+
+	code, _, _ = runCommand(server, testPassword+"\n", append(login, "rm", "1", "99")...)
+	assert.Equal(t, cli.ExitNotFound, code)
+	code, _, stderr = runCommand(server, testPassword+"\n", append(login, "rm", "1")...)
+	assert.Equal(t, cli.ExitOK, code, stderr)
+	assert.NotContains(t, server.cells, int64(1))
+
+	code, _, stderr = runCommand(server, testPassword+"\n", append(login, "sync")...)
+	assert.Equal(t, cli.ExitOK, code, stderr)
+}
+
+func TestRun_InvalidInput(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	server := newFakeServer()
+	login := []string{"--user", testUser, "--password-stdin"}
+
+	code, _, _ := runCommand(server, "wrong password\n", append(login, "list")...)
+	assert.Equal(t, cli.ExitAuth, code)
+
+	// обязательный пароль сайта не передан
+	code, _, _ = runCommand(server, testPassword+"\n", append(login, "add", "login", "--url", "example.com")...)
+	assert.Equal(t, cli.ExitUsage, code)
+	// номер карты не проходит проверку
+	code, _, _ = runCommand(server, testPassword+"\n4111111111111112\n", append(login, "add", "card", "--expiry", "12/30", "--secret-stdin")...)
+	assert.Equal(t, cli.ExitUsage, code)
+	assert.Empty(t, server.cells)
+}
+
+func TestRun_PasswordPrompt(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	server := newFakeServer()
+
+	// стандартный ввод не терминал: мастер-пароль читается строкой без отключения отображения
+	code, stdout, stderr := runCommand(server, testPassword+"\n", "--user", testUser, "list")
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.Empty(t, stdout)
+	assert.True(t, strings.HasPrefix(stderr, "Мастер-пароль: "), stderr)
+	assert.NotContains(t, stderr, testPassword)
+}
+
+func TestRun_Output(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	server := newFakeServer()
+	login := []string{"--user", testUser, "--password-stdin"}
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "report.txt")
+	require.NoError(t, os.WriteFile(path, []byte("report"), 0600))
+	code, stdout, stderr := runCommand(server, testPassword+"\n", append(login, "--output", "json", "add", "file", "--description", "Отчет", "--file", path)...)
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.JSONEq(t, `{"id": 1, "pending": false}`, stdout)
+
+	code, stdout, _ = runCommand(server, testPassword+"\n", append(login, "--output", "json", "list")...)
+	assert.Equal(t, cli.ExitOK, code)
+	assert.JSONEq(t, `[{"id": 1, "kind": "file", "description": "Отчет", "size": 0, "revision": 1}]`, stdout)
+
+	code, stdout, _ = runCommand(server, testPassword+"\n", append(login, "--output", "json", "get", "1")...)
+	assert.Equal(t, cli.ExitOK, code)
+	assert.JSONEq(t, `{
+		"id": 1, "kind": "file", "description": "Отчет", "size": 0, "revision": 1,
+		"fields": {"media-type": "text/plain; charset=utf-8"},
+		"file": {"name": "report.txt", "size": 6, "data": "cmVwb3J0"}
+	}`, stdout)
+
+	// файл сохраняется на диск, в документе выводится путь к нему
+	out := t.TempDir()
+	code, stdout, _ = runCommand(server, testPassword+"\n", append(login, "--output", "yaml", "get", "1", "--out", out)...)
+	assert.Equal(t, cli.ExitOK, code)
+	var doc map[string]interface{}
+	require.NoError(t, yaml.Unmarshal([]byte(stdout), &doc))
+	assert.Equal(t, "Отчет", doc["description"])
+	assert.Equal(t, map[string]interface{}{"name": "report.txt", "size": 6, "path": filepath.Join(out, "report.txt")}, doc["file"])
+	data, err := os.ReadFile(filepath.Join(out, "report.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "report", string(data))
+
+	code, _, _ = runCommand(server, testPassword+"\n", append(login, "--output", "xml", "list")...)
+	assert.Equal(t, cli.ExitUsage, code)
+}
+
+func TestRun_Session(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	server := newFakeServer()
+
+	code, _, stderr := runCommand(server, testPassword+"\n", "--user", testUser, "--password-stdin", "login")
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.Equal(t, 1, server.logins)
+
+	// следующий запуск продолжает сохраненную сессию последнего пользователя без входа по паролю
+	code, _, stderr = runCommand(server, testPassword+"\n", "--password-stdin", "list")
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.Equal(t, 1, server.logins)
+
+	// истекший токен доступа обновляется
+	server.tokens = make(map[string]bool)
+	code, _, stderr = runCommand(server, testPassword+"\n", "--password-stdin", "list")
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.Equal(t, 1, server.logins)
+
+	// сохраненная сессия не открывается с неверным мастер-паролем
+	code, _, _ = runCommand(server, "wrong password\n", "--password-stdin", "list")
+	assert.Equal(t, cli.ExitAuth, code)
+
+	code, _, stderr = runCommand(server, testPassword+"\n", "--password-stdin", "logout")
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.Empty(t, server.tokens)
+
+	// после выхода сессия не сохранена, нужен вход по паролю
+	code, _, _ = runCommand(server, testPassword+"\n", "--password-stdin", "list")
+	assert.Equal(t, cli.ExitUsage, code)
+	code, _, stderr = runCommand(server, testPassword+"\n", "--user", testUser, "--password-stdin", "list")
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.Equal(t, 2, server.logins)
+}
+
+func TestRun_Generate(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	server := newFakeServer()
+	login := []string{"--user", testUser, "--password-stdin"}
+
+	// генерация не требует входа на сервер
+	code, stdout, stderr := runCommand(server, "", "generate", "--length", "32", "--no-symbols")
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.Regexp(t, `^[a-zA-Z0-9]{32}\n$`, stdout)
+	assert.Contains(t, stderr, "Энтропия: 191 бит (очень надежный)")
+
+	code, stdout, _ = runCommand(server, "", "generate", "--words", "5", "--separator", " ")
+	assert.Equal(t, cli.ExitOK, code)
+	assert.Len(t, strings.Fields(stdout), 5)
+
+	code, stdout, _ = runCommand(server, "", "--output", "yaml", "generate", "--pronounceable", "--length", "10", "--no-digits", "--no-symbols", "--no-upper")
+	assert.Equal(t, cli.ExitOK, code)
+	var doc map[string]interface{}
+	require.NoError(t, yaml.Unmarshal([]byte(stdout), &doc))
+	assert.Len(t, doc["value"], 10)
+	assert.Equal(t, 32, doc["entropy"])
+	assert.Equal(t, "слабый", doc["strength"])
+
+	code, _, _ = runCommand(server, "", "generate", "--length", "2")
+	assert.Equal(t, cli.ExitUsage, code)
+	code, _, _ = runCommand(server, "", "generate", "--no-lower", "--no-upper", "--no-digits", "--no-symbols")
+	assert.Equal(t, cli.ExitUsage, code)
+
+	// пароль генерируется при добавлении данных
+	code, _, stderr = runCommand(server, testPassword+"\n", append(login, "add", "login", "--description", "Почта", "--generate")...)
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.Contains(t, stderr, "Пароль сгенерирован")
+	code, stdout, _ = runCommand(server, testPassword+"\n", append(login, "get", "1", "--field", "password")...)
+	assert.Equal(t, cli.ExitOK, code)
+	assert.Len(t, strings.TrimSpace(stdout), 20)
+	code, _, _ = runCommand(server, testPassword+"\n", append(login, "add", "note", "--generate")...)
+	assert.Equal(t, cli.ExitUsage, code)
+}
+
+func TestRun_Audit(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	server := newFakeServer()
+	login := []string{"--user", testUser, "--password-stdin"}
+	strong := "correct-Horse-battery-staple-91"
+
+	add := func(secrets string, args ...string) {
+		code, _, stderr := runCommand(server, testPassword+"\n"+secrets, append(append(login, "add"), append(args, "--secret-stdin")...)...)
+		require.Equal(t, cli.ExitOK, code, stderr)
+	}
+	add("password1\n", "login", "--description", "Почта")
+	add(strong+"\n", "login", "--description", "Банк")
+	add(strong+"\n", "login", "--description", "Форум")
+	add("4111111111111111\n123\n", "card", "--description", "Visa", "--expiry", "01/20")
+
+	// время создания и изменения данных выдает сервер
+	server.revision++
+	server.cells[3].Info.Revision = server.revision
+	server.cells[3].Info.CreatedAt = timestamppb.New(time.Now().AddDate(-2, 0, 0))
+	server.cells[3].Info.UpdatedAt = server.cells[3].Info.CreatedAt
+
+	code, stdout, stderr := runCommand(server, testPassword+"\n", append(login, "audit")...)
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.Contains(t, stdout, "Проверено данных: 4 (с паролями: 3, карт: 1).")
+	assert.Contains(t, stdout, "Слабые пароли: 1\n\t1\tПочта")
+	assert.Contains(t, stdout, "Одинаковые пароли: 1\n\t2 Банк, 3 Форум\n")
+	assert.Contains(t, stdout, "Пароли, не менявшиеся больше 365 дней: 1\n\t3\tФорум")
+	assert.Contains(t, stdout, "\t4\tVisa\t01/20, срок действия истек\n")
+	assert.Contains(t, stdout, "Замечаний: 4.")
+	assert.NotContains(t, stdout, strong, "пароли в отчет не выводятся")
+
+	code, stdout, _ = runCommand(server, testPassword+"\n", append(login, "--output", "json", "audit", "--max-age", "1000", "--min-score", "0")...)
+	require.Equal(t, cli.ExitOK, code)
+	assert.JSONEq(t, `{
+		"checked": 4, "passwords": 3, "cards": 1,
+		"weak": [],
+		"reused": [[{"id": 2, "kind": "login", "description": "Банк"}, {"id": 3, "kind": "login", "description": "Форум"}]],
+		"old": [],
+		"expiring": [{"id": 4, "kind": "card", "description": "Visa", "expiry": "01/20", "expired": true, "daysLeft": 0}],
+		"breached": []
+	}`, stdout)
+
+	// после смены пароля давно созданные данные не считаются устаревшими
+	server.revision++
+	server.cells[3].Info.Revision = server.revision
+	server.cells[3].Info.UpdatedAt = timestamppb.Now()
+	code, stdout, stderr = runCommand(server, testPassword+"\n", append(login, "audit")...)
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.NotContains(t, stdout, "Пароли, не менявшиеся")
+	assert.Contains(t, stdout, "Замечаний: 3.")
+
+	code, _, _ = runCommand(server, testPassword+"\n", append(login, "audit", "--min-score", "5")...)
+	assert.Equal(t, cli.ExitUsage, code)
+}
+
+func TestRun_Breaches(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	server := newFakeServer()
+	login := []string{"--user", testUser, "--password-stdin"}
+
+	// локальная база в формате HIBP range: файл на каждый префикс хеша
+	dir := t.TempDir()
+	prefix, suffix := breach.Hash("password1")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, prefix+".txt"), []byte(suffix+":2418984\n"), 0o600))
+
+	// при добавлении пароль из утечек вызывает предупреждение, но данные сохраняются
+	c := cli.NewCli(server, context.Background(), storage.NewStorage())
+	c.SetBreachCheck(dir, false)
+	var stdout, stderr bytes.Buffer
+	code := c.Run(append(login, "add", "login", "--description", "Почта", "--secret-stdin"), strings.NewReader(testPassword+"\npassword1\n"), &stdout, &stderr)
+	require.Equal(t, cli.ExitOK, code, stderr.String())
+	assert.Contains(t, stderr.String(), "пароль встречается в известных утечках (2418984 раз)")
+	require.Len(t, server.cells, 1)
+
+	code, out, _ := runCommand(server, testPassword+"\nx8#Lq2!vR7zT\n", append(login, "add", "login", "--description", "Банк", "--secret-stdin")...)
+	require.Equal(t, cli.ExitOK, code)
+	assert.NotContains(t, out, "утечках")
+
+	code, out, errOut := runCommand(server, testPassword+"\n", append(login, "audit", "--breach-data", dir)...)
+	require.Equal(t, cli.ExitOK, code, errOut)
+	assert.Contains(t, out, "Пароли из известных утечек: 1\n\t1\tПочта\tвстречается в утечках 2418984 раз\n")
+
+	// без базы проверка не выполняется
+	code, out, _ = runCommand(server, testPassword+"\n", append(login, "--output", "json", "audit")...)
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, `"breached": []`)
+
+	// база сервера получает только префиксы хешей
+	code, _, errOut = runCommand(server, testPassword+"\n", append(login, "audit", "--breach-server")...)
+	assert.Equal(t, cli.ExitError, code)
+	assert.Contains(t, errOut, "на сервере не настроена база утечек")
+
+	server.breached = map[string]int64{"password1": 2418984}
+	code, out, errOut = runCommand(server, testPassword+"\n", append(login, "--output", "json", "audit", "--breach-server")...)
+	require.Equal(t, cli.ExitOK, code, errOut)
+	assert.Contains(t, out, `"breached": [
+    {
+      "id": 1,
+      "kind": "login",
+      "description": "Почта",
+      "count": 2418984
+    }
+  ]`)
+	require.Len(t, server.prefixes, 2)
+	for _, prefix := range server.prefixes {
+		assert.Len(t, prefix, breach.PrefixLength)
+	}
+
+	code, _, _ = runCommand(server, testPassword+"\n", append(login, "audit", "--breach-data", filepath.Join(dir, "missing"))...)
+	assert.Equal(t, cli.ExitError, code)
+}
+
+func TestRun_OTP(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	server := newFakeServer()
+	login := []string{"--user", testUser, "--password-stdin"}
+
+	// секретный ключ читается из стандартного ввода и приводится к единому виду
+	code, stdout, stderr := runCommand(server, testPassword+"\njbsw y3dp ehpk 3pxp\n",
+		append(login, "add", "2fa", "--description", "GitHub", "--issuer", "GitHub", "--account", "alice", "--secret-stdin")...)
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.Equal(t, "1\n", stdout)
+	assert.NotContains(t, server.cells[1].String(), "JBSWY3DPEHPK3PXP", "секретный ключ отправляется на сервер зашифрованным")
+	for field, value := range map[string]string{"secret": "JBSWY3DPEHPK3PXP", "type": "totp", "algorithm": "SHA1", "digits": "6", "period": "30"} {
+		code, stdout, _ = runCommand(server, testPassword+"\n", append(login, "get", "1", "--field", field)...)
+		assert.Equal(t, cli.ExitOK, code)
+		assert.Equal(t, value+"\n", stdout, field)
+	}
+
+	// код мог смениться во время команды
+	key := otp.Key{Secret: "JBSWY3DPEHPK3PXP"}
+	before, err := otp.Code(key, time.Now())
+	require.NoError(t, err)
+	code, stdout, stderr = runCommand(server, testPassword+"\n", append(login, "otp", "1")...)
+	after, err := otp.Code(key, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.Contains(t, []string{before + "\n", after + "\n"}, stdout)
+	assert.Contains(t, stderr, "- Код действует еще")
+
+	code, stdout, _ = runCommand(server, testPassword+"\n", append(login, "--output", "json", "otp", "1")...)
+	require.Equal(t, cli.ExitOK, code)
+	assert.Regexp(t, `"type": "totp",\s+"code": "\d{6}",\s+"remaining": \d+`, stdout)
+
+	// коды HOTP из RFC 4226: после каждого кода счетчик увеличивается и сохраняется
+	code, stdout, stderr = runCommand(server, testPassword+"\nGEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ\n",
+		append(login, "add", "otp", "--description", "VPN", "--otp-type", "HOTP", "--counter", "5", "--secret-stdin")...)
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.Equal(t, "2\n", stdout)
+	code, stdout, stderr = runCommand(server, testPassword+"\n", append(login, "otp", "2")...)
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.Equal(t, "254676\n", stdout)
+	code, stdout, _ = runCommand(server, testPassword+"\n", append(login, "--output", "json", "otp", "2")...)
+	require.Equal(t, cli.ExitOK, code)
+	assert.JSONEq(t, `{"id": 2, "type": "hotp", "code": "287922", "counter": 6}`, stdout)
+	code, stdout, _ = runCommand(server, testPassword+"\n", append(login, "get", "2", "--field", "counter")...)
+	assert.Equal(t, cli.ExitOK, code)
+	assert.Equal(t, "7\n", stdout)
+	code, _, _ = runCommand(server, testPassword+"\n", append(login, "otp", "2", "--watch")...)
+	assert.Equal(t, cli.ExitUsage, code)
+
+	// ссылки читаются после мастер-пароля, строки-комментарии пропускаются
+	links := "otpauth://totp/ACME%20Co:bob@example.com?secret=HXDMVJECJJWSRB3HWIZR4IFUGFTMXBOZ&issuer=ACME%20Co&algorithm=SHA512&digits=8\n" +
+		"# резервные ключи\n" +
+		"otpauth://hotp/carol?secret=JBSWY3DPEHPK3PXP&counter=3\n"
+	code, stdout, stderr = runCommand(server, testPassword+"\n"+links, append(login, "otp", "import")...)
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.Equal(t, "3\n4\n", stdout)
+	code, stdout, _ = runCommand(server, testPassword+"\n", append(login, "list", "--kind", "otp")...)
+	assert.Equal(t, cli.ExitOK, code)
+	assert.Equal(t, "1\totp\tGitHub\n2\totp\tVPN\n3\totp\tACME Co (bob@example.com)\n4\totp\tcarol\n", stdout)
+	code, stdout, _ = runCommand(server, testPassword+"\n", append(login, "get", "3", "--field", "digits")...)
+	assert.Equal(t, cli.ExitOK, code)
+	assert.Equal(t, "8\n", stdout)
+
+	// неверная ссылка: не добавляется ни один ключ
+	code, _, stderr = runCommand(server, testPassword+"\n"+links+"https://example.com\n", append(login, "otp", "import")...)
+	assert.Equal(t, cli.ExitUsage, code)
+	assert.Contains(t, stderr, "строка 4")
+	assert.Len(t, server.cells, 4)
+
+	code, _, _ = runCommand(server, testPassword+"\nJBSWY3DPEHPK3PXP\n", append(login, "add", "otp", "--digits", "7", "--secret-stdin")...)
+	assert.Equal(t, cli.ExitUsage, code)
+	code, _, _ = runCommand(server, testPassword+"\n", append(login, "otp", "99")...)
+	assert.Equal(t, cli.ExitNotFound, code)
+}
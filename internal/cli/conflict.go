@@ -0,0 +1,211 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+
+	"github.com/bubu256/gophkeeper_pet/internal/proto/pb"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/client/kvpairs"
+	"google.golang.org/protobuf/proto"
+)
+
+// resolveConflict - разрешает конфликт изменений: данные изменены на другом устройстве после версии base,
+// на основе которой пользователь сделал изменения mine. Пользователь выбирает, оставить свою версию,
+// версию с сервера, сохранить обе версии или объединить изменения.
+func (c *Cli) resolveConflict(base, mine *pb.MemoryCell) error {
+	cells, err := c.retrieveCells([]int64{mine.Info.Id})
+	if err != nil {
+		return err
+	}
+	reader := bufio.NewReader(os.Stdin)
+
+	if len(cells) == 0 {
+		fmt.Printf("- Данные %d удалены на другом устройстве.\n", mine.Info.Id)
+		c.storage.Remove(mine.Info.Id)
+		fmt.Print("Сохранить вашу версию как новые данные? (y/n): ")
+		if answer := readLine(reader); answer != "y" && answer != "yes" {
+			c.saveLocal()
+			return errors.New("изменения не сохранены, данные удалены")
+		}
+		return c.saveCopy(mine, "")
+	}
+	theirs := cells[0]
+
+	fmt.Printf("- Конфликт: данные %d изменены на другом устройстве.\n", mine.Info.Id)
+	printVersions(mine, theirs)
+	fmt.Println("Выберите действие:  1. Оставить мою версию;  2. Оставить версию с сервера;  3. Сохранить обе версии;  4. Объединить изменения")
+	fmt.Print("Ваш выбор: ")
+
+	switch readLine(reader) {
+	case "1":
+		mine.Info.Revision = theirs.Info.Revision
+		return c.sendUpdatedCell(mine, theirs)
+	case "2":
+		c.storage.Put(theirs)
+		c.saveLocal()
+		return nil
+	case "3":
+		c.storage.Put(theirs)
+		return c.saveCopy(mine, " (копия)")
+	case "4":
+		merged := mergeCells(reader, base, mine, theirs)
+		return c.sendUpdatedCell(merged, theirs)
+	default:
+		return errors.New("конфликт не разрешен, изменения не сохранены")
+	}
+}
+
+// saveCopy - сохраняет версию пользователя как новые данные, добавив suffix к описанию.
+func (c *Cli) saveCopy(mine *pb.MemoryCell, suffix string) error {
+	cell := proto.Clone(mine).(*pb.MemoryCell)
+	cell.Info.Id = 0
+	cell.Info.Revision = 0
+	cell.Info.Description += suffix
+	if cell.Blob {
+		// файл, загруженный потоком, остается в исходных данных
+		cell.Blob = false
+		cell.FileName = ""
+	}
+
+	infoID, err := c.sendNewCell(cell)
+	if err != nil {
+		return err
+	}
+	fmt.Println("- Ваша версия сохранена как новые данные. ID данных:", infoID)
+	return nil
+}
+
+// mergeCells - объединяет изменения mine и theirs относительно base.
+// Пары ключ-значение и поля содержимого объединяются по отдельности, файл - целиком;
+// по каждому конфликтующему полю пользователь выбирает значение.
+// Результат получает ревизию theirs.
+func mergeCells(reader *bufio.Reader, base, mine, theirs *pb.MemoryCell) *pb.MemoryCell {
+	if base == nil {
+		base = &pb.MemoryCell{Info: &pb.InfoCell{}}
+	}
+	merged := proto.Clone(theirs).(*pb.MemoryCell)
+
+	merged.Info.DataType = mergeField(reader, "Тип данных", base.Info.DataType, mine.Info.DataType, theirs.Info.DataType)
+	merged.Info.Description = mergeField(reader, "Описание", base.Info.Description, mine.Info.Description, theirs.Info.Description)
+	mergePayload(reader, base, mine, merged)
+
+	switch {
+	case sameFile(mine, theirs), sameFile(mine, base):
+	case sameFile(theirs, base) || chooseMine(reader, "Файл", mine.FileName, theirs.FileName):
+		merged.FileName = mine.FileName
+		merged.BinaryData = mine.BinaryData
+		merged.Blob = mine.Blob
+	}
+
+	pairs, conflicts := kvpairs.Merge3(base.KeyValuePairs, mine.KeyValuePairs, theirs.KeyValuePairs)
+	for _, conflict := range conflicts {
+		value := conflict.Theirs
+		if chooseMine(reader, fmt.Sprintf("Ключ %q", conflict.Key), describeValue(conflict.Mine), describeValue(conflict.Theirs)) {
+			value = conflict.Mine
+		}
+		if value.Present {
+			pairs[conflict.Key] = value.Value
+		}
+	}
+	merged.KeyValuePairs = pairs
+
+	return merged
+}
+
+// mergePayload - объединяет содержимое данных по отдельным полям. merged содержит версию theirs
+// с уже выбранным видом данных. Если выбран вид данных из версии mine, берется ее содержимое.
+func mergePayload(reader *bufio.Reader, base, mine, merged *pb.MemoryCell) {
+	if merged.Info.DataType != mine.Info.DataType {
+		return
+	}
+	if reflect.TypeOf(merged.Payload) != reflect.TypeOf(mine.Payload) {
+		merged.Payload = proto.Clone(mine).(*pb.MemoryCell).Payload
+		return
+	}
+
+	mineFields := payloadFields(mine)
+	baseFields := payloadFields(base)
+	for i, field := range payloadFields(merged) {
+		baseValue := ""
+		if reflect.TypeOf(base.Payload) == reflect.TypeOf(mine.Payload) {
+			baseValue = *baseFields[i].value
+		}
+		*field.value = mergeField(reader, field.label, baseValue, *mineFields[i].value, *field.value)
+	}
+}
+
+// mergeField - выбирает значение поля при трехстороннем слиянии, спрашивая пользователя при конфликте.
+func mergeField(reader *bufio.Reader, name, base, mine, theirs string) string {
+	switch {
+	case mine == theirs, mine == base:
+		return theirs
+	case theirs == base:
+		return mine
+	case chooseMine(reader, name, mine, theirs):
+		return mine
+	default:
+		return theirs
+	}
+}
+
+// chooseMine - спрашивает пользователя, какое из конфликтующих значений оставить. Возвращает true для своего значения.
+func chooseMine(reader *bufio.Reader, name, mine, theirs string) bool {
+	fmt.Printf("%s изменен на обоих устройствах:  1. мое значение: %s;  2. значение с сервера: %s\n", name, mine, theirs)
+	fmt.Print("Ваш выбор (1/2): ")
+	return readLine(reader) == "1"
+}
+
+// sameFile - сообщает, что в версиях один и тот же файл.
+func sameFile(a, b *pb.MemoryCell) bool {
+	return a.FileName == b.FileName && a.Blob == b.Blob && bytes.Equal(a.BinaryData, b.BinaryData)
+}
+
+// describeValue - возвращает значение ключа для вывода пользователю.
+func describeValue(value kvpairs.Value) string {
+	if !value.Present {
+		return "(удален)"
+	}
+	return value.Value
+}
+
+// printVersions - выводит различия между версией пользователя и версией с сервера.
+func printVersions(mine, theirs *pb.MemoryCell) {
+	printDiff := func(name, mine, theirs string) {
+		if mine != theirs {
+			fmt.Printf("\t%s:  мое: %s  |  на сервере: %s\n", name, mine, theirs)
+		}
+	}
+
+	printDiff("Тип данных", mine.Info.DataType, theirs.Info.DataType)
+	printDiff("Описание", mine.Info.Description, theirs.Info.Description)
+	if reflect.TypeOf(mine.Payload) == reflect.TypeOf(theirs.Payload) {
+		theirsFields := payloadFields(theirs)
+		for i, field := range payloadFields(mine) {
+			printDiff(field.label, displayValue(field), displayValue(theirsFields[i]))
+		}
+	}
+	if !sameFile(mine, theirs) {
+		printDiff("Файл", mine.FileName+" ", theirs.FileName+" ")
+	}
+
+	keys := make([]string, 0, len(mine.KeyValuePairs)+len(theirs.KeyValuePairs))
+	for key := range mine.KeyValuePairs {
+		keys = append(keys, key)
+	}
+	for key := range theirs.KeyValuePairs {
+		if _, ok := mine.KeyValuePairs[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		m, mok := mine.KeyValuePairs[key]
+		t, tok := theirs.KeyValuePairs[key]
+		printDiff(fmt.Sprintf("Ключ %q", key), describeValue(kvpairs.Value{Value: m, Present: mok}), describeValue(kvpairs.Value{Value: t, Present: tok}))
+	}
+}
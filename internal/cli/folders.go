@@ -0,0 +1,344 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bubu256/gophkeeper_pet/internal/proto/pb"
+)
+
+// Folders - показывает дерево папок и метки пользователя, создает, переименовывает и перемещает папки,
+// перемещает данные в папки и отмечает данные метками.
+func (c *Cli) Folders() {
+	if c.offline {
+		fmt.Println("- Изменение папок и меток доступно только при подключении к серверу.")
+		return
+	}
+
+	folders, err := c.loadFolders()
+	if err != nil {
+		fmt.Println("Ошибка при получении папок:", err)
+		return
+	}
+	printFolderTree(folders, c.storage.GetInformation())
+	tags, err := c.client.ListTags(c.ctx, &pb.ListTagsRequest{})
+	if err != nil {
+		fmt.Println("Ошибка при получении меток:", err)
+		return
+	}
+	if len(tags.Tags) > 0 {
+		fmt.Println("\tМетки:")
+		for _, tag := range tags.Tags {
+			fmt.Printf("\t#%s (%d)\n", tag.Name, tag.Count)
+		}
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	fmt.Println("Выберите действие:  1. Создать папку;  2. Переименовать папку;  3. Переместить папку;  4. Переместить данные в папку;  5. Изменить метки данных;  пустая строка - выход")
+	fmt.Print("Ваш выбор: ")
+	switch readLine(reader) {
+	case "1":
+		err = c.createFolder(reader, folders)
+	case "2":
+		err = c.renameFolder(reader, folders)
+	case "3":
+		err = c.moveFolder(reader, folders)
+	case "4":
+		err = c.moveData(reader, folders)
+	case "5":
+		err = c.tagData(reader)
+	default:
+		return
+	}
+	if err != nil {
+		fmt.Println("- Ошибка:", err)
+		return
+	}
+	fmt.Println("- Изменения сохранены.")
+}
+
+// createFolder - создает папку в указанной пользователем папке.
+func (c *Cli) createFolder(reader *bufio.Reader, folders []*pb.Folder) error {
+	fmt.Print("Родительская папка (ID или путь, пустая строка - верхний уровень): ")
+	parentID, err := resolveFolder(folders, readLine(reader))
+	if err != nil {
+		return err
+	}
+	fmt.Print("Имя папки: ")
+	_, err = c.client.CreateFolder(c.ctx, &pb.CreateFolderRequest{Name: readLine(reader), ParentId: parentID})
+	return err
+}
+
+// renameFolder - переименовывает папку.
+func (c *Cli) renameFolder(reader *bufio.Reader, folders []*pb.Folder) error {
+	folderID, err := promptFolder(reader, folders, "Папка (ID или путь): ")
+	if err != nil {
+		return err
+	}
+	fmt.Print("Новое имя папки: ")
+	_, err = c.client.RenameFolder(c.ctx, &pb.RenameFolderRequest{Id: folderID, Name: readLine(reader)})
+	return err
+}
+
+// moveFolder - перемещает папку вместе с вложенными папками и данными в другую папку.
+func (c *Cli) moveFolder(reader *bufio.Reader, folders []*pb.Folder) error {
+	folderID, err := promptFolder(reader, folders, "Папка (ID или путь): ")
+	if err != nil {
+		return err
+	}
+	fmt.Print("Новая родительская папка (ID или путь, пустая строка - верхний уровень): ")
+	parentID, err := resolveFolder(folders, readLine(reader))
+	if err != nil {
+		return err
+	}
+	_, err = c.client.MoveFolder(c.ctx, &pb.MoveFolderRequest{Id: folderID, ParentId: parentID})
+	return err
+}
+
+// moveData - перемещает данные в папку и загружает изменение в локальную копию.
+func (c *Cli) moveData(reader *bufio.Reader, folders []*pb.Folder) error {
+	fmt.Print("Введите InfoID данных через пробел: ")
+	infoIDs, err := parseIDs(readLine(reader))
+	if err != nil {
+		return err
+	}
+	fmt.Print("Папка (ID или путь, пустая строка - убрать из папок): ")
+	folderID, err := resolveFolder(folders, readLine(reader))
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.client.MoveData(c.ctx, &pb.MoveDataRequest{Ids: infoIDs, FolderId: folderID}); err != nil {
+		return err
+	}
+	return c.syncFolderChanges()
+}
+
+// tagData - добавляет данным метки и снимает их, затем загружает изменение в локальную копию.
+func (c *Cli) tagData(reader *bufio.Reader) error {
+	fmt.Print("Введите InfoID данных через пробел: ")
+	infoIDs, err := parseIDs(readLine(reader))
+	if err != nil {
+		return err
+	}
+	fmt.Print("Добавить метки (через пробел или запятую): ")
+	add := splitTags(readLine(reader))
+	fmt.Print("Снять метки (через пробел или запятую): ")
+	remove := splitTags(readLine(reader))
+	if len(add) == 0 && len(remove) == 0 {
+		return errors.New("метки не указаны")
+	}
+
+	if _, err := c.client.TagData(c.ctx, &pb.TagDataRequest{Ids: infoIDs, Add: add, Remove: remove}); err != nil {
+		return err
+	}
+	return c.syncFolderChanges()
+}
+
+// syncFolderChanges - загружает с сервера данные, получившие новую ревизию при перемещении или изменении меток.
+func (c *Cli) syncFolderChanges() error {
+	if err := c.syncChanges(); err != nil {
+		return fmt.Errorf("изменения сохранены, но синхронизация не выполнена: %w", err)
+	}
+	c.saveLocal()
+	return nil
+}
+
+// loadFolders - возвращает папки пользователя с сервера и сохраняет их в локальной копии.
+// В автономном режиме возвращает папки, полученные при последнем подключении.
+func (c *Cli) loadFolders() ([]*pb.Folder, error) {
+	if !c.offline {
+		response, err := c.client.ListFolders(c.ctx, &pb.ListFoldersRequest{})
+		if err != nil && !c.goOffline(err) {
+			return nil, err
+		}
+		if err == nil {
+			c.storage.SetFolders(response.Folders)
+			c.saveLocal()
+			return response.Folders, nil
+		}
+	}
+	return c.storage.Folders(), nil
+}
+
+// filterInfo - оставляет информацию о данных, подходящих под фильтр: "#метка" или папка (ID или путь).
+// Папке соответствуют данные в ней самой и во вложенных в нее папках. Пустой фильтр оставляет все данные.
+func filterInfo(infoCells []*pb.InfoCell, folders []*pb.Folder, filter string) ([]*pb.InfoCell, error) {
+	if filter == "" {
+		return infoCells, nil
+	}
+
+	var match func(info *pb.InfoCell) bool
+	if strings.HasPrefix(filter, "#") {
+		tag := strings.ToLower(strings.TrimPrefix(filter, "#"))
+		match = func(info *pb.InfoCell) bool {
+			for _, t := range info.Tags {
+				if t == tag {
+					return true
+				}
+			}
+			return false
+		}
+	} else {
+		folderID, err := resolveFolder(folders, filter)
+		if err != nil {
+			return nil, err
+		}
+		parents := folderParents(folders)
+		match = func(info *pb.InfoCell) bool {
+			for id := info.FolderId; id != 0; id = parents[id] {
+				if id == folderID {
+					return true
+				}
+			}
+			return folderID == 0
+		}
+	}
+
+	var filtered []*pb.InfoCell
+	for _, info := range infoCells {
+		if match(info) {
+			filtered = append(filtered, info)
+		}
+	}
+	return filtered, nil
+}
+
+// promptFolder - запрашивает существующую папку. Верхний уровень не считается папкой.
+func promptFolder(reader *bufio.Reader, folders []*pb.Folder, prompt string) (int64, error) {
+	fmt.Print(prompt)
+	folderID, err := resolveFolder(folders, readLine(reader))
+	if err != nil {
+		return 0, err
+	}
+	if folderID == 0 {
+		return 0, errors.New("папка не указана")
+	}
+	return folderID, nil
+}
+
+// resolveFolder - находит папку по ID или пути вида work/projects.
+// Пустая строка и / означают верхний уровень и возвращают 0.
+func resolveFolder(folders []*pb.Folder, input string) (int64, error) {
+	input = strings.TrimSpace(input)
+	if id, err := strconv.ParseInt(input, 10, 64); err == nil {
+		for _, folder := range folders {
+			if folder.Id == id {
+				return id, nil
+			}
+		}
+		return 0, fmt.Errorf("папка %d не найдена", id)
+	}
+
+	var parentID int64
+	for _, name := range strings.Split(input, "/") {
+		if name == "" {
+			continue
+		}
+		found := false
+		for _, folder := range folders {
+			if folder.ParentId == parentID && folder.Name == name {
+				parentID, found = folder.Id, true
+				break
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("папка %q не найдена", input)
+		}
+	}
+	return parentID, nil
+}
+
+// folderPath - возвращает путь к папке от верхнего уровня, например /work/projects.
+func folderPath(folders []*pb.Folder, folderID int64) string {
+	names := make(map[int64]string, len(folders))
+	for _, folder := range folders {
+		names[folder.Id] = folder.Name
+	}
+	parents := folderParents(folders)
+
+	var path []string
+	for id := folderID; id != 0 && len(path) <= len(folders); id = parents[id] {
+		name, ok := names[id]
+		if !ok {
+			name = strconv.FormatInt(id, 10)
+		}
+		path = append([]string{name}, path...)
+	}
+	return "/" + strings.Join(path, "/")
+}
+
+// folderParents - возвращает ID родительской папки для каждой папки.
+func folderParents(folders []*pb.Folder) map[int64]int64 {
+	parents := make(map[int64]int64, len(folders))
+	for _, folder := range folders {
+		parents[folder.Id] = folder.ParentId
+	}
+	return parents
+}
+
+// printFolderTree - выводит дерево папок с количеством данных в каждой папке.
+func printFolderTree(folders []*pb.Folder, infoCells []*pb.InfoCell) {
+	counts := make(map[int64]int)
+	for _, info := range infoCells {
+		counts[info.FolderId]++
+	}
+	children := make(map[int64][]*pb.Folder)
+	for _, folder := range folders {
+		children[folder.ParentId] = append(children[folder.ParentId], folder)
+	}
+
+	fmt.Println("\tПапки:")
+	fmt.Printf("\t/ (%d)\n", counts[0])
+	var printLevel func(parentID int64, depth int)
+	printLevel = func(parentID int64, depth int) {
+		list := children[parentID]
+		sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+		for _, folder := range list {
+			fmt.Printf("\t%s%s [ID %d] (%d)\n", strings.Repeat("  ", depth), folder.Name, folder.Id, counts[folder.Id])
+			if depth < len(folders) {
+				printLevel(folder.Id, depth+1)
+			}
+		}
+	}
+	printLevel(0, 1)
+}
+
+// printFolderAndTags - выводит папку и метки данных.
+func printFolderAndTags(w io.Writer, info *pb.InfoCell, folders []*pb.Folder) {
+	if info.FolderId != 0 {
+		fmt.Fprintf(w, "\tПапка: %s\n", folderPath(folders, info.FolderId))
+	}
+	if len(info.Tags) > 0 {
+		fmt.Fprintf(w, "\tМетки: #%s\n", strings.Join(info.Tags, " #"))
+	}
+}
+
+// parseIDs - разбирает список InfoID, разделенных пробелами.
+func parseIDs(input string) ([]int64, error) {
+	fields := strings.Fields(input)
+	if len(fields) == 0 {
+		return nil, errors.New("InfoID не указаны")
+	}
+
+	infoIDs := make([]int64, 0, len(fields))
+	for _, field := range fields {
+		id, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("неверный InfoID %q", field)
+		}
+		infoIDs = append(infoIDs, id)
+	}
+	return infoIDs, nil
+}
+
+// splitTags - разбирает метки, разделенные пробелами или запятыми.
+func splitTags(input string) []string {
+	return strings.FieldsFunc(input, func(r rune) bool { return r == ',' || r == ' ' })
+}
@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/client/passgen"
+)
+
+// generateInput - ввод в поле пароля, по которому пароль генерируется.
+const generateInput = "+"
+
+// generated - сгенерированный пароль или парольная фраза и ее энтропия в битах.
+type generated struct {
+	value   string
+	entropy float64
+}
+
+// String - описание надежности для пользователя, без самого значения.
+func (g generated) String() string {
+	return fmt.Sprintf("энтропия %.0f бит, %s", g.entropy, passgen.StrengthOf(g.entropy))
+}
+
+// generatePassword - генерирует пароль с параметрами opts.
+func generatePassword(opts passgen.Options) (generated, error) {
+	value, err := passgen.Password(opts)
+	if err != nil {
+		return generated{}, err
+	}
+	return generated{value: value, entropy: opts.Entropy()}, nil
+}
+
+// generatePassphrase - генерирует парольную фразу с параметрами opts.
+func generatePassphrase(opts passgen.PassphraseOptions) (generated, error) {
+	value, err := passgen.Passphrase(opts)
+	if err != nil {
+		return generated{}, err
+	}
+	return generated{value: value, entropy: opts.Entropy()}, nil
+}
+
+// runGenerate - команда generate: выводит сгенерированный пароль или, с флагом --words, парольную фразу.
+// Энтропия выводится в поток сообщений, чтобы результат можно было передать другой команде.
+// Вход на сервер не нужен.
+func (c *Cli) runGenerate(opts globalOptions, args []string) error {
+	fs := c.newFlagSet("generate")
+	password := passgen.DefaultOptions()
+	fs.IntVar(&password.Length, "length", password.Length, "длина пароля")
+	noLower := fs.Bool("no-lower", false, "без строчных букв")
+	noUpper := fs.Bool("no-upper", false, "без заглавных букв")
+	noDigits := fs.Bool("no-digits", false, "без цифр")
+	noSymbols := fs.Bool("no-symbols", false, "без специальных символов")
+	fs.BoolVar(&password.ExcludeAmbiguous, "exclude-ambiguous", false, "без символов, которые легко спутать: "+passgen.Ambiguous)
+	fs.BoolVar(&password.Pronounceable, "pronounceable", false, "произносимый пароль из чередующихся согласных и гласных")
+	phrase := passgen.DefaultPassphraseOptions()
+	words := fs.Int("words", 0, "сгенерировать парольную фразу из указанного количества слов")
+	fs.StringVar(&phrase.Separator, "separator", phrase.Separator, "разделитель слов парольной фразы")
+	fs.BoolVar(&phrase.Capitalize, "capitalize", false, "начинать слова парольной фразы с заглавной буквы")
+	fs.BoolVar(&phrase.Number, "number", false, "добавить цифру к случайному слову парольной фразы")
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return usageError("лишние аргументы: %v", positional)
+	}
+	password.Lower, password.Upper, password.Digits, password.Symbols = !*noLower, !*noUpper, !*noDigits, !*noSymbols
+
+	var result generated
+	if *words != 0 {
+		phrase.Words = *words
+		result, err = generatePassphrase(phrase)
+	} else {
+		result, err = generatePassword(password)
+	}
+	if err != nil {
+		return usageError("%v", err)
+	}
+
+	if c.structured() {
+		return c.writeDocument(generatedDocument{
+			Value:    result.value,
+			Entropy:  int(result.entropy),
+			Strength: passgen.StrengthOf(result.entropy).String(),
+		})
+	}
+	fmt.Fprintln(c.stdout, result.value)
+	fmt.Fprintf(c.messages, "- Энтропия: %.0f бит (%s).\n", result.entropy, passgen.StrengthOf(result.entropy))
+	return nil
+}
+
+// Generate - генератор паролей и парольных фраз в меню.
+func (c *Cli) Generate() {
+	fmt.Print("Вид: 1. Пароль;  2. Произносимый пароль;  3. Парольная фраза [1]: ")
+	choice := readLine(c.stdin)
+
+	var (
+		result generated
+		err    error
+	)
+	switch choice {
+	case "", "1", "2":
+		opts := passgen.DefaultOptions()
+		opts.Pronounceable = choice == "2"
+		if opts.Pronounceable {
+			opts.Length = 14
+		}
+		fmt.Printf("Длина [%d]: ", opts.Length)
+		if opts.Length, err = readNumber(c.stdin, opts.Length); err != nil {
+			break
+		}
+		fmt.Print("Исключить символы, которые легко спутать (y/n) [n]: ")
+		opts.ExcludeAmbiguous = readLine(c.stdin) == "y"
+		result, err = generatePassword(opts)
+	case "3":
+		opts := passgen.DefaultPassphraseOptions()
+		fmt.Printf("Количество слов [%d]: ", opts.Words)
+		if opts.Words, err = readNumber(c.stdin, opts.Words); err != nil {
+			break
+		}
+		result, err = generatePassphrase(opts)
+	default:
+		fmt.Println("Некорректный выбор")
+		return
+	}
+	if err != nil {
+		fmt.Printf("- %v.\n", err)
+		return
+	}
+	fmt.Println(result.value)
+	fmt.Printf("- %s.\n", result)
+}
+
+// readNumber - читает целое число. Пустой ввод возвращает значение по умолчанию.
+func readNumber(reader *bufio.Reader, defaultValue int) (int, error) {
+	input := readLine(reader)
+	if input == "" {
+		return defaultValue, nil
+	}
+	number, err := strconv.Atoi(input)
+	if err != nil {
+		return 0, fmt.Errorf("некорректное число %q", input)
+	}
+	return number, nil
+}
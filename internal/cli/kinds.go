@@ -0,0 +1,432 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bubu256/gophkeeper_pet/internal/kinds"
+	"github.com/bubu256/gophkeeper_pet/internal/proto/pb"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/client/passgen"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/otp"
+)
+
+// maxAttempts - сколько раз пользователю предлагается повторить неверный ввод.
+const maxAttempts = 3
+
+// kindTitles - названия видов данных для пользователя.
+var kindTitles = map[string]string{
+	kinds.Login: "Логин и пароль",
+	kinds.Card:  "Банковская карта",
+	kinds.Note:  "Текстовая заметка",
+	kinds.File:  "Файл",
+	kinds.OTP:   "Одноразовые коды (2FA)",
+}
+
+// kindTitle - возвращает название вида данных. Для неизвестных видов возвращается сам вид.
+func kindTitle(kind string) string {
+	if title, ok := kindTitles[kind]; ok {
+		return title
+	}
+	return kind
+}
+
+// payloadField - поле содержимого данных, зависящего от вида данных.
+type payloadField struct {
+	// name - имя поля в командах и флагах командной строки.
+	name  string
+	label string
+	value *string
+	// secret - значение скрывается при вводе подсказки с текущим значением.
+	secret   bool
+	required bool
+	// multiline - значение вводится в несколько строк.
+	multiline bool
+	// generate - значение можно сгенерировать генератором паролей.
+	generate bool
+	// check - проверяет введенное значение и возвращает его в едином виде.
+	check func(string) (string, error)
+	// display - возвращает значение для вывода пользователю.
+	display func(string) string
+}
+
+// payloadFields - возвращает поля содержимого ячейки. Значения полей указывают на поля ячейки.
+func payloadFields(cell *pb.MemoryCell) []payloadField {
+	switch payload := cell.Payload.(type) {
+	case *pb.MemoryCell_Login:
+		return []payloadField{
+			{name: "url", label: "Адрес сайта", value: &payload.Login.Url, check: kinds.NormalizeURL},
+			{name: "username", label: "Логин", value: &payload.Login.Username},
+			{name: "password", label: "Пароль", value: &payload.Login.Password, secret: true, required: true, generate: true},
+		}
+	case *pb.MemoryCell_Card:
+		return []payloadField{
+			{name: "number", label: "Номер карты", value: &payload.Card.Number, required: true, check: kinds.NormalizeCardNumber, display: formatCardNumber},
+			{name: "holder", label: "Владелец карты", value: &payload.Card.Holder},
+			{name: "expiry", label: "Срок действия (MM/YY)", value: &payload.Card.Expiry, required: true, check: checkExpiry, display: formatExpiry},
+			{name: "cvv", label: "CVV", value: &payload.Card.Cvv, secret: true, check: checkCVV},
+		}
+	case *pb.MemoryCell_Note:
+		return []payloadField{
+			{name: "text", label: "Текст", value: &payload.Note.Text, required: true, multiline: true},
+		}
+	case *pb.MemoryCell_File:
+		return []payloadField{
+			{name: "media-type", label: "Тип файла", value: &payload.File.MediaType},
+		}
+	case *pb.MemoryCell_Otp:
+		return []payloadField{
+			{name: "issuer", label: "Сервис", value: &payload.Otp.Issuer},
+			{name: "account", label: "Аккаунт", value: &payload.Otp.Account},
+			{name: "secret", label: "Секретный ключ (base32)", value: &payload.Otp.Secret, secret: true, required: true, check: otp.NormalizeSecret},
+			{name: "type", label: "Тип кодов (totp или hotp)", value: &payload.Otp.Type, check: otp.ParseType},
+			{name: "algorithm", label: "Алгоритм (SHA1, SHA256 или SHA512)", value: &payload.Otp.Algorithm, check: otp.ParseAlgorithm},
+			{name: "digits", label: "Количество цифр (6 или 8)", value: &payload.Otp.Digits, check: checkDigits},
+			{name: "period", label: "Период смены кода, секунд (totp)", value: &payload.Otp.Period, check: checkPeriod},
+			{name: "counter", label: "Счетчик (hotp)", value: &payload.Otp.Counter, check: checkCounter},
+		}
+	}
+	return nil
+}
+
+// ensurePayload - создает в ячейке пустое содержимое, соответствующее виду данных,
+// если содержимого нет или оно другого вида.
+func ensurePayload(cell *pb.MemoryCell) {
+	switch cell.Info.DataType {
+	case kinds.Login:
+		if cell.GetLogin() == nil {
+			cell.Payload = &pb.MemoryCell_Login{Login: &pb.LoginPayload{}}
+		}
+	case kinds.Card:
+		if cell.GetCard() == nil {
+			cell.Payload = &pb.MemoryCell_Card{Card: &pb.CardPayload{}}
+		}
+	case kinds.Note:
+		if cell.GetNote() == nil {
+			cell.Payload = &pb.MemoryCell_Note{Note: &pb.NotePayload{}}
+		}
+	case kinds.File:
+		if cell.GetFile() == nil {
+			cell.Payload = &pb.MemoryCell_File{File: &pb.FilePayload{}}
+		}
+	case kinds.OTP:
+		if cell.GetOtp() == nil {
+			cell.Payload = &pb.MemoryCell_Otp{Otp: &pb.OTPPayload{}}
+		}
+	default:
+		cell.Payload = nil
+	}
+}
+
+// promptKind - запрашивает вид данных по номеру или названию. Пустой ввод оставляет текущий вид.
+func promptKind(reader *bufio.Reader, current string) (string, error) {
+	if kind, err := kinds.Parse(current); err == nil {
+		current = kind
+	} else {
+		current = ""
+	}
+
+	variants := make([]string, 0, len(kinds.All))
+	for i, kind := range kinds.All {
+		variants = append(variants, fmt.Sprintf("%d. %s", i+1, kindTitle(kind)))
+	}
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		fmt.Printf("Вид данных (%s)", strings.Join(variants, ";  "))
+		if current != "" {
+			fmt.Printf(" [%s]", kindTitle(current))
+		}
+		fmt.Print(": ")
+
+		input := readLine(reader)
+		if input == "" && current != "" {
+			return current, nil
+		}
+		for i, kind := range kinds.All {
+			if input == fmt.Sprint(i+1) {
+				return kind, nil
+			}
+		}
+		if kind, err := kinds.Parse(input); err == nil {
+			return kind, nil
+		}
+		fmt.Println("- Неизвестный вид данных.")
+	}
+	return "", errors.New("вид данных не выбран")
+}
+
+// promptPayload - запрашивает содержимое данных в зависимости от их вида.
+// Текущие значения полей показываются в подсказке, пустой ввод оставляет текущее значение.
+// Для файлов возвращает путь к большому файлу, который нужно загрузить потоком.
+func (c *Cli) promptPayload(cell *pb.MemoryCell) (string, error) {
+	ensurePayload(cell)
+	reader := c.stdin
+
+	if cell.Info.DataType == kinds.File {
+		path, err := promptFile(reader, cell)
+		if err != nil || path == "" {
+			return "", err
+		}
+		largeFile, err := attachFile(cell, path)
+		if err != nil {
+			return "", err
+		}
+		cell.GetFile().MediaType = mediaType(cell.FileName)
+		return largeFile, nil
+	}
+
+	for _, field := range payloadFields(cell) {
+		if err := c.promptField(field); err != nil {
+			return "", err
+		}
+	}
+	if err := normalizeOTP(cell); err != nil {
+		return "", err
+	}
+
+	if card := cell.GetCard(); card != nil {
+		if expiry, err := kinds.ParseExpiry(card.Expiry); err == nil && expiry.Expired(time.Now()) {
+			fmt.Println("- Внимание: срок действия карты истек.")
+		}
+	}
+	return "", nil
+}
+
+// promptFile - запрашивает путь к файлу. Если файл уже сохранен, пустой ввод оставляет его.
+func promptFile(reader *bufio.Reader, cell *pb.MemoryCell) (string, error) {
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if cell.FileName != "" {
+			fmt.Printf("Путь к файлу [%s]: ", cell.FileName)
+		} else {
+			fmt.Print("Путь к файлу: ")
+		}
+		path := readLine(reader)
+		if path != "" || cell.FileName != "" {
+			return path, nil
+		}
+		fmt.Println("- Укажите путь к файлу.")
+	}
+	return "", errors.New("файл не указан")
+}
+
+// promptField - запрашивает значение поля, пока оно не пройдет проверку. Секретные поля вводятся без отображения.
+func (c *Cli) promptField(field payloadField) error {
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		input := ""
+		if field.multiline {
+			input = promptMultiline(c.stdin, field.label, *field.value)
+		} else if field.secret {
+			label := field.label
+			if field.generate {
+				label = fmt.Sprintf("%s (%q - сгенерировать)", field.label, generateInput)
+			}
+			prompt := label + ": "
+			if *field.value != "" {
+				prompt = fmt.Sprintf("%s [%s]: ", label, promptHint(field))
+			}
+			var err error
+			if input, err = c.readSecret(prompt); err != nil {
+				return err
+			}
+			if field.generate && input == generateInput {
+				result, err := generatePassword(passgen.DefaultOptions())
+				if err != nil {
+					return err
+				}
+				input = result.value
+				// значение не выводится, чтобы не оставлять его на экране и в истории терминала
+				fmt.Printf("- %s сгенерирован (%s).\n", field.label, result)
+			}
+		} else {
+			fmt.Print(field.label)
+			if *field.value != "" {
+				fmt.Printf(" [%s]", promptHint(field))
+			}
+			fmt.Print(": ")
+			input = readLine(c.stdin)
+		}
+
+		if input == "" && *field.value != "" {
+			return nil
+		}
+		if err := setField(field, input); err != nil {
+			fmt.Printf("- %s.\n", err)
+			continue
+		}
+		return nil
+	}
+	return fmt.Errorf("не заполнено поле %q", field.label)
+}
+
+// setField - проверяет значение поля и сохраняет его в едином виде.
+func setField(field payloadField, input string) error {
+	if input == "" {
+		if field.required {
+			return errors.New("Поле обязательно для заполнения")
+		}
+		*field.value = ""
+		return nil
+	}
+	if field.check != nil {
+		value, err := field.check(input)
+		if err != nil {
+			return errors.New(validationMessage(err))
+		}
+		input = value
+	}
+	*field.value = input
+	return nil
+}
+
+// promptMultiline - читает многострочное значение до пустой строки.
+func promptMultiline(reader *bufio.Reader, label, current string) string {
+	if current != "" {
+		fmt.Printf("%s (пустая строка - оставить текущий):\n", label)
+	} else {
+		fmt.Printf("%s (пустая строка для завершения ввода):\n", label)
+	}
+	var lines []string
+	for {
+		line := readLine(reader)
+		if line == "" {
+			break
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// promptHint - возвращает текущее значение поля для подсказки при вводе.
+func promptHint(field payloadField) string {
+	if field.secret {
+		return "***"
+	}
+	return displayValue(field)
+}
+
+// displayValue - возвращает значение поля для вывода пользователю.
+func displayValue(field payloadField) string {
+	if field.display != nil {
+		return field.display(*field.value)
+	}
+	return *field.value
+}
+
+// printPayload - выводит содержимое данных в зависимости от их вида.
+func printPayload(w io.Writer, cell *pb.MemoryCell) {
+	for _, field := range payloadFields(cell) {
+		if *field.value == "" {
+			continue
+		}
+		if field.multiline {
+			fmt.Fprintf(w, "\t%s:\n", field.label)
+			for _, line := range strings.Split(*field.value, "\n") {
+				fmt.Fprintf(w, "\t  %s\n", line)
+			}
+			continue
+		}
+		fmt.Fprintf(w, "\t%s: %s\n", field.label, displayValue(field))
+	}
+}
+
+// validationMessage - возвращает описание ошибки проверки данных для пользователя.
+func validationMessage(err error) string {
+	switch {
+	case errors.Is(err, kinds.ErrInvalidCardNumber):
+		return "Неверный номер карты"
+	case errors.Is(err, kinds.ErrInvalidExpiry):
+		return "Неверный срок действия, используйте формат MM/YY"
+	case errors.Is(err, kinds.ErrInvalidCVV):
+		return "Код безопасности должен состоять из 3 или 4 цифр"
+	case errors.Is(err, kinds.ErrInvalidURL):
+		return "Неверный адрес сайта"
+	case errors.Is(err, otp.ErrType):
+		return "Тип кодов должен быть totp или hotp"
+	case errors.Is(err, otp.ErrSecret):
+		return "Неверный секретный ключ, ожидается строка в кодировке base32"
+	case errors.Is(err, otp.ErrAlgorithm):
+		return "Алгоритм должен быть SHA1, SHA256 или SHA512"
+	case errors.Is(err, otp.ErrDigits):
+		return "Код должен состоять из 6 или 8 цифр"
+	case errors.Is(err, otp.ErrPeriod):
+		return "Период должен быть целым числом секунд от 1 до 86400"
+	case errors.Is(err, otp.ErrCounter):
+		return "Счетчик должен быть неотрицательным целым числом"
+	case errors.Is(err, otp.ErrURI):
+		return "Неверная ссылка, ожидается otpauth:// или otpauth-migration://"
+	default:
+		return err.Error()
+	}
+}
+
+// checkExpiry - проверяет срок действия карты и приводит его к формату MM/YY.
+func checkExpiry(s string) (string, error) {
+	expiry, err := kinds.ParseExpiry(s)
+	if err != nil {
+		return "", err
+	}
+	return expiry.String(), nil
+}
+
+// checkCVV - проверяет код безопасности карты.
+func checkCVV(s string) (string, error) {
+	return s, kinds.ValidateCVV(s)
+}
+
+// checkDigits - проверяет количество цифр одноразового кода.
+func checkDigits(s string) (string, error) {
+	digits, err := otp.ParseDigits(s)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(digits), nil
+}
+
+// checkPeriod - проверяет период смены кода TOTP.
+func checkPeriod(s string) (string, error) {
+	period, err := otp.ParsePeriod(s)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(period), nil
+}
+
+// checkCounter - проверяет счетчик HOTP.
+func checkCounter(s string) (string, error) {
+	counter, err := otp.ParseCounter(s)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatUint(counter, 10), nil
+}
+
+// formatCardNumber - разбивает номер карты на группы по четыре цифры.
+func formatCardNumber(number string) string {
+	var groups []string
+	for len(number) > 4 {
+		groups = append(groups, number[:4])
+		number = number[4:]
+	}
+	return strings.Join(append(groups, number), " ")
+}
+
+// formatExpiry - добавляет к сроку действия отметку об истечении.
+func formatExpiry(s string) string {
+	if expiry, err := kinds.ParseExpiry(s); err == nil && expiry.Expired(time.Now()) {
+		return s + " (истек)"
+	}
+	return s
+}
+
+// mediaType - определяет тип файла по расширению.
+func mediaType(fileName string) string {
+	if t := mime.TypeByExtension(filepath.Ext(fileName)); t != "" {
+		return t
+	}
+	return "application/octet-stream"
+}
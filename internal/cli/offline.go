@@ -0,0 +1,298 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/bubu256/gophkeeper_pet/internal/proto/pb"
+	"github.com/bubu256/gophkeeper_pet/internal/schema"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/client/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// isUnavailable - сообщает, что запрос не выполнен из-за недоступности сервера.
+func isUnavailable(err error) bool {
+	code := status.Code(err)
+	return code == codes.Unavailable || code == codes.DeadlineExceeded
+}
+
+// goOffline - переводит клиент в автономный режим, если ошибка вызвана недоступностью сервера.
+// Возвращает true, если операцию следует выполнить с локальной копией данных.
+func (c *Cli) goOffline(err error) bool {
+	if !isUnavailable(err) || c.vaultKey == nil {
+		return false
+	}
+	if !c.offline {
+		fmt.Fprintln(c.messages, "- Сервер недоступен, переход в автономный режим. Изменения будут отправлены при синхронизации.")
+		c.offline = true
+	}
+	return true
+}
+
+// openProfile - загружает локальную копию данных и журнал изменений пользователя.
+func (c *Cli) openProfile(username string) error {
+	if c.username != username {
+		// локальная копия другого пользователя не должна попасть в синхронизацию
+		c.storage.Reset()
+		c.storage.SetProfile(username)
+		if c.storage.HasLocal() {
+			if err := c.storage.LoadLocal(c.vaultKey); err != nil {
+				log.Printf("failed to load local copy: %v", err)
+			}
+		}
+		c.username = username
+	}
+
+	journal, err := storage.OpenJournal(c.storage.ProfilePath(".journal"), c.vaultKey)
+	if err != nil {
+		return fmt.Errorf("не удалось открыть журнал изменений: %w", err)
+	}
+	c.journal = journal
+	return nil
+}
+
+// loginOffline - вход без подключения к серверу по локальной копии данных.
+// Мастер-пароль проверяется расшифровкой локальной копии. Сохраненная сессия, если она есть,
+// используется при восстановлении подключения к серверу.
+func (c *Cli) loginOffline(username string, keys storage.Keys) error {
+	c.storage.Reset()
+	c.storage.SetProfile(username)
+	c.username = ""
+	if err := c.storage.LoadLocal(keys.Vault); err != nil {
+		return errors.New("сервер недоступен, а локальная копия данных не найдена или пароль неверен")
+	}
+
+	c.vaultKey = keys.Vault
+	c.authKey = keys.Auth
+	c.username = username
+	if err := c.openProfile(username); err != nil {
+		return err
+	}
+	session, err := c.storage.LoadSession(keys.Vault)
+	if err != nil {
+		session = storage.Session{}
+	}
+	c.setSession(session.Token, session.RefreshToken, session.ExpiresAt)
+	c.offline = true
+	return nil
+}
+
+// reconnect - восстанавливает подключение к серверу после работы в автономном режиме.
+// Если действующего токена нет, выполняется вход с ключом аутентификации, полученным при входе.
+func (c *Cli) reconnect() error {
+	if c.token != "" {
+		response, err := c.client.Authorize(c.ctx, &pb.AuthorizationRequest{Token: c.token})
+		if err != nil {
+			return err
+		}
+		if response.Success {
+			c.offline = false
+			return nil
+		}
+	}
+
+	preLogin, err := c.client.PreLogin(c.ctx, &pb.PreLoginRequest{Username: c.username})
+	if err != nil {
+		return err
+	}
+	if preLogin.Kdf == schema.ClientKDFNone {
+		return errors.New("для обновления учетной записи выполните аутентификацию")
+	}
+
+	response, err := c.client.Authenticate(c.ctx, &pb.AuthenticationRequest{Username: c.username, Password: c.authKey})
+	if err != nil {
+		return err
+	}
+	c.setSession(response.Token, response.RefreshToken, response.ExpiresAt.AsTime())
+	c.saveSession()
+	c.offline = false
+	return nil
+}
+
+// recordAdd - сохраняет новые данные локально под временным InfoID и записывает их добавление в журнал.
+func (c *Cli) recordAdd(cell *pb.MemoryCell) (int64, error) {
+	cell.Info.Id = c.journal.NextTempID()
+	if err := c.journal.RecordAdd(cell); err != nil {
+		return 0, err
+	}
+	c.storage.Put(cell)
+	c.saveLocal()
+	return cell.Info.Id, nil
+}
+
+// recordUpdate - изменяет данные локально и записывает изменение в журнал.
+// base - версия данных до изменения, используется при разрешении конфликта.
+func (c *Cli) recordUpdate(cell, base *pb.MemoryCell) error {
+	if err := c.journal.RecordUpdate(cell, base); err != nil {
+		return err
+	}
+	c.storage.Put(cell)
+	c.saveLocal()
+	return nil
+}
+
+// recordDelete - удаляет данные локально и записывает удаление в журнал.
+func (c *Cli) recordDelete(infoIDs []int64) error {
+	if err := c.journal.RecordDelete(infoIDs); err != nil {
+		return err
+	}
+	c.storage.Remove(infoIDs...)
+	c.saveLocal()
+	return nil
+}
+
+// replayJournal - отправляет на сервер изменения, сделанные в автономном режиме, и сообщает результат каждой операции.
+func (c *Cli) replayJournal() error {
+	if c.journal == nil || c.journal.Len() == 0 {
+		return nil
+	}
+
+	fmt.Fprintf(c.messages, "- Отправка изменений, сделанных без подключения к серверу (%d):\n", c.journal.Len())
+	results, err := c.journal.Replay(c.replayOperation)
+	for _, result := range results {
+		if result.Err != nil {
+			fmt.Fprintf(c.messages, "\t[ошибка] %s: %v\n", describeOperation(result.Operation), result.Err)
+			if result.Operation.Kind == storage.OpAdd {
+				c.discardTempID(result.Operation.Cell.Info.Id)
+				fmt.Fprintln(c.messages, "\t\tданные не сохранены на сервере и удалены из локальной копии")
+			}
+			continue
+		}
+		if result.Operation.Kind == storage.OpAdd {
+			fmt.Fprintf(c.messages, "\t[успешно] %s, ID данных: %d\n", describeOperation(result.Operation), result.NewID)
+			continue
+		}
+		fmt.Fprintf(c.messages, "\t[успешно] %s\n", describeOperation(result.Operation))
+	}
+	c.saveLocal()
+
+	if errors.Is(err, storage.ErrReplayInterrupted) {
+		c.offline = true
+		return fmt.Errorf("сервер недоступен, не отправлено операций: %d", c.journal.Len())
+	}
+	return err
+}
+
+// replayOperation - отправляет на сервер одну операцию журнала.
+// Для добавленных данных возвращает InfoID, назначенный сервером.
+func (c *Cli) replayOperation(op *pb.PendingOperation) (int64, error) {
+	var newID, revision int64
+	var err error
+	switch op.Kind {
+	case storage.OpAdd:
+		newID, revision, err = c.sendCell(op.Cell, true)
+		if err == nil {
+			c.commitTempID(op.Cell.Info.Id, newID, revision)
+		}
+	case storage.OpUpdate:
+		_, revision, err = c.sendCell(op.Cell, false)
+		if status.Code(err) == codes.Aborted {
+			// данные изменены на другом устройстве, пока клиент работал без подключения
+			err = c.resolveConflict(op.Base, op.Cell)
+			break
+		}
+		if err == nil {
+			c.storeRevision(op.Cell, revision)
+		}
+	case storage.OpDelete:
+		_, err = c.client.DeleteData(c.ctx, &pb.DeleteDataRequest{Ids: op.Ids})
+	default:
+		err = fmt.Errorf("неизвестная операция %q", op.Kind)
+	}
+
+	if isUnavailable(err) {
+		return 0, fmt.Errorf("%w: %v", storage.ErrReplayInterrupted, err)
+	}
+	return newID, err
+}
+
+// sendCell - шифрует ячейку и отправляет ее на сервер как новые (add) или измененные данные.
+// Изменение применяется, только если данные на сервере не менялись после ревизии ячейки,
+// иначе сервер возвращает codes.Aborted. Возвращает InfoID и новую ревизию данных.
+func (c *Cli) sendCell(cell *pb.MemoryCell, add bool) (int64, int64, error) {
+	encrypted, err := c.encryptCell(cell)
+	if err != nil {
+		return 0, 0, err
+	}
+	if add {
+		response, err := c.client.AddData(c.ctx, &pb.AddDataRequest{Data: encrypted})
+		if err != nil {
+			return 0, 0, err
+		}
+		return response.Id, response.Revision, nil
+	}
+
+	response, err := c.client.UpdateData(c.ctx, &pb.UpdateDataRequest{
+		Data:             encrypted,
+		ExpectedRevision: cell.Info.Revision,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return cell.Info.Id, response.Revision, nil
+}
+
+// storeRevision - сохраняет в локальной копии данные с ревизией, назначенной сервером.
+func (c *Cli) storeRevision(cell *pb.MemoryCell, revision int64) {
+	cell = proto.Clone(cell).(*pb.MemoryCell)
+	cell.Info.Revision = revision
+	c.storage.Put(cell)
+}
+
+// discardTempID - удаляет из локальной копии данные, которые сервер отказался создать, и файл, ожидающий загрузки.
+// Операция уже убрана из журнала, поэтому данные с временным InfoID больше не будут отправлены,
+// а их изменения записывались бы в журнал как изменения несуществующих на сервере данных.
+func (c *Cli) discardTempID(tempID int64) {
+	c.storage.Remove(tempID)
+	tempPath := filepath.Join(c.uploadsDir(), fmt.Sprintf("%d.enc", tempID))
+	if err := os.Remove(tempPath); err != nil && !os.IsNotExist(err) {
+		log.Printf("failed to remove pending upload: %v", err)
+	}
+}
+
+// commitTempID - заменяет временный InfoID данных на назначенный сервером
+// в локальной копии и в имени файла, ожидающего загрузки.
+func (c *Cli) commitTempID(tempID, newID, revision int64) {
+	if cell, err := c.storage.GetDataByID(tempID); err == nil {
+		c.storage.Remove(tempID)
+		cell.Info.Id = newID
+		c.storeRevision(cell, revision)
+	}
+
+	tempPath := filepath.Join(c.uploadsDir(), fmt.Sprintf("%d.enc", tempID))
+	if _, err := os.Stat(tempPath); err == nil {
+		newPath := filepath.Join(c.uploadsDir(), fmt.Sprintf("%d.enc", newID))
+		if err := os.Rename(tempPath, newPath); err != nil {
+			log.Printf("failed to rename pending upload: %v", err)
+		}
+	}
+}
+
+// saveLocal - сохраняет зашифрованную локальную копию данных для работы без подключения к серверу.
+func (c *Cli) saveLocal() {
+	if c.vaultKey == nil {
+		return
+	}
+	if err := c.storage.SaveLocal(c.vaultKey); err != nil {
+		log.Printf("failed to save local copy: %v", err)
+	}
+}
+
+// describeOperation - возвращает описание операции журнала для пользователя.
+func describeOperation(op *pb.PendingOperation) string {
+	switch op.Kind {
+	case storage.OpAdd:
+		return fmt.Sprintf("добавление данных %q", op.Cell.Info.Description)
+	case storage.OpUpdate:
+		return fmt.Sprintf("изменение данных %d %q", op.Cell.Info.Id, op.Cell.Info.Description)
+	case storage.OpDelete:
+		return fmt.Sprintf("удаление данных %v", op.Ids)
+	default:
+		return op.Kind
+	}
+}
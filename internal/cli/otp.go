@@ -0,0 +1,425 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/bubu256/gophkeeper_pet/internal/kinds"
+	"github.com/bubu256/gophkeeper_pet/internal/proto/pb"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/otp"
+	"google.golang.org/protobuf/proto"
+)
+
+// countdownWidth - длина полосы обратного отсчета в символах.
+const countdownWidth = 10
+
+// otpKeyOf - возвращает параметры генерации кодов из содержимого данных. Незаданные параметры заменяются значениями по умолчанию.
+func otpKeyOf(payload *pb.OTPPayload) (otp.Key, error) {
+	key := otp.Key{Type: payload.Type, Issuer: payload.Issuer, Account: payload.Account, Secret: payload.Secret, Algorithm: payload.Algorithm}
+	var err error
+	if key.Digits, err = otp.ParseDigits(payload.Digits); err != nil {
+		return otp.Key{}, err
+	}
+	if key.Period, err = otp.ParsePeriod(payload.Period); err != nil {
+		return otp.Key{}, err
+	}
+	if key.Counter, err = otp.ParseCounter(payload.Counter); err != nil {
+		return otp.Key{}, err
+	}
+	return key.Normalize()
+}
+
+// setOTPKey - сохраняет параметры генерации кодов в содержимое данных. Период сохраняется только для TOTP,
+// счетчик - только для HOTP.
+func setOTPKey(payload *pb.OTPPayload, key otp.Key) {
+	payload.Type, payload.Issuer, payload.Account = key.Type, key.Issuer, key.Account
+	payload.Secret, payload.Algorithm = key.Secret, key.Algorithm
+	payload.Digits, payload.Period, payload.Counter = strconv.Itoa(key.Digits), "", ""
+	if key.Type == otp.HOTP {
+		payload.Counter = strconv.FormatUint(key.Counter, 10)
+	} else {
+		payload.Period = strconv.Itoa(key.Period)
+	}
+}
+
+// normalizeOTP - проверяет параметры кодов в данных вида otp и заполняет незаданные значениями по умолчанию.
+// Данные других видов не изменяются.
+func normalizeOTP(cell *pb.MemoryCell) error {
+	payload := cell.GetOtp()
+	if payload == nil {
+		return nil
+	}
+	key, err := otpKeyOf(payload)
+	if err != nil {
+		return errors.New(validationMessage(err))
+	}
+	setOTPKey(payload, key)
+	return nil
+}
+
+// otpDescription - возвращает описание импортированного ключа: сервис и аккаунт.
+func otpDescription(key otp.Key) string {
+	switch {
+	case key.Issuer != "" && key.Account != "":
+		return fmt.Sprintf("%s (%s)", key.Issuer, key.Account)
+	case key.Issuer != "":
+		return key.Issuer
+	case key.Account != "":
+		return key.Account
+	}
+	return "Одноразовые коды"
+}
+
+// totpKey - возвращает параметры кодов TOTP выбранных данных. Возвращает false для данных других видов и кодов HOTP.
+func totpKey(cell *pb.MemoryCell) (otp.Key, bool) {
+	payload := cell.GetOtp()
+	if payload == nil {
+		return otp.Key{}, false
+	}
+	key, err := otpKeyOf(payload)
+	if err != nil || key.Type != otp.TOTP {
+		return otp.Key{}, false
+	}
+	return key, true
+}
+
+// formatCode - разбивает код на две группы цифр для удобства чтения.
+func formatCode(code string) string {
+	half := len(code) / 2
+	return code[:half] + " " + code[half:]
+}
+
+// seconds - округляет оставшееся время действия кода вверх до секунд.
+func seconds(d time.Duration) int {
+	return int((d + time.Second - 1) / time.Second)
+}
+
+// countdown - полоса обратного отсчета до смены кода TOTP и оставшиеся секунды.
+func countdown(key otp.Key, now time.Time) string {
+	left := seconds(otp.Remaining(key, now))
+	filled := (left*countdownWidth + key.Period - 1) / key.Period
+	return fmt.Sprintf("%s%s %2d с", strings.Repeat("█", filled), strings.Repeat("░", countdownWidth-filled), left)
+}
+
+// watchCode - выводит код TOTP с обратным отсчетом и обновляет строку каждую секунду, пока не закрыт канал stop.
+func (c *Cli) watchCode(key otp.Key, stop <-chan struct{}) error {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		now := time.Now()
+		code, err := otp.Code(key, now)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(c.stdout, "\r%s  %s ", formatCode(code), countdown(key, now))
+		select {
+		case <-stop:
+			fmt.Fprintln(c.stdout)
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// nextHOTP - возвращает код HOTP для текущего значения счетчика и сохраняет увеличенный счетчик,
+// чтобы следующий код отличался. Если счетчик сохранить не удалось, код не возвращается:
+// иначе тот же код был бы выдан повторно.
+func (c *Cli) nextHOTP(cell *pb.MemoryCell, key otp.Key) (string, error) {
+	code, err := otp.HOTPCode(key, key.Counter)
+	if err != nil {
+		return "", err
+	}
+	base := proto.Clone(cell).(*pb.MemoryCell)
+	cell.GetOtp().Counter = strconv.FormatUint(key.Counter+1, 10)
+	if err := c.updateCell(cell, base); err != nil {
+		if errors.Is(err, errConflict) {
+			return "", fmt.Errorf("%w, счетчик не сохранен: выполните синхронизацию и повторите", errConflict)
+		}
+		return "", err
+	}
+	return code, nil
+}
+
+// parseOTPLinks - разбирает ссылки otpauth:// и otpauth-migration:// по одной в строке.
+// Пустые строки и строки, начинающиеся с "#", пропускаются.
+func parseOTPLinks(lines []string) ([]otp.Key, error) {
+	var keys []otp.Key
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parsed, err := otp.Parse(line)
+		if err != nil {
+			return nil, fmt.Errorf("строка %d: %s", i+1, validationMessage(err))
+		}
+		keys = append(keys, parsed...)
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("нет ссылок для импорта")
+	}
+	return keys, nil
+}
+
+// importOTPKeys - добавляет данные вида otp для каждого ключа и возвращает их InfoID.
+// При ошибке возвращает InfoID уже добавленных данных.
+func (c *Cli) importOTPKeys(keys []otp.Key) ([]int64, error) {
+	infoIDs := make([]int64, 0, len(keys))
+	for _, key := range keys {
+		payload := &pb.OTPPayload{}
+		setOTPKey(payload, key)
+		cell := &pb.MemoryCell{
+			Info:          &pb.InfoCell{DataType: kinds.OTP, Description: otpDescription(key)},
+			KeyValuePairs: map[string]string{},
+			Payload:       &pb.MemoryCell_Otp{Otp: payload},
+		}
+		infoID, err := c.sendNewCell(cell)
+		if err != nil {
+			return infoIDs, err
+		}
+		infoIDs = append(infoIDs, infoID)
+	}
+	return infoIDs, nil
+}
+
+// runOTP - команда otp: выводит текущий одноразовый код данных вида otp, а с флагом --watch обновляет код TOTP
+// с обратным отсчетом, пока команду не прервут. Для кодов HOTP счетчик увеличивается и сохраняется до вывода кода.
+// Подкоманда import добавляет ключи из ссылок, прочитанных из стандартного ввода.
+func (c *Cli) runOTP(opts globalOptions, args []string) error {
+	if len(args) > 0 && args[0] == "import" {
+		return c.runOTPImport(opts, args[1:])
+	}
+
+	fs := c.newFlagSet("otp")
+	watch := fs.Bool("watch", false, "обновлять код TOTP каждую секунду с обратным отсчетом, пока команду не прервут")
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return usageError("укажите один InfoID")
+	}
+	infoID, err := strconv.ParseInt(positional[0], 10, 64)
+	if err != nil {
+		return usageError("неверный InfoID %q", positional[0])
+	}
+	if *watch && c.structured() {
+		return usageError("флаг --watch не используется с форматом вывода %s", c.format)
+	}
+
+	// коды TOTP агент выдает без мастер-пароля, счетчик HOTP нужно сохранить, для этого нужен вход
+	var data *pb.MemoryCell
+	response, ok, err := c.agentGet(opts, infoID)
+	if err != nil {
+		return err
+	}
+	if ok {
+		data = response.Data
+	}
+	if !ok || data.GetOtp() != nil && data.GetOtp().Type == otp.HOTP {
+		if err := c.authenticate(opts); err != nil {
+			return err
+		}
+		if data, err = c.getCell(infoID); err != nil {
+			return err
+		}
+		if data == nil {
+			return errDataNotFound
+		}
+	}
+
+	payload := data.GetOtp()
+	if payload == nil {
+		return usageError("данные %d вида %s, а не %s", infoID, data.Info.DataType, kinds.OTP)
+	}
+	key, err := otpKeyOf(payload)
+	if err != nil {
+		return fmt.Errorf("неверные параметры кодов: %s", validationMessage(err))
+	}
+
+	if key.Type == otp.HOTP {
+		if *watch {
+			return usageError("флаг --watch используется только для кодов %s", otp.TOTP)
+		}
+		code, err := c.nextHOTP(data, key)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(c.messages, "- Код получен по счетчику %d, следующий код - по счетчику %d.\n", key.Counter, key.Counter+1)
+		if c.structured() {
+			return c.writeDocument(otpDocument{ID: infoID, Type: key.Type, Code: code, Counter: &key.Counter})
+		}
+		fmt.Fprintln(c.stdout, code)
+		return nil
+	}
+
+	if *watch {
+		ctx, stop := signal.NotifyContext(c.ctx, os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		return c.watchCode(key, ctx.Done())
+	}
+	now := time.Now()
+	code, err := otp.Code(key, now)
+	if err != nil {
+		return err
+	}
+	remaining := seconds(otp.Remaining(key, now))
+	fmt.Fprintf(c.messages, "- Код действует еще %d с.\n", remaining)
+	if c.structured() {
+		return c.writeDocument(otpDocument{ID: infoID, Type: key.Type, Code: code, Remaining: remaining})
+	}
+	fmt.Fprintln(c.stdout, code)
+	return nil
+}
+
+// runOTPImport - команда otp import: добавляет данные вида otp из ссылок otpauth:// и otpauth-migration://
+// (экспорт Google Authenticator), прочитанных из стандартного ввода по одной в строке, и выводит их InfoID.
+// Если хотя бы одна ссылка неверна, ничего не добавляется.
+func (c *Cli) runOTPImport(opts globalOptions, args []string) error {
+	positional, err := parseFlags(c.newFlagSet("otp import"), args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return usageError("лишние аргументы: %v", positional)
+	}
+
+	// мастер-пароль читается из стандартного ввода раньше ссылок
+	if err := c.authenticate(opts); err != nil {
+		return err
+	}
+	input, err := readAll(c.stdin)
+	if err != nil {
+		return err
+	}
+	keys, err := parseOTPLinks(strings.Split(input, "\n"))
+	if err != nil {
+		return usageError("%v", err)
+	}
+
+	infoIDs, err := c.importOTPKeys(keys)
+	if len(infoIDs) > 0 {
+		fmt.Fprintf(c.messages, "- Добавлено ключей: %d из %d.\n", len(infoIDs), len(keys))
+	}
+	if err != nil {
+		return err
+	}
+	pending := false
+	for _, infoID := range infoIDs {
+		pending = pending || infoID < 0
+	}
+	if pending {
+		fmt.Fprintln(c.messages, "- Сервер недоступен, данные будут отправлены при синхронизации.")
+	}
+	if c.structured() {
+		doc := importedDocument{Imported: make([]addedDocument, 0, len(infoIDs))}
+		for _, infoID := range infoIDs {
+			doc.Imported = append(doc.Imported, addedDocument{ID: infoID, Pending: infoID < 0})
+		}
+		return c.writeDocument(doc)
+	}
+	for _, infoID := range infoIDs {
+		fmt.Fprintln(c.stdout, infoID)
+	}
+	return nil
+}
+
+// OTP - одноразовые коды в меню: код с обратным отсчетом или импорт ключей из ссылок.
+func (c *Cli) OTP() {
+	if c.vaultKey == nil {
+		fmt.Println("- Выполните аутентификацию.")
+		return
+	}
+	fmt.Print("1. Показать код;  2. Импорт ссылок otpauth:// и otpauth-migration:// [1]: ")
+	switch readLine(c.stdin) {
+	case "", "1":
+		c.showCode()
+	case "2":
+		c.importLinks()
+	default:
+		fmt.Println("- Неизвестный пункт.")
+	}
+}
+
+// showCode - выводит код данных вида otp. Код TOTP обновляется с обратным отсчетом до нажатия Enter.
+func (c *Cli) showCode() {
+	fmt.Print("Введите InfoID данных: ")
+	infoID, err := strconv.ParseInt(readLine(c.stdin), 10, 64)
+	if err != nil {
+		fmt.Println("Ошибка при чтении InfoID:", err)
+		return
+	}
+	data, err := c.getCell(infoID)
+	if err != nil {
+		fmt.Println("Ошибка при получении данных:", err)
+		return
+	}
+	if data == nil {
+		fmt.Println("- Данные не найдены")
+		return
+	}
+	payload := data.GetOtp()
+	if payload == nil {
+		fmt.Println("- Это не одноразовые коды.")
+		return
+	}
+	key, err := otpKeyOf(payload)
+	if err != nil {
+		fmt.Println("- Неверные параметры кодов:", validationMessage(err))
+		return
+	}
+
+	if key.Type == otp.HOTP {
+		code, err := c.nextHOTP(data, key)
+		if err != nil {
+			fmt.Println("-", err)
+			return
+		}
+		fmt.Printf("Код: %s (счетчик %d)\n", formatCode(code), key.Counter)
+		return
+	}
+
+	fmt.Println("Нажмите Enter, чтобы вернуться в меню.")
+	stop := make(chan struct{})
+	go func() {
+		readLine(c.stdin)
+		close(stop)
+	}()
+	if err := c.watchCode(key, stop); err != nil {
+		fmt.Println("-", err)
+		<-stop
+	}
+}
+
+// importLinks - добавляет данные вида otp из ссылок, введенных по одной в строке.
+func (c *Cli) importLinks() {
+	fmt.Println("Вставьте ссылки по одной в строке (пустая строка для завершения ввода):")
+	var lines []string
+	for {
+		line := readLine(c.stdin)
+		if line == "" {
+			break
+		}
+		lines = append(lines, line)
+	}
+	keys, err := parseOTPLinks(lines)
+	if err != nil {
+		fmt.Println("-", err)
+		return
+	}
+	infoIDs, err := c.importOTPKeys(keys)
+	for i, infoID := range infoIDs {
+		fmt.Printf("- %s: InfoID %d\n", otpDescription(keys[i]), infoID)
+	}
+	if err != nil {
+		fmt.Println("Ошибка при сохранении данных:", err)
+		return
+	}
+	fmt.Printf("- Добавлено ключей: %d.\n", len(infoIDs))
+}
@@ -0,0 +1,308 @@
+package cli
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/bubu256/gophkeeper_pet/internal/proto/pb"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/client/audit"
+	"gopkg.in/yaml.v3"
+)
+
+// Форматы вывода результата команд.
+const (
+	formatText = "text"
+	formatJSON = "json"
+	formatYAML = "yaml"
+)
+
+// envOutput - переменная окружения с форматом вывода по умолчанию.
+const envOutput = "GOPHKEEPER_OUTPUT"
+
+// Документы вывода команд в форматах JSON и YAML. Имена и смысл полей не меняются между версиями клиента:
+// новые поля могут добавляться, существующие не удаляются и не переименовываются. Схема описана в README.
+
+// infoDocument - информация о данных.
+type infoDocument struct {
+	ID          int64    `json:"id" yaml:"id"`
+	Kind        string   `json:"kind" yaml:"kind"`
+	Description string   `json:"description" yaml:"description"`
+	Size        int64    `json:"size" yaml:"size"`
+	Revision    int64    `json:"revision" yaml:"revision"`
+	Folder      string   `json:"folder,omitempty" yaml:"folder,omitempty"`
+	Tags        []string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	// CreatedAt, UpdatedAt - время создания и изменения на сервере в формате RFC 3339.
+	CreatedAt string `json:"createdAt,omitempty" yaml:"createdAt,omitempty"`
+	UpdatedAt string `json:"updatedAt,omitempty" yaml:"updatedAt,omitempty"`
+}
+
+// cellDocument - данные целиком: информация о данных, поля содержимого, дополнительные поля и файл.
+type cellDocument struct {
+	infoDocument `yaml:",inline"`
+	// Fields - поля содержимого по именам: url, username, password (login), number, holder, expiry, cvv (card),
+	// text (note), media-type (file), issuer, account, secret, type, algorithm, digits, period, counter (otp).
+	// Пустые поля не выводятся.
+	Fields map[string]string `json:"fields,omitempty" yaml:"fields,omitempty"`
+	Extra  map[string]string `json:"extra,omitempty" yaml:"extra,omitempty"`
+	File   *fileDocument     `json:"file,omitempty" yaml:"file,omitempty"`
+}
+
+// fileDocument - файл из данных. Содержимое выводится в Data в кодировке base64 или,
+// если файл сохранен на диск флагом --out, путь к файлу выводится в Path.
+// Большие файлы хранятся на сервере (Remote) и выводятся только сохранением на диск.
+type fileDocument struct {
+	Name   string `json:"name" yaml:"name"`
+	Size   int64  `json:"size" yaml:"size"`
+	Remote bool   `json:"remote,omitempty" yaml:"remote,omitempty"`
+	Data   string `json:"data,omitempty" yaml:"data,omitempty"`
+	Path   string `json:"path,omitempty" yaml:"path,omitempty"`
+}
+
+// fieldDocument - значение одного поля данных.
+type fieldDocument struct {
+	ID    int64  `json:"id" yaml:"id"`
+	Field string `json:"field" yaml:"field"`
+	Value string `json:"value" yaml:"value"`
+}
+
+// addedDocument - результат добавления данных. Pending - данные сохранены без подключения к серверу
+// под временным отрицательным InfoID и будут отправлены при синхронизации.
+type addedDocument struct {
+	ID      int64 `json:"id" yaml:"id"`
+	Pending bool  `json:"pending" yaml:"pending"`
+}
+
+// importedDocument - результат импорта одноразовых кодов: добавленные данные в порядке ссылок.
+type importedDocument struct {
+	Imported []addedDocument `json:"imported" yaml:"imported"`
+}
+
+// otpDocument - одноразовый код. Remaining - сколько секунд еще действует код TOTP,
+// Counter - значение счетчика, по которому получен код HOTP.
+type otpDocument struct {
+	ID        int64   `json:"id" yaml:"id"`
+	Type      string  `json:"type" yaml:"type"`
+	Code      string  `json:"code" yaml:"code"`
+	Remaining int     `json:"remaining,omitempty" yaml:"remaining,omitempty"`
+	Counter   *uint64 `json:"counter,omitempty" yaml:"counter,omitempty"`
+}
+
+// removedDocument - результат удаления данных.
+type removedDocument struct {
+	Removed []int64 `json:"removed" yaml:"removed"`
+}
+
+// sessionDocument - результат входа и синхронизации.
+type sessionDocument struct {
+	User     string `json:"user" yaml:"user"`
+	Offline  bool   `json:"offline" yaml:"offline"`
+	Revision int64  `json:"revision" yaml:"revision"`
+	// Pending - количество изменений, ожидающих отправки на сервер.
+	Pending int `json:"pending" yaml:"pending"`
+}
+
+// logoutDocument - результат выхода из аккаунта.
+type logoutDocument struct {
+	User       string `json:"user" yaml:"user"`
+	AllDevices bool   `json:"allDevices" yaml:"allDevices"`
+}
+
+// agentDocument - состояние агента. LockAt - время автоматической блокировки в формате RFC 3339.
+type agentDocument struct {
+	User    string `json:"user" yaml:"user"`
+	Locked  bool   `json:"locked" yaml:"locked"`
+	Offline bool   `json:"offline" yaml:"offline"`
+	LockAt  string `json:"lockAt,omitempty" yaml:"lockAt,omitempty"`
+}
+
+// generatedDocument - сгенерированный пароль или парольная фраза. Entropy - энтропия в битах.
+type generatedDocument struct {
+	Value    string `json:"value" yaml:"value"`
+	Entropy  int    `json:"entropy" yaml:"entropy"`
+	Strength string `json:"strength" yaml:"strength"`
+}
+
+// auditDocument - отчет о проверке надежности данных.
+type auditDocument struct {
+	Checked   int                   `json:"checked" yaml:"checked"`
+	Passwords int                   `json:"passwords" yaml:"passwords"`
+	Cards     int                   `json:"cards" yaml:"cards"`
+	Weak      []auditWeakDocument   `json:"weak" yaml:"weak"`
+	Reused    [][]auditItemDocument `json:"reused" yaml:"reused"`
+	Old       []auditOldDocument    `json:"old" yaml:"old"`
+	Expiring  []auditExpiryDocument `json:"expiring" yaml:"expiring"`
+	// Breached - пароли из известных утечек, пустой список, если проверка по базе утечек не выполнялась.
+	Breached []auditBreachedDocument `json:"breached" yaml:"breached"`
+}
+
+// auditItemDocument - данные, к которым относится замечание.
+type auditItemDocument struct {
+	ID          int64  `json:"id" yaml:"id"`
+	Kind        string `json:"kind" yaml:"kind"`
+	Description string `json:"description" yaml:"description"`
+}
+
+// auditWeakDocument - слабый пароль: оценка от 0 до 4 и энтропия в битах.
+type auditWeakDocument struct {
+	auditItemDocument `yaml:",inline"`
+	Score             int `json:"score" yaml:"score"`
+	Entropy           int `json:"entropy" yaml:"entropy"`
+}
+
+// auditBreachedDocument - пароль из известных утечек: Count - количество его появлений в утечках.
+type auditBreachedDocument struct {
+	auditItemDocument `yaml:",inline"`
+	Count             int64 `json:"count" yaml:"count"`
+}
+
+// auditOldDocument - давно не менявшийся пароль. UpdatedAt - время последнего изменения в формате RFC 3339.
+type auditOldDocument struct {
+	auditItemDocument `yaml:",inline"`
+	UpdatedAt         string `json:"updatedAt" yaml:"updatedAt"`
+	AgeDays           int    `json:"ageDays" yaml:"ageDays"`
+}
+
+// auditExpiryDocument - карта с истекающим сроком действия. Expiry - срок действия в формате MM/YY.
+type auditExpiryDocument struct {
+	auditItemDocument `yaml:",inline"`
+	Expiry            string `json:"expiry" yaml:"expiry"`
+	Expired           bool   `json:"expired" yaml:"expired"`
+	DaysLeft          int    `json:"daysLeft" yaml:"daysLeft"`
+}
+
+// auditDocumentOf - возвращает документ отчета о проверке. Пустые списки выводятся как пустые массивы.
+func auditDocumentOf(report audit.Report) auditDocument {
+	doc := auditDocument{
+		Checked:   report.Checked,
+		Passwords: report.Passwords,
+		Cards:     report.Cards,
+		Weak:      make([]auditWeakDocument, 0, len(report.Weak)),
+		Reused:    make([][]auditItemDocument, 0, len(report.Reused)),
+		Old:       make([]auditOldDocument, 0, len(report.Old)),
+		Expiring:  make([]auditExpiryDocument, 0, len(report.Expiring)),
+		Breached:  make([]auditBreachedDocument, 0, len(report.Breached)),
+	}
+	for _, weak := range report.Weak {
+		doc.Weak = append(doc.Weak, auditWeakDocument{auditItemDocumentOf(weak.Item), weak.Score, int(weak.Entropy)})
+	}
+	for _, group := range report.Reused {
+		items := make([]auditItemDocument, 0, len(group))
+		for _, item := range group {
+			items = append(items, auditItemDocumentOf(item))
+		}
+		doc.Reused = append(doc.Reused, items)
+	}
+	for _, breached := range report.Breached {
+		doc.Breached = append(doc.Breached, auditBreachedDocument{auditItemDocumentOf(breached.Item), breached.Count})
+	}
+	for _, old := range report.Old {
+		doc.Old = append(doc.Old, auditOldDocument{auditItemDocumentOf(old.Item), old.UpdatedAt.Format(time.RFC3339), int(old.Age / day)})
+	}
+	for _, card := range report.Expiring {
+		doc.Expiring = append(doc.Expiring, auditExpiryDocument{auditItemDocumentOf(card.Item), card.Expiry.String(), card.Expired, daysLeft(card.Left)})
+	}
+	return doc
+}
+
+func auditItemDocumentOf(item audit.Item) auditItemDocument {
+	return auditItemDocument{ID: item.ID, Kind: item.Kind, Description: item.Description}
+}
+
+// parseFormat - проверяет формат вывода.
+func parseFormat(format string) (string, error) {
+	switch format = strings.ToLower(format); format {
+	case "", formatText:
+		return formatText, nil
+	case formatJSON, formatYAML:
+		return format, nil
+	}
+	return "", fmt.Errorf("неизвестный формат вывода %q, доступны: text, json, yaml", format)
+}
+
+// structured - сообщает, что результат команды выводится документом JSON или YAML.
+func (c *Cli) structured() bool {
+	return c.format == formatJSON || c.format == formatYAML
+}
+
+// writeDocument - выводит документ в формате JSON или YAML.
+func (c *Cli) writeDocument(doc interface{}) error {
+	if c.format == formatYAML {
+		encoder := yaml.NewEncoder(c.stdout)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(doc); err != nil {
+			return fmt.Errorf("ошибка вывода результата: %w", err)
+		}
+		return encoder.Close()
+	}
+
+	encoder := json.NewEncoder(c.stdout)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(doc); err != nil {
+		return fmt.Errorf("ошибка вывода результата: %w", err)
+	}
+	return nil
+}
+
+// infoDocumentOf - возвращает документ информации о данных.
+func infoDocumentOf(info *pb.InfoCell, folders []*pb.Folder) infoDocument {
+	doc := infoDocument{
+		ID:          info.Id,
+		Kind:        info.DataType,
+		Description: info.Description,
+		Size:        int64(info.DataSize),
+		Revision:    info.Revision,
+		Tags:        info.Tags,
+	}
+	if info.FolderId != 0 {
+		doc.Folder = folderPath(folders, info.FolderId)
+	}
+	if info.CreatedAt != nil {
+		doc.CreatedAt = info.CreatedAt.AsTime().Format(time.RFC3339)
+	}
+	if info.UpdatedAt != nil {
+		doc.UpdatedAt = info.UpdatedAt.AsTime().Format(time.RFC3339)
+	}
+	return doc
+}
+
+// cellDocumentOf - возвращает документ данных. Содержимое небольшого файла кодируется в base64.
+func cellDocumentOf(cell *pb.MemoryCell, folders []*pb.Folder) cellDocument {
+	doc := cellDocument{infoDocument: infoDocumentOf(cell.Info, folders)}
+	for _, field := range payloadFields(cell) {
+		if *field.value == "" {
+			continue
+		}
+		if doc.Fields == nil {
+			doc.Fields = make(map[string]string)
+		}
+		doc.Fields[field.name] = *field.value
+	}
+	if len(cell.KeyValuePairs) > 0 {
+		doc.Extra = cell.KeyValuePairs
+	}
+
+	if cell.FileName != "" {
+		doc.File = &fileDocument{Name: cell.FileName, Size: int64(len(cell.BinaryData))}
+		if cell.Blob {
+			doc.File.Size = int64(cell.Info.DataSize)
+			doc.File.Remote = true
+		} else {
+			doc.File.Data = base64.StdEncoding.EncodeToString(cell.BinaryData)
+		}
+	}
+	return doc
+}
+
+// savedFileDocument - отмечает в документе данных, что файл сохранен в папку, вместо вывода его содержимого.
+func savedFileDocument(doc *cellDocument, directoryPath string) {
+	if doc.File == nil {
+		return
+	}
+	doc.File.Data = ""
+	doc.File.Path = filepath.Join(directoryPath, doc.File.Name)
+}
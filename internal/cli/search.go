@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+)
+
+// searchLimit - наибольшее количество выводимых результатов поиска.
+const searchLimit = 20
+
+// Search - ищет данные в локальной копии по словам запроса. Поиск выполняется без обращения к серверу,
+// находит слова по началу и с опечатками и выводит результаты в порядке релевантности.
+func (c *Cli) Search() {
+	fmt.Print("Поисковый запрос: ")
+	query := readLine(bufio.NewReader(os.Stdin))
+	if query == "" {
+		return
+	}
+
+	results := c.storage.Search(query, searchLimit)
+	if len(results) == 0 {
+		if len(c.storage.GetData()) == 0 {
+			fmt.Println("- Локальная копия пуста, выполните синхронизацию.")
+			return
+		}
+		fmt.Println("- Ничего не найдено.")
+		return
+	}
+
+	folders := c.storage.Folders()
+	fmt.Println("\tНайденные данные:")
+	fmt.Println("\t-------------------------------")
+	for _, result := range results {
+		cell, err := c.storage.GetDataByID(result.ID)
+		if err != nil {
+			continue
+		}
+		fmt.Printf("\tID: %d (релевантность %.1f)\n", cell.Info.Id, result.Score)
+		fmt.Printf("\tВид данных: %s\n", kindTitle(cell.Info.DataType))
+		fmt.Printf("\tОписание: %s\n", cell.Info.Description)
+		printFolderAndTags(c.stdout, cell.Info, folders)
+		fmt.Println("\t-------------------------------")
+	}
+}
@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"golang.org/x/term"
+)
+
+// errSecretMismatch возвращается, если секрет и его подтверждение не совпали.
+var errSecretMismatch = errors.New("введенные значения не совпадают")
+
+// isTerminal - сообщает, что стандартный ввод - терминал и секреты можно вводить без отображения.
+func (c *Cli) isTerminal() bool {
+	return c.stdinFd >= 0 && term.IsTerminal(c.stdinFd)
+}
+
+// readSecret - выводит приглашение и читает секрет (пароль, CVV) с отключенным отображением вводимых символов.
+// Если стандартный ввод не терминал (секрет передан через конвейер или файл), читается строка из c.stdin,
+// поэтому остальной ввод функций, запрашивающих секреты, тоже читается из c.stdin.
+func (c *Cli) readSecret(prompt string) (string, error) {
+	fmt.Fprint(c.messages, prompt)
+	if !c.isTerminal() {
+		return readLine(c.stdin), nil
+	}
+
+	// при прерывании ввода сигналом отображение символов в терминале восстанавливается
+	state, err := term.GetState(c.stdinFd)
+	if err != nil {
+		return "", fmt.Errorf("ошибка чтения ввода: %w", err)
+	}
+	c.setTerminalState(state)
+	defer c.setTerminalState(nil)
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-signals:
+			c.RestoreTerminal()
+			fmt.Fprintln(c.messages)
+			os.Exit(ExitError)
+		case <-done:
+		}
+	}()
+
+	secret, err := term.ReadPassword(c.stdinFd)
+	fmt.Fprintln(c.messages)
+	if err != nil {
+		return "", fmt.Errorf("ошибка чтения ввода: %w", err)
+	}
+	return strings.TrimSpace(string(secret)), nil
+}
+
+// readNewSecret - читает новый секрет и, если ввод с терминала, его подтверждение.
+// При вводе из конвейера или файла опечатка невозможна, и подтверждение не запрашивается.
+func (c *Cli) readNewSecret(prompt string) (string, error) {
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		secret, err := c.readSecret(prompt)
+		if err != nil || !c.isTerminal() {
+			return secret, err
+		}
+		confirmation, err := c.readSecret("Повторите ввод: ")
+		if err != nil {
+			return "", err
+		}
+		if secret == confirmation {
+			return secret, nil
+		}
+		fmt.Fprintf(c.messages, "- %s.\n", errSecretMismatch)
+	}
+	return "", errSecretMismatch
+}
+
+// setTerminalState - запоминает состояние терминала до отключения отображения ввода.
+func (c *Cli) setTerminalState(state *term.State) {
+	c.terminalMu.Lock()
+	defer c.terminalMu.Unlock()
+	c.terminalState = state
+}
+
+// RestoreTerminal - восстанавливает отображение ввода в терминале, если приложение завершается во время ввода секрета.
+func (c *Cli) RestoreTerminal() {
+	c.terminalMu.Lock()
+	defer c.terminalMu.Unlock()
+	if c.terminalState != nil {
+		term.Restore(c.stdinFd, c.terminalState)
+	}
+}
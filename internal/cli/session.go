@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/bubu256/gophkeeper_pet/internal/proto/pb"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/client/storage"
+)
+
+// resumeSession - продолжает сессию, сохраненную предыдущим запуском клиента. Токен доступа проверяется
+// на сервере методом Authorize, истекший токен обновляется. Данные загружаются из локальной копии,
+// поэтому при синхронизации с сервера запрашиваются только изменения после сохраненной ревизии.
+// Возвращает false, если сохраненной сессии нет, она недействительна или мастер-пароль не подходит к ней.
+func (c *Cli) resumeSession(username string, keys storage.Keys) (bool, error) {
+	if c.username != username {
+		// данные и ключи другого пользователя не должны остаться в клиенте, если вход не удастся
+		c.storage.Reset()
+		c.storage.SetProfile(username)
+		c.username = ""
+		c.vaultKey = nil
+		c.journal = nil
+	}
+
+	session, err := c.storage.LoadSession(keys.Vault)
+	if err != nil {
+		if !errors.Is(err, storage.ErrNoSession) {
+			log.Printf("failed to load session: %v", err)
+		}
+		return false, nil
+	}
+
+	valid := false
+	if time.Until(session.ExpiresAt) > tokenRefreshMargin {
+		response, err := c.client.Authorize(c.ctx, &pb.AuthorizationRequest{Token: session.Token})
+		if isUnavailable(err) {
+			return false, err
+		}
+		if err != nil {
+			log.Printf("failed to check saved session: %v", err)
+			return false, nil
+		}
+		valid = response.Success
+	}
+
+	c.setSession(session.Token, session.RefreshToken, session.ExpiresAt)
+	if !valid {
+		response, err := c.client.RefreshToken(c.ctx, &pb.RefreshTokenRequest{RefreshToken: session.RefreshToken})
+		if isUnavailable(err) {
+			return false, err
+		}
+		if err != nil {
+			// сессия завершена на сервере или истекла
+			c.setSession("", "", time.Time{})
+			if err := c.storage.RemoveSession(); err != nil {
+				log.Printf("failed to remove session: %v", err)
+			}
+			return false, nil
+		}
+		c.setSession(response.Token, response.RefreshToken, response.ExpiresAt.AsTime())
+	}
+
+	c.vaultKey = keys.Vault
+	c.authKey = keys.Auth
+	c.offline = false
+	if err := c.openProfile(username); err != nil {
+		return false, err
+	}
+	if !valid {
+		c.saveSession()
+	}
+	return true, nil
+}
+
+// saveSession - сохраняет токены сессии, чтобы следующий запуск клиента продолжил ее без входа на сервере.
+func (c *Cli) saveSession() {
+	if c.vaultKey == nil || c.refreshToken == "" {
+		return
+	}
+	session := storage.Session{Token: c.token, RefreshToken: c.refreshToken, ExpiresAt: c.tokenExpiresAt}
+	if err := c.storage.SaveSession(session, c.vaultKey); err != nil {
+		log.Printf("failed to save session: %v", err)
+	}
+}
+
+// resumeLastSession - при запуске меню предлагает продолжить сессию пользователя, входившего последним.
+func (c *Cli) resumeLastSession() {
+	username := c.storage.LastUser()
+	if username == "" {
+		return
+	}
+
+	password, err := c.readSecret(fmt.Sprintf("Сохранена сессия пользователя %s. Введите мастер-пароль (пустая строка - пропустить): ", username))
+	if err != nil || password == "" {
+		return
+	}
+	c.completeLogin(username, password)
+	fmt.Println()
+}
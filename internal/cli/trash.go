@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+
+	"github.com/bubu256/gophkeeper_pet/internal/proto/pb"
+)
+
+// Trash - показывает данные в корзине, восстанавливает их или очищает корзину.
+// Данные в корзине окончательно удаляются сервером по истечении срока хранения.
+func (c *Cli) Trash() {
+	if c.offline {
+		fmt.Println("- Корзина доступна только при подключении к серверу.")
+		return
+	}
+
+	response, err := c.client.ListTrash(c.ctx, &pb.ListTrashRequest{})
+	if err != nil {
+		c.goOffline(err)
+		fmt.Println("Ошибка при получении корзины:", err)
+		return
+	}
+	if len(response.Info) == 0 {
+		fmt.Println("- Корзина пуста.")
+		return
+	}
+
+	fmt.Println("\tДанные в корзине:")
+	fmt.Println("\t-------------------------------")
+	for _, info := range response.Info {
+		fmt.Printf("\tID: %d\n", info.Id)
+		fmt.Printf("\tВид данных: %s\n", kindTitle(info.DataType))
+		fmt.Printf("\tОписание: %s\n", info.Description)
+		fmt.Printf("\tУдалено: %s\n", info.DeletedAt.AsTime().Local().Format("02.01.2006 15:04:05"))
+		fmt.Println("\t-------------------------------")
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	fmt.Println("Выберите действие:  1. Восстановить данные;  2. Очистить корзину;  пустая строка - выход")
+	fmt.Print("Ваш выбор: ")
+	switch readLine(reader) {
+	case "1":
+		c.restoreFromTrash(reader)
+	case "2":
+		fmt.Print("Удалить данные из корзины без возможности восстановления? (y/n): ")
+		if answer := readLine(reader); answer != "y" && answer != "yes" {
+			fmt.Println("- Очистка отменена.")
+			return
+		}
+		response, err := c.client.EmptyTrash(c.ctx, &pb.EmptyTrashRequest{})
+		if err != nil {
+			fmt.Println("- Ошибка при очистке корзины:", err)
+			return
+		}
+		fmt.Println("- Корзина очищена, удалено данных:", response.Deleted)
+	}
+}
+
+// restoreFromTrash - восстанавливает данные из корзины по списку InfoID и загружает их в локальную копию.
+func (c *Cli) restoreFromTrash(reader *bufio.Reader) {
+	fmt.Print("Введите InfoID для восстановления через пробел: ")
+	infoIDs, err := parseIDs(readLine(reader))
+	if err != nil {
+		fmt.Println("Ошибка при чтении InfoID:", err)
+		return
+	}
+
+	if _, err := c.client.RestoreFromTrash(c.ctx, &pb.RestoreFromTrashRequest{Ids: infoIDs}); err != nil {
+		fmt.Println("- Ошибка при восстановлении данных:", err)
+		return
+	}
+	// восстановленные данные получают новую ревизию и приходят с изменениями
+	if err := c.syncChanges(); err != nil {
+		fmt.Println("- Ошибка при синхронизации:", err)
+	}
+	c.saveLocal()
+	fmt.Println("- Данные восстановлены.")
+}
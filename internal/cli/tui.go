@@ -0,0 +1,807 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/atotto/clipboard"
+	"github.com/bubu256/gophkeeper_pet/internal/kinds"
+	"github.com/bubu256/gophkeeper_pet/internal/proto/pb"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/otp"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// tuiSyncInterval - период фоновой синхронизации в полноэкранном режиме.
+	tuiSyncInterval = 30 * time.Second
+	// clipboardClearAfter - через сколько скопированное значение удаляется из буфера обмена.
+	clipboardClearAfter = 30 * time.Second
+	// Размер экрана, если терминал его не сообщает.
+	defaultTUIWidth  = 100
+	defaultTUIHeight = 30
+)
+
+// primaryFields - поле содержимого, копируемое в буфер обмена, по виду данных. Для остальных видов копируется описание.
+var primaryFields = map[string]string{kinds.Login: "password", kinds.Card: "number", kinds.Note: "text"}
+
+// tuiMode - режим полноэкранного интерфейса: что сейчас принимает нажатия клавиш.
+type tuiMode int
+
+const (
+	modeList tuiMode = iota
+	modeSearch
+	modeKind
+	modeDelete
+	modeForm
+)
+
+// opDoneMsg - результат операции, выполненной в фоне: синхронизации, сохранения или удаления данных.
+type opDoneMsg struct {
+	op       string
+	status   string
+	selectID int64
+	err      error
+}
+
+// syncTickMsg - время фоновой синхронизации.
+type syncTickMsg struct{}
+
+// codeTickMsg - время обновить одноразовый код и обратный отсчет.
+type codeTickMsg struct{}
+
+// clipboardClearMsg - время удалить скопированное значение из буфера обмена.
+type clipboardClearMsg struct {
+	value string
+}
+
+// statusLine - принимает сообщения о ходе работы Cli и хранит последнее для строки состояния.
+type statusLine struct {
+	mu   sync.Mutex
+	last string
+}
+
+func (s *statusLine) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, line := range strings.FieldsFunc(string(p), func(r rune) bool { return r == '\n' || r == '\r' }) {
+		if line = strings.TrimSpace(line); line != "" {
+			s.last = strings.TrimPrefix(line, "- ")
+		}
+	}
+	return len(p), nil
+}
+
+// take - возвращает последнее сообщение и забывает его.
+func (s *statusLine) take() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	last := s.last
+	s.last = ""
+	return last
+}
+
+// tuiModel - состояние полноэкранного интерфейса. Пока операция выполняется в фоне (busy не пуст),
+// интерфейс не обращается к Cli и хранилищу и показывает список и данные, загруженные до операции.
+type tuiModel struct {
+	cli      *Cli
+	messages *statusLine
+	width    int
+	height   int
+	mode     tuiMode
+
+	search   textinput.Model
+	items    []*pb.InfoCell
+	cursor   int
+	offset   int
+	detail   *pb.MemoryCell
+	folders  []*pb.Folder
+	revealed bool
+	form     *tuiForm
+	// copied - значение, скопированное в буфер обмена и еще не удаленное из него.
+	copied string
+
+	busy      string
+	quitAfter bool
+	status    string
+	offline   bool
+	revision  int64
+	pending   int
+	lastSync  time.Time
+}
+
+// runTUI - команда tui: полноэкранный интерфейс для просмотра и изменения данных.
+func (c *Cli) runTUI(opts globalOptions, args []string) error {
+	positional, err := parseFlags(c.newFlagSet("tui"), args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return usageError("лишние аргументы: %v", positional)
+	}
+
+	if err := c.authenticate(opts); err != nil {
+		return err
+	}
+	synced := false
+	if !c.offline {
+		if err := c.synchronize(); err != nil {
+			fmt.Fprintln(c.messages, "- Не удалось синхронизировать данные:", err)
+		} else {
+			synced = true
+		}
+	}
+
+	// сообщения о ходе работы выводятся в строке состояния, журнал отключается, чтобы не портить экран
+	messages := &statusLine{}
+	c.messages = messages
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	model := newTUIModel(c, messages)
+	if synced {
+		model.lastSync = time.Now()
+	}
+	options := []tea.ProgramOption{tea.WithOutput(c.stdout)}
+	if c.isTerminal() {
+		options = append(options, tea.WithAltScreen())
+	} else {
+		options = append(options, tea.WithInput(c.stdin))
+	}
+	_, err = tea.NewProgram(model, options...).Run()
+	// после выхода таймер удаления из буфера обмена уже не сработает
+	model.clearClipboard()
+	c.saveLocal()
+	return err
+}
+
+// newTUIModel - возвращает состояние интерфейса со списком всех данных из локальной копии.
+func newTUIModel(c *Cli, messages *statusLine) *tuiModel {
+	search := textinput.New()
+	search.Prompt = "/ "
+	search.Placeholder = "поиск"
+	m := &tuiModel{
+		cli:      c,
+		messages: messages,
+		width:    defaultTUIWidth,
+		height:   defaultTUIHeight,
+		search:   search,
+	}
+	m.refresh(0)
+	return m
+}
+
+func (m *tuiModel) Init() tea.Cmd {
+	return tea.Batch(syncTick(), codeTick())
+}
+
+// codeTick - планирует обновление одноразового кода в начале следующей секунды.
+func codeTick() tea.Cmd {
+	return tea.Every(time.Second, func(time.Time) tea.Msg { return codeTickMsg{} })
+}
+
+// syncTick - планирует следующую фоновую синхронизацию.
+func syncTick() tea.Cmd {
+	return tea.Tick(tuiSyncInterval, func(time.Time) tea.Msg { return syncTickMsg{} })
+}
+
+func (m *tuiModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		if m.form != nil {
+			m.form.resize(m.detailWidth())
+		}
+		return m, nil
+
+	case syncTickMsg:
+		if m.busy != "" {
+			return m, syncTick()
+		}
+		return m, tea.Batch(m.syncCmd(), syncTick())
+
+	case codeTickMsg:
+		// экран перерисовывается после каждого сообщения, поэтому достаточно запланировать следующее
+		return m, codeTick()
+
+	case opDoneMsg:
+		return m.finish(msg)
+
+	case clipboardClearMsg:
+		// значение, скопированное позже, удаляется по своему таймеру
+		if msg.value == m.copied {
+			m.clearClipboard()
+		}
+		return m, nil
+
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			return m.quit()
+		}
+		switch m.mode {
+		case modeSearch:
+			return m.updateSearch(msg)
+		case modeKind:
+			return m.updateKind(msg)
+		case modeDelete:
+			return m.updateDelete(msg)
+		case modeForm:
+			return m.updateForm(msg)
+		}
+		return m.updateList(msg)
+	}
+	return m, nil
+}
+
+// updateList - клавиши в списке данных.
+func (m *tuiModel) updateList(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q":
+		return m.quit()
+	case "up", "k":
+		m.move(-1)
+	case "down", "j":
+		m.move(1)
+	case "pgup":
+		m.move(-m.listRows())
+	case "pgdown":
+		m.move(m.listRows())
+	case "home", "g":
+		m.move(-len(m.items))
+	case "end", "G":
+		m.move(len(m.items))
+	case "/":
+		m.mode = modeSearch
+		return m, m.search.Focus()
+	case "esc":
+		if m.search.Value() != "" && m.idle() {
+			m.search.SetValue("")
+			m.refresh(0)
+		}
+	case "r":
+		m.revealed = !m.revealed
+	case "c":
+		return m, m.copyValue()
+	case "s":
+		if m.idle() {
+			return m, m.syncCmd()
+		}
+	case "a":
+		if m.idle() {
+			m.mode = modeKind
+		}
+	case "e":
+		if m.idle() && m.detail != nil {
+			m.form = newTUIForm(m.detail, m.detailWidth())
+			m.mode = modeForm
+			return m, m.form.focusInput()
+		}
+	case "d":
+		if m.idle() && m.detail != nil {
+			m.mode = modeDelete
+		}
+	}
+	return m, nil
+}
+
+// updateSearch - ввод поискового запроса. Список обновляется при каждом изменении запроса.
+func (m *tuiModel) updateSearch(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter", "down", "tab":
+		m.mode = modeList
+		m.search.Blur()
+		return m, nil
+	case "esc":
+		m.mode = modeList
+		m.search.Blur()
+		m.search.SetValue("")
+		if m.busy == "" {
+			m.refresh(0)
+		}
+		return m, nil
+	}
+
+	query := m.search.Value()
+	var cmd tea.Cmd
+	m.search, cmd = m.search.Update(msg)
+	if m.search.Value() != query && m.busy == "" {
+		m.refresh(0)
+	}
+	return m, cmd
+}
+
+// updateKind - выбор вида новых данных.
+func (m *tuiModel) updateKind(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	m.mode = modeList
+	for i, kind := range kinds.All {
+		if msg.String() == fmt.Sprint(i+1) {
+			cell := &pb.MemoryCell{Info: &pb.InfoCell{DataType: kind}}
+			m.form = newTUIForm(cell, m.detailWidth())
+			m.mode = modeForm
+			return m, m.form.focusInput()
+		}
+	}
+	return m, nil
+}
+
+// updateDelete - подтверждение удаления выбранных данных.
+func (m *tuiModel) updateDelete(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	m.mode = modeList
+	if msg.String() != "y" || !m.idle() || m.detail == nil {
+		m.status = "Удаление отменено."
+		return m, nil
+	}
+
+	c, infoID := m.cli, m.detail.Info.Id
+	return m, m.run("удаление", func() (string, int64, error) {
+		if err := c.deleteCells([]int64{infoID}); err != nil {
+			return "", 0, err
+		}
+		return "Данные перемещены в корзину.", 0, nil
+	})
+}
+
+// updateForm - ввод данных в форме добавления или изменения.
+func (m *tuiModel) updateForm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.form = nil
+		m.mode = modeList
+		return m, nil
+	case "ctrl+s":
+		if !m.idle() {
+			return m, nil
+		}
+		return m.save()
+	}
+	return m, m.form.update(msg)
+}
+
+// save - проверяет форму и сохраняет данные в фоне.
+func (m *tuiModel) save() (tea.Model, tea.Cmd) {
+	form := m.form
+	largeFile, err := form.apply()
+	if err != nil {
+		form.err = err.Error()
+		return m, nil
+	}
+	m.form = nil
+	m.mode = modeList
+
+	c, cell := m.cli, form.cell
+	if form.base == nil {
+		return m, m.run("сохранение", func() (string, int64, error) {
+			infoID, err := c.sendNewCell(cell)
+			if err != nil {
+				return "", 0, err
+			}
+			if largeFile != "" {
+				c.uploadFile(infoID, largeFile)
+			}
+			return fmt.Sprintf("Данные добавлены, InfoID %d.", infoID), infoID, nil
+		})
+	}
+
+	base := form.base
+	return m, m.run("сохранение", func() (string, int64, error) {
+		err := c.updateCell(cell, base)
+		if errors.Is(err, errConflict) {
+			// конфликт разрешается в меню; здесь загружается версия с сервера, и изменение нужно повторить
+			if err := c.synchronize(); err != nil {
+				return "", 0, err
+			}
+			return "", cell.Info.Id, fmt.Errorf("%w, изменения не сохранены: загружена новая версия, повторите изменение", errConflict)
+		}
+		if err != nil {
+			return "", 0, err
+		}
+		if largeFile != "" {
+			c.uploadFile(cell.Info.Id, largeFile)
+		}
+		return "Данные изменены.", cell.Info.Id, nil
+	})
+}
+
+// syncCmd - запускает синхронизацию в фоне.
+func (m *tuiModel) syncCmd() tea.Cmd {
+	c := m.cli
+	return m.run("синхронизация", func() (string, int64, error) {
+		c.refreshSession()
+		return "", 0, c.synchronize()
+	})
+}
+
+// run - выполняет операцию с Cli в фоне. Результат приходит сообщением opDoneMsg.
+func (m *tuiModel) run(op string, fn func() (string, int64, error)) tea.Cmd {
+	m.busy = op
+	m.status = ""
+	return func() tea.Msg {
+		status, selectID, err := fn()
+		return opDoneMsg{op: op, status: status, selectID: selectID, err: err}
+	}
+}
+
+// finish - обновляет интерфейс после завершения фоновой операции.
+func (m *tuiModel) finish(msg opDoneMsg) (tea.Model, tea.Cmd) {
+	m.busy = ""
+	m.status = msg.status
+	if message := m.messages.take(); message != "" && msg.status == "" {
+		m.status = message
+	}
+	switch {
+	case msg.err != nil && isUnavailable(msg.err):
+		m.status = "Сервер недоступен, изменения сохранены локально и будут отправлены при синхронизации."
+	case msg.err != nil:
+		m.status = "Ошибка: " + msg.err.Error()
+	case msg.op == "синхронизация":
+		m.lastSync = time.Now()
+	}
+
+	m.refresh(msg.selectID)
+	if m.quitAfter {
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+// quit - завершает работу интерфейса. Если операция еще выполняется, выход откладывается до ее завершения.
+func (m *tuiModel) quit() (tea.Model, tea.Cmd) {
+	if m.busy != "" {
+		m.quitAfter = true
+		return m, nil
+	}
+	return m, tea.Quit
+}
+
+// idle - сообщает, что фоновых операций нет. Иначе сообщает пользователю, что нужно подождать.
+func (m *tuiModel) idle() bool {
+	if m.busy != "" {
+		m.status = "Дождитесь завершения операции: " + m.busy + "."
+		return false
+	}
+	return true
+}
+
+// refresh - загружает список данных из локальной копии с учетом поискового запроса и выбирает данные selectID
+// или, если он 0, оставляет выбранными текущие данные.
+func (m *tuiModel) refresh(selectID int64) {
+	c := m.cli
+	m.offline = c.offline
+	m.revision = c.storage.Revision()
+	m.pending = 0
+	if c.journal != nil {
+		m.pending = c.journal.Len()
+	}
+	m.folders = c.storage.Folders()
+
+	if selectID == 0 && m.detail != nil {
+		selectID = m.detail.Info.Id
+	}
+	m.items = nil
+	if query := strings.TrimSpace(m.search.Value()); query != "" {
+		for _, result := range c.storage.Search(query, 0) {
+			if cell, err := c.storage.GetDataByID(result.ID); err == nil {
+				m.items = append(m.items, cell.Info)
+			}
+		}
+	} else {
+		m.items = c.storage.GetInformation()
+		// новые данные, еще не отправленные на сервер, имеют отрицательные InfoID и выводятся в конце
+		sort.Slice(m.items, func(i, j int) bool {
+			a, b := m.items[i].Id, m.items[j].Id
+			if (a < 0) != (b < 0) {
+				return a > 0
+			}
+			if a < 0 {
+				return a > b
+			}
+			return a < b
+		})
+	}
+
+	m.cursor = 0
+	for i, info := range m.items {
+		if info.Id == selectID {
+			m.cursor = i
+		}
+	}
+	m.loadDetail()
+}
+
+// move - перемещает курсор списка и загружает выбранные данные.
+func (m *tuiModel) move(delta int) {
+	m.cursor += delta
+	if m.cursor >= len(m.items) {
+		m.cursor = len(m.items) - 1
+	}
+	if m.cursor < 0 {
+		m.cursor = 0
+	}
+	if m.busy == "" {
+		m.loadDetail()
+	}
+}
+
+// loadDetail - загружает из локальной копии данные, выбранные в списке. Значения скрываются заново при выборе других данных.
+func (m *tuiModel) loadDetail() {
+	var previous int64
+	if m.detail != nil {
+		previous = m.detail.Info.Id
+	}
+	m.detail = nil
+	if len(m.items) > 0 {
+		if cell, err := m.cli.storage.GetDataByID(m.items[m.cursor].Id); err == nil {
+			m.detail = proto.Clone(cell).(*pb.MemoryCell)
+		}
+	}
+	if m.detail == nil || m.detail.Info.Id != previous {
+		m.revealed = false
+	}
+}
+
+// copyValue - копирует в буфер обмена основное поле выбранных данных и планирует его удаление из буфера.
+func (m *tuiModel) copyValue() tea.Cmd {
+	if m.detail == nil {
+		return nil
+	}
+	label, value := "Описание", m.detail.Info.Description
+	for _, field := range payloadFields(m.detail) {
+		if field.name == primaryFields[m.detail.Info.DataType] && *field.value != "" {
+			label, value = field.label, *field.value
+		}
+	}
+	// для кодов TOTP копируется текущий код, код HOTP выдается командой otp, так как он увеличивает счетчик
+	if key, ok := totpKey(m.detail); ok {
+		if code, err := otp.Code(key, time.Now()); err == nil {
+			label, value = "Одноразовый код", code
+		}
+	}
+
+	if err := clipboard.WriteAll(value); err != nil {
+		m.status = "Не удалось скопировать в буфер обмена: " + err.Error()
+		return nil
+	}
+	m.copied = value
+	m.status = fmt.Sprintf("%s скопировано в буфер обмена и будет удалено из него через %s или при выходе.", label, clipboardClearAfter)
+	return tea.Tick(clipboardClearAfter, func(time.Time) tea.Msg { return clipboardClearMsg{value: value} })
+}
+
+// clearClipboard - удаляет из буфера обмена скопированное значение, если его не заменили другим.
+func (m *tuiModel) clearClipboard() {
+	if m.copied == "" {
+		return
+	}
+	if current, err := clipboard.ReadAll(); err == nil && current == m.copied {
+		clipboard.WriteAll("")
+	}
+	m.copied = ""
+}
+
+// maskedValue - возвращает значение поля для вывода: секретные поля и номер карты скрываются, пока их не раскрыть.
+func maskedValue(field payloadField, revealed bool) string {
+	if revealed || *field.value == "" {
+		return displayValue(field)
+	}
+	if field.secret {
+		return "••••••••"
+	}
+	if field.name == "number" && len(*field.value) > 4 {
+		return "•••• " + (*field.value)[len(*field.value)-4:]
+	}
+	return displayValue(field)
+}
+
+// Стили полноэкранного интерфейса.
+var (
+	headerStyle   = lipgloss.NewStyle().Bold(true)
+	paneStyle     = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(0, 1)
+	selectedStyle = lipgloss.NewStyle().Reverse(true)
+	labelStyle    = lipgloss.NewStyle().Faint(true)
+	errorStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+)
+
+func (m *tuiModel) View() string {
+	left := paneStyle.Width(m.listWidth()).Height(m.paneHeight()).Render(m.listView())
+	var right string
+	if m.mode == modeForm {
+		right = m.form.view()
+	} else {
+		right = m.detailView()
+	}
+	right = paneStyle.Width(m.detailWidth()).Height(m.paneHeight()).Render(right)
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		m.headerView(),
+		lipgloss.JoinHorizontal(lipgloss.Top, left, right),
+		m.status,
+		labelStyle.Render(m.helpView()),
+	)
+}
+
+// headerView - строка с пользователем и состоянием синхронизации.
+func (m *tuiModel) headerView() string {
+	state := "● онлайн"
+	if m.offline {
+		state = "○ автономный режим"
+	}
+	parts := []string{
+		headerStyle.Render("GophKeeper · " + m.cli.username),
+		state,
+		fmt.Sprintf("ревизия %d", m.revision),
+		fmt.Sprintf("ожидают отправки: %d", m.pending),
+	}
+	if !m.lastSync.IsZero() {
+		parts = append(parts, "синхронизировано "+m.lastSync.Format("15:04:05"))
+	}
+	if m.busy != "" {
+		parts = append(parts, "⟳ "+m.busy+"…")
+	}
+	return strings.Join(parts, "  ·  ")
+}
+
+// listView - поиск и список данных.
+func (m *tuiModel) listView() string {
+	lines := []string{m.search.View(), ""}
+	rows := m.listRows()
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	}
+	if m.cursor >= m.offset+rows {
+		m.offset = m.cursor - rows + 1
+	}
+	if len(m.items) == 0 {
+		lines = append(lines, labelStyle.Render("нет данных"))
+	}
+	for i := m.offset; i < len(m.items) && i < m.offset+rows; i++ {
+		info := m.items[i]
+		line := truncate(fmt.Sprintf("%-5d %-6s %s", info.Id, info.DataType, info.Description), m.listWidth()-2)
+		if i == m.cursor {
+			line = selectedStyle.Render(line)
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// detailView - выбранные данные в зависимости от их вида.
+func (m *tuiModel) detailView() string {
+	switch m.mode {
+	case modeKind:
+		variants := make([]string, 0, len(kinds.All))
+		for i, kind := range kinds.All {
+			variants = append(variants, fmt.Sprintf("%d - %s", i+1, kindTitle(kind)))
+		}
+		return "Вид новых данных:\n\n" + strings.Join(variants, "\n") + "\n\nEsc - отмена"
+	case modeDelete:
+		return fmt.Sprintf("Переместить данные %d «%s» в корзину? (y/n)", m.detail.Info.Id, m.detail.Info.Description)
+	}
+	if m.detail == nil {
+		return labelStyle.Render("Выберите данные в списке")
+	}
+
+	cell := m.detail
+	var b strings.Builder
+	line := func(label, value string) {
+		fmt.Fprintf(&b, "%s %s\n", labelStyle.Render(label+":"), value)
+	}
+	fmt.Fprintln(&b, headerStyle.Render(kindTitle(cell.Info.DataType)))
+	fmt.Fprintln(&b)
+	if cell.Info.Id < 0 {
+		line("InfoID", fmt.Sprintf("%d (не отправлено на сервер)", cell.Info.Id))
+	} else {
+		line("InfoID", fmt.Sprint(cell.Info.Id))
+	}
+	line("Описание", cell.Info.Description)
+	if cell.Info.FolderId != 0 {
+		line("Папка", folderPath(m.folders, cell.Info.FolderId))
+	}
+	if len(cell.Info.Tags) > 0 {
+		line("Метки", "#"+strings.Join(cell.Info.Tags, " #"))
+	}
+	if cell.Info.CreatedAt != nil {
+		line("Создано", cell.Info.CreatedAt.AsTime().Local().Format("2006-01-02 15:04"))
+	}
+	if cell.Info.UpdatedAt != nil {
+		line("Изменено", cell.Info.UpdatedAt.AsTime().Local().Format("2006-01-02 15:04"))
+	}
+
+	for _, field := range payloadFields(cell) {
+		if *field.value == "" {
+			continue
+		}
+		if field.multiline {
+			fmt.Fprintln(&b, labelStyle.Render(field.label+":"))
+			fmt.Fprintln(&b, *field.value)
+			continue
+		}
+		line(field.label, maskedValue(field, m.revealed))
+	}
+	if key, ok := totpKey(cell); ok {
+		now := time.Now()
+		if code, err := otp.Code(key, now); err == nil {
+			line("Код", headerStyle.Render(formatCode(code))+"  "+countdown(key, now))
+		}
+	}
+
+	if len(cell.KeyValuePairs) > 0 {
+		fmt.Fprintln(&b)
+		fmt.Fprintln(&b, labelStyle.Render("Дополнительные поля:"))
+		keys := make([]string, 0, len(cell.KeyValuePairs))
+		for key := range cell.KeyValuePairs {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Fprintf(&b, "  %s: %s\n", key, cell.KeyValuePairs[key])
+		}
+	}
+	if cell.FileName != "" {
+		size := int64(len(cell.BinaryData))
+		if cell.Blob {
+			size = int64(cell.Info.DataSize)
+		}
+		line("Файл", fmt.Sprintf("%s, %d байт", cell.FileName, size))
+	}
+	return b.String()
+}
+
+// helpView - подсказка по клавишам текущего режима.
+func (m *tuiModel) helpView() string {
+	switch m.mode {
+	case modeSearch:
+		return "Enter - к списку  Esc - сбросить поиск"
+	case modeForm:
+		return "Tab/Shift+Tab - поле  Ctrl+G - сгенерировать пароль  Ctrl+S - сохранить  Esc - отмена"
+	case modeKind, modeDelete:
+		return ""
+	}
+	return "↑/↓ - выбор  / - поиск  a - добавить  e - изменить  d - удалить  c - копировать  r - показать/скрыть  s - синхронизация  q - выход"
+}
+
+// Размеры панелей: список занимает треть экрана, рамка добавляет по два символа.
+func (m *tuiModel) listWidth() int {
+	width := m.width / 3
+	if width < 30 {
+		width = 30
+	}
+	return width
+}
+
+func (m *tuiModel) detailWidth() int {
+	width := m.width - m.listWidth() - 4
+	if width < 30 {
+		width = 30
+	}
+	return width
+}
+
+func (m *tuiModel) paneHeight() int {
+	height := m.height - 5
+	if height < 5 {
+		height = 5
+	}
+	return height
+}
+
+// listRows - сколько данных помещается в список под строкой поиска.
+func (m *tuiModel) listRows() int {
+	return m.paneHeight() - 2
+}
+
+// truncate - обрезает строку до ширины width символов.
+func truncate(s string, width int) string {
+	runes := []rune(s)
+	if width <= 0 || len(runes) <= width {
+		return s
+	}
+	return string(runes[:width-1]) + "…"
+}
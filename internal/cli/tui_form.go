@@ -0,0 +1,229 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bubu256/gophkeeper_pet/internal/kinds"
+	"github.com/bubu256/gophkeeper_pet/internal/proto/pb"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/client/passgen"
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"google.golang.org/protobuf/proto"
+)
+
+// tuiForm - форма добавления или изменения данных в полноэкранном интерфейсе.
+// Изменяется копия данных, поэтому отмена формы не затрагивает локальную копию.
+type tuiForm struct {
+	cell *pb.MemoryCell
+	// base - данные до изменения, nil для новых данных.
+	base   *pb.MemoryCell
+	inputs []*formInput
+	focus  int
+	err    string
+	// notice - сообщение о сгенерированном пароле.
+	notice string
+}
+
+// formInput - поле формы: описание, путь к файлу или поле содержимого данных.
+type formInput struct {
+	label string
+	// field - поле содержимого данных, nil для описания и пути к файлу.
+	field     *payloadField
+	path      bool
+	multiline bool
+	text      textinput.Model
+	area      textarea.Model
+}
+
+// newTUIForm - возвращает форму для данных cell. Данные с InfoID 0 считаются новыми.
+// Дополнительные поля (ключ-значение), папка и метки в форме не изменяются.
+func newTUIForm(cell *pb.MemoryCell, width int) *tuiForm {
+	form := &tuiForm{cell: proto.Clone(cell).(*pb.MemoryCell)}
+	if cell.Info.Id != 0 {
+		form.base = cell
+	}
+	ensurePayload(form.cell)
+
+	form.inputs = append(form.inputs, newFormInput("Описание", form.cell.Info.Description, false))
+	if form.cell.Info.DataType == kinds.File {
+		input := newFormInput("Путь к файлу", "", false)
+		input.path = true
+		if form.cell.FileName != "" {
+			input.text.Placeholder = form.cell.FileName + " (оставить)"
+		}
+		form.inputs = append(form.inputs, input)
+	} else {
+		for _, field := range payloadFields(form.cell) {
+			field := field
+			var input *formInput
+			if field.multiline {
+				input = &formInput{label: field.label, multiline: true, area: textarea.New()}
+				input.area.ShowLineNumbers = false
+				input.area.SetValue(*field.value)
+			} else {
+				input = newFormInput(field.label, displayValue(field), field.secret)
+			}
+			input.field = &field
+			form.inputs = append(form.inputs, input)
+		}
+	}
+	form.resize(width)
+	return form
+}
+
+// newFormInput - возвращает однострочное поле формы. Значение секретного поля скрывается при вводе.
+func newFormInput(label, value string, secret bool) *formInput {
+	text := textinput.New()
+	text.Prompt = "> "
+	text.SetValue(value)
+	if secret {
+		text.EchoMode = textinput.EchoPassword
+		text.EchoCharacter = '•'
+	}
+	return &formInput{label: label, text: text}
+}
+
+// value - возвращает введенное значение.
+func (in *formInput) value() string {
+	if in.multiline {
+		return strings.TrimRight(in.area.Value(), "\n ")
+	}
+	return strings.TrimSpace(in.text.Value())
+}
+
+// resize - подгоняет поля формы под ширину панели.
+func (f *tuiForm) resize(width int) {
+	for _, input := range f.inputs {
+		if input.multiline {
+			input.area.SetWidth(width - 2)
+			input.area.SetHeight(5)
+		} else {
+			input.text.Width = width - 4
+		}
+	}
+}
+
+// update - обрабатывает клавиши в форме: Tab и Enter переходят к следующему полю, остальные клавиши изменяют поле.
+// В многострочном поле Enter и стрелки работают внутри поля.
+func (f *tuiForm) update(msg tea.KeyMsg) tea.Cmd {
+	input := f.inputs[f.focus]
+	switch msg.String() {
+	case "tab":
+		return f.moveFocus(1)
+	case "shift+tab":
+		return f.moveFocus(-1)
+	case "enter", "down":
+		if !input.multiline {
+			return f.moveFocus(1)
+		}
+	case "up":
+		if !input.multiline {
+			return f.moveFocus(-1)
+		}
+	case "ctrl+g":
+		if input.field != nil && input.field.generate {
+			result, err := generatePassword(passgen.DefaultOptions())
+			if err != nil {
+				f.err = err.Error()
+				return nil
+			}
+			input.text.SetValue(result.value)
+			f.err = ""
+			f.notice = fmt.Sprintf("%s сгенерирован: %s.", input.label, result)
+		}
+		return nil
+	}
+
+	var cmd tea.Cmd
+	if input.multiline {
+		input.area, cmd = input.area.Update(msg)
+	} else {
+		input.text, cmd = input.text.Update(msg)
+	}
+	return cmd
+}
+
+// moveFocus - переходит к следующему или предыдущему полю формы.
+func (f *tuiForm) moveFocus(delta int) tea.Cmd {
+	input := f.inputs[f.focus]
+	input.text.Blur()
+	input.area.Blur()
+	f.focus = (f.focus + delta + len(f.inputs)) % len(f.inputs)
+	return f.focusInput()
+}
+
+// focusInput - передает ввод текущему полю формы.
+func (f *tuiForm) focusInput() tea.Cmd {
+	input := f.inputs[f.focus]
+	if input.multiline {
+		return input.area.Focus()
+	}
+	return input.text.Focus()
+}
+
+// apply - проверяет введенные значения и переносит их в данные формы.
+// Возвращает путь к большому файлу, который нужно загрузить потоком после сохранения данных.
+func (f *tuiForm) apply() (string, error) {
+	f.cell.Info.Description = f.inputs[0].value()
+	largeFile := ""
+	for _, input := range f.inputs[1:] {
+		value := input.value()
+		if !input.path {
+			if err := setField(*input.field, value); err != nil {
+				return "", fmt.Errorf("%s: %s", input.label, err)
+			}
+			continue
+		}
+
+		if value == "" {
+			if f.cell.FileName == "" {
+				return "", errors.New("Путь к файлу: поле обязательно для заполнения")
+			}
+			continue
+		}
+		var err error
+		largeFile, err = attachFile(f.cell, value)
+		if err != nil {
+			return "", err
+		}
+		f.cell.GetFile().MediaType = mediaType(f.cell.FileName)
+	}
+	if err := normalizeOTP(f.cell); err != nil {
+		return "", err
+	}
+	return largeFile, nil
+}
+
+// view - форма с заголовком, полями и ошибкой проверки.
+func (f *tuiForm) view() string {
+	var b strings.Builder
+	if f.base == nil {
+		fmt.Fprintln(&b, headerStyle.Render("Новые данные: "+kindTitle(f.cell.Info.DataType)))
+	} else {
+		fmt.Fprintln(&b, headerStyle.Render(fmt.Sprintf("Изменение данных %d: %s", f.cell.Info.Id, kindTitle(f.cell.Info.DataType))))
+	}
+	for i, input := range f.inputs {
+		fmt.Fprintln(&b)
+		label := labelStyle.Render(input.label + ":")
+		if i == f.focus {
+			label = headerStyle.Render(input.label + ":")
+		}
+		fmt.Fprintln(&b, label)
+		if input.multiline {
+			fmt.Fprintln(&b, input.area.View())
+		} else {
+			fmt.Fprintln(&b, input.text.View())
+		}
+	}
+	if f.err != "" {
+		fmt.Fprintln(&b)
+		fmt.Fprint(&b, errorStyle.Render(f.err))
+	} else if f.notice != "" {
+		fmt.Fprintln(&b)
+		fmt.Fprint(&b, f.notice)
+	}
+	return b.String()
+}
@@ -0,0 +1,77 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/bubu256/gophkeeper_pet/internal/cli"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/client/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// keyReader - ввод, отдающий при каждом чтении одну строку: полноэкранный интерфейс воспринимает
+// каждое чтение как одно нажатие клавиши.
+type keyReader struct {
+	keys []string
+}
+
+func (r *keyReader) Read(p []byte) (int, error) {
+	if len(r.keys) == 0 {
+		return 0, io.EOF
+	}
+	n := copy(p, r.keys[0])
+	r.keys = r.keys[1:]
+	return n, nil
+}
+
+// runTUI - выполняет команду tui с нажатиями клавиш keys и возвращает код завершения и вывод на экран.
+func runTUI(server *fakeServer, keys ...string) (int, string) {
+	c := cli.NewCli(server, context.Background(), storage.NewStorage())
+	var stdout, stderr bytes.Buffer
+	input := &keyReader{keys: append([]string{testPassword + "\n"}, keys...)}
+	code := c.Run([]string{"--user", testUser, "--password-stdin", "tui"}, input, &stdout, &stderr)
+	return code, stdout.String()
+}
+
+func TestRun_TUI(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	server := newFakeServer()
+	login := []string{"--user", testUser, "--password-stdin"}
+
+	code, _, stderr := runCommand(server, testPassword+"\nsite password\n", append(login, "add", "login", "--description", "Почта", "--url", "mail.example.com", "--secret-stdin")...)
+	require.Equal(t, cli.ExitOK, code, stderr)
+
+	// пароль скрыт, пока его не раскрыть
+	code, screen := runTUI(server, "q")
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, screen, "Почта")
+	assert.Contains(t, screen, "https://mail.example.com")
+	assert.Contains(t, screen, "••••••••")
+	assert.NotContains(t, screen, "site password")
+
+	code, screen = runTUI(server, "r", "q")
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, screen, "site password")
+
+	// добавление заметки: выбор вида, описание, Tab, текст, Ctrl+S
+	code, _ = runTUI(server, "a", "3", "Список", "\t", "молоко", "\x13", "q")
+	require.Equal(t, cli.ExitOK, code)
+	code, stdout, _ := runCommand(server, testPassword+"\n", append(login, "list")...)
+	assert.Equal(t, cli.ExitOK, code)
+	assert.Equal(t, "1\tlogin\tПочта\n2\tnote\tСписок\n", stdout)
+	code, stdout, _ = runCommand(server, testPassword+"\n", append(login, "get", "2", "--field", "text")...)
+	assert.Equal(t, cli.ExitOK, code)
+	assert.Equal(t, "молоко\n", stdout)
+
+	// поиск и удаление найденных данных с подтверждением
+	code, _ = runTUI(server, "/", "спис", "\r", "d", "n", "q")
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, server.cells, int64(2))
+	code, _ = runTUI(server, "/", "спис", "\r", "d", "y", "q")
+	require.Equal(t, cli.ExitOK, code)
+	assert.NotContains(t, server.cells, int64(2))
+	assert.Contains(t, server.cells, int64(1))
+}
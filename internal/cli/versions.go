@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+
+	"github.com/bubu256/gophkeeper_pet/internal/proto/pb"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/client/kvpairs"
+)
+
+// Versions - показывает предыдущие версии данных, отличия выбранной версии от текущей
+// и восстанавливает выбранную версию.
+func (c *Cli) Versions() {
+	if c.offline {
+		fmt.Println("- История версий доступна только при подключении к серверу.")
+		return
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	fmt.Print("Введите InfoID данных: ")
+	infoID, err := strconv.ParseInt(readLine(reader), 10, 64)
+	if err != nil {
+		fmt.Println("Ошибка при чтении InfoID:", err)
+		return
+	}
+
+	response, err := c.client.ListVersions(c.ctx, &pb.ListVersionsRequest{InfoId: infoID})
+	if err != nil {
+		c.goOffline(err)
+		fmt.Println("Ошибка при получении истории версий:", err)
+		return
+	}
+	if len(response.Versions) == 0 {
+		fmt.Println("- У данных нет предыдущих версий.")
+		return
+	}
+	current, err := c.getCell(infoID)
+	if err != nil || current == nil {
+		fmt.Println("Ошибка при получении данных:", err)
+		return
+	}
+
+	versions := make(map[int64]*pb.MemoryCell, len(response.Versions))
+	fmt.Println("\tПредыдущие версии:")
+	for _, version := range response.Versions {
+		cells, err := c.decryptCells([]*pb.MemoryCell{version.Data})
+		if err != nil {
+			fmt.Println("Ошибка при расшифровке данных:", err)
+			return
+		}
+		versions[version.Revision] = cells[0]
+		fmt.Printf("\tРевизия %d, заменена %s: %s\n",
+			version.Revision, version.ReplacedAt.AsTime().Local().Format("02.01.2006 15:04:05"), cells[0].Info.Description)
+	}
+
+	for {
+		fmt.Print("Введите ревизию для сравнения с текущей версией (пустая строка - выход): ")
+		input := readLine(reader)
+		if input == "" {
+			return
+		}
+		revision, err := strconv.ParseInt(input, 10, 64)
+		version, ok := versions[revision]
+		if err != nil || !ok {
+			fmt.Println("- Версия не найдена.")
+			continue
+		}
+
+		fmt.Printf("\tИзменения при восстановлении ревизии %d:\n", revision)
+		if !printCellDiff(current, version) {
+			fmt.Println("\tВерсия совпадает с текущей.")
+			continue
+		}
+
+		fmt.Print("Восстановить эту версию? (y/n): ")
+		if answer := readLine(reader); answer != "y" && answer != "yes" {
+			continue
+		}
+		if err := c.restoreVersion(infoID, revision); err != nil {
+			fmt.Println("- Ошибка при восстановлении версии:", err)
+			return
+		}
+		fmt.Println("- Версия восстановлена.")
+		return
+	}
+}
+
+// restoreVersion - восстанавливает версию данных на сервере и обновляет локальную копию.
+func (c *Cli) restoreVersion(infoID, revision int64) error {
+	if _, err := c.client.RestoreVersion(c.ctx, &pb.RestoreVersionRequest{InfoId: infoID, Revision: revision}); err != nil {
+		return err
+	}
+
+	cells, err := c.retrieveCells([]int64{infoID})
+	if err != nil {
+		return err
+	}
+	if len(cells) > 0 {
+		c.storage.Put(cells[0])
+		c.saveLocal()
+	}
+	return nil
+}
+
+// printCellDiff - выводит отличия версии to от версии from. Возвращает false, если отличий нет.
+func printCellDiff(from, to *pb.MemoryCell) bool {
+	changed := false
+	printChange := func(name, from, to string) {
+		if from != to {
+			fmt.Printf("\t~ %s: %s -> %s\n", name, from, to)
+			changed = true
+		}
+	}
+
+	printChange("Вид данных", kindTitle(from.Info.DataType), kindTitle(to.Info.DataType))
+	printChange("Описание", from.Info.Description, to.Info.Description)
+	if reflect.TypeOf(from.Payload) == reflect.TypeOf(to.Payload) {
+		toFields := payloadFields(to)
+		for i, field := range payloadFields(from) {
+			printChange(field.label, displayValue(field), displayValue(toFields[i]))
+		}
+	} else {
+		for _, field := range payloadFields(to) {
+			printChange(field.label, "", displayValue(field))
+		}
+	}
+	if !sameFile(from, to) {
+		printChange("Файл", from.FileName+" ", to.FileName+" ")
+	}
+
+	for _, change := range kvpairs.Diff(from.KeyValuePairs, to.KeyValuePairs) {
+		switch {
+		case !change.From.Present:
+			fmt.Printf("\t+ %s: %s\n", change.Key, change.To.Value)
+		case !change.To.Present:
+			fmt.Printf("\t- %s: %s\n", change.Key, change.From.Value)
+		default:
+			fmt.Printf("\t~ %s: %s -> %s\n", change.Key, change.From.Value, change.To.Value)
+		}
+		changed = true
+	}
+	return changed
+}
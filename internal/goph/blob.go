@@ -0,0 +1,175 @@
+package goph
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/bubu256/gophkeeper_pet/internal/schema"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper"
+)
+
+// MaxBlobChunkSize - максимальный размер одной части бинарных данных при потоковой загрузке.
+const MaxBlobChunkSize = 1 << 20
+
+// MaxBlobSize - максимальный размер бинарных данных: размер данных хранится в столбце data_size типа INT.
+const MaxBlobSize = math.MaxInt32
+
+var (
+	// ErrBlobNotFound возвращается, если для данных нет полностью загруженных бинарных данных.
+	ErrBlobNotFound = errors.New("blob not found")
+	// ErrBlobOffset возвращается, если загрузка продолжается не с того смещения, на котором остановилась.
+	ErrBlobOffset = errors.New("unexpected blob offset")
+	// ErrBlobChecksum возвращается, если контрольная сумма загруженных данных не совпала с заявленной.
+	ErrBlobChecksum = errors.New("blob checksum mismatch")
+	// ErrBlobTooLarge возвращается, если заявленный размер бинарных данных больше MaxBlobSize.
+	ErrBlobTooLarge = errors.New("blob is too large")
+)
+
+// BlobStatus возвращает состояние загрузки бинарных данных пользователя.
+// Если загрузка не начиналась, возвращается состояние с нулевым размером.
+func (g *GophLogic) BlobStatus(userID, infoID int64) (*schema.Blob, error) {
+	if err := g.checkOwnership(userID, infoID); err != nil {
+		return nil, err
+	}
+
+	blob, err := g.keeper.GetBlob(infoID)
+	if errors.Is(err, keeper.ErrNotFound) {
+		return &schema.Blob{InfoID: infoID}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve blob: %w", err)
+	}
+
+	return blob, nil
+}
+
+// StartBlobUpload начинает или продолжает загрузку бинарных данных.
+// Загрузка продолжается, если незавершенная загрузка с тем же размером и контрольной суммой
+// уже есть и offset совпадает с загруженным размером. Иначе загрузка начинается заново с нулевого смещения.
+func (g *GophLogic) StartBlobUpload(userID int64, header schema.Blob, offset int64) (*schema.Blob, error) {
+	if header.TotalSize <= 0 {
+		return nil, errors.New("blob size must be positive")
+	}
+	if header.TotalSize > MaxBlobSize {
+		return nil, fmt.Errorf("%w: %d bytes, maximum is %d", ErrBlobTooLarge, header.TotalSize, MaxBlobSize)
+	}
+	if sum, err := hex.DecodeString(header.SHA256); err != nil || len(sum) != sha256.Size {
+		return nil, errors.New("invalid blob checksum")
+	}
+
+	current, err := g.BlobStatus(userID, header.InfoID)
+	if err != nil {
+		return nil, err
+	}
+
+	resumable := !current.Complete && current.TotalSize == header.TotalSize && current.SHA256 == header.SHA256
+	if resumable {
+		if offset != current.Size {
+			return current, fmt.Errorf("%w: uploaded %d bytes", ErrBlobOffset, current.Size)
+		}
+		return current, nil
+	}
+
+	if offset != 0 {
+		return current, fmt.Errorf("%w: upload must start from 0", ErrBlobOffset)
+	}
+	blob := schema.Blob{
+		InfoID:    header.InfoID,
+		TotalSize: header.TotalSize,
+		SHA256:    header.SHA256,
+	}
+	if err := g.keeper.CreateBlob(blob); err != nil {
+		return nil, fmt.Errorf("failed to start blob upload: %w", err)
+	}
+
+	return &blob, nil
+}
+
+// WriteBlobChunk сохраняет очередную часть бинарных данных, начинающуюся с offset.
+func (g *GophLogic) WriteBlobChunk(infoID, offset int64, chunk []byte) error {
+	if len(chunk) == 0 || len(chunk) > MaxBlobChunkSize {
+		return fmt.Errorf("chunk size must be between 1 and %d bytes", MaxBlobChunkSize)
+	}
+
+	err := g.keeper.AppendBlobChunk(infoID, offset, chunk)
+	if errors.Is(err, keeper.ErrConflict) {
+		return ErrBlobOffset
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write blob chunk: %w", err)
+	}
+
+	return nil
+}
+
+// FinishBlobUpload завершает загрузку, если получены все данные.
+// Контрольная сумма пересчитывается по сохраненным данным; при несовпадении данные удаляются
+// и возвращается ErrBlobChecksum. Если данные получены не полностью, возвращается текущее состояние.
+func (g *GophLogic) FinishBlobUpload(infoID int64) (*schema.Blob, error) {
+	blob, err := g.keeper.GetBlob(infoID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve blob: %w", err)
+	}
+	if blob.Complete || blob.Size < blob.TotalSize {
+		return blob, nil
+	}
+
+	h := sha256.New()
+	err = g.keeper.ReadBlob(infoID, 0, func(_ int64, data []byte) error {
+		h.Write(data)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read blob: %w", err)
+	}
+
+	if hex.EncodeToString(h.Sum(nil)) != blob.SHA256 {
+		if err := g.keeper.DeleteBlob(infoID); err != nil {
+			return nil, fmt.Errorf("failed to delete corrupted blob: %w", err)
+		}
+		return nil, ErrBlobChecksum
+	}
+
+	if err := g.keeper.CompleteBlob(infoID); err != nil {
+		return nil, fmt.Errorf("failed to complete blob: %w", err)
+	}
+	blob.Complete = true
+
+	return blob, nil
+}
+
+// ReadBlob передает в fn полностью загруженные бинарные данные пользователя начиная с offset.
+// Перед чтением вызывается header с описанием данных.
+func (g *GophLogic) ReadBlob(userID, infoID, offset int64, header func(blob *schema.Blob) error, fn func(offset int64, chunk []byte) error) error {
+	blob, err := g.BlobStatus(userID, infoID)
+	if err != nil {
+		return err
+	}
+	if !blob.Complete {
+		return ErrBlobNotFound
+	}
+	if offset < 0 || offset > blob.TotalSize {
+		return fmt.Errorf("%w: offset is out of range", ErrBlobOffset)
+	}
+
+	if err := header(blob); err != nil {
+		return err
+	}
+
+	return g.keeper.ReadBlob(infoID, offset, fn)
+}
+
+// checkOwnership - проверяет, что данные с указанным InfoID принадлежат пользователю.
+func (g *GophLogic) checkOwnership(userID, infoID int64) error {
+	owned, err := g.filterOwnedIDs(userID, []int64{infoID})
+	if err != nil {
+		return err
+	}
+	if len(owned) == 0 {
+		return ErrAccessDenied
+	}
+	return nil
+}
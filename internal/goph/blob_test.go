@@ -0,0 +1,135 @@
+package goph_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/bubu256/gophkeeper_pet/config"
+	"github.com/bubu256/gophkeeper_pet/internal/goph"
+	"github.com/bubu256/gophkeeper_pet/internal/schema"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// blobKeeper - хранилище бинарных данных в памяти. Данные с InfoID 1 принадлежат пользователю 1.
+type blobKeeper struct {
+	keeper.Keeper
+	blob *schema.Blob
+	data []byte
+}
+
+func (b *blobKeeper) GetUserDataInfo(userID int64) ([]*schema.InfoCell, error) {
+	if userID != 1 {
+		return nil, nil
+	}
+	return []*schema.InfoCell{{ID: 1, OwnerID: 1}}, nil
+}
+
+func (b *blobKeeper) GetBlob(infoID int64) (*schema.Blob, error) {
+	if b.blob == nil {
+		return nil, keeper.ErrNotFound
+	}
+	blob := *b.blob
+	return &blob, nil
+}
+
+func (b *blobKeeper) CreateBlob(blob schema.Blob) error {
+	b.blob = &blob
+	b.data = nil
+	return nil
+}
+
+func (b *blobKeeper) AppendBlobChunk(infoID, offset int64, data []byte) error {
+	if offset != b.blob.Size {
+		return keeper.ErrConflict
+	}
+	b.data = append(b.data, data...)
+	b.blob.Size += int64(len(data))
+	return nil
+}
+
+func (b *blobKeeper) CompleteBlob(infoID int64) error {
+	b.blob.Complete = true
+	return nil
+}
+
+func (b *blobKeeper) DeleteBlob(infoID int64) error {
+	b.blob = nil
+	b.data = nil
+	return nil
+}
+
+func (b *blobKeeper) ReadBlob(infoID, offset int64, fn func(offset int64, data []byte) error) error {
+	return fn(offset, b.data[offset:])
+}
+
+func TestBlobUpload_Resume(t *testing.T) {
+	bk := &blobKeeper{}
+	gophLogic, err := goph.New(bk, config.ServerConfig{})
+	require.NoError(t, err)
+
+	data := bytes.Repeat([]byte("blob"), 100)
+	sum := sha256.Sum256(data)
+	header := schema.Blob{InfoID: 1, TotalSize: int64(len(data)), SHA256: hex.EncodeToString(sum[:])}
+
+	// чужие данные
+	_, err = gophLogic.StartBlobUpload(2, header, 0)
+	assert.ErrorIs(t, err, goph.ErrAccessDenied)
+
+	// размер, который не помещается в data_size
+	_, err = gophLogic.StartBlobUpload(1, schema.Blob{InfoID: 1, TotalSize: goph.MaxBlobSize + 1, SHA256: header.SHA256}, 0)
+	assert.ErrorIs(t, err, goph.ErrBlobTooLarge)
+
+	// первая часть загрузки
+	_, err = gophLogic.StartBlobUpload(1, header, 0)
+	require.NoError(t, err)
+	require.NoError(t, gophLogic.WriteBlobChunk(1, 0, data[:150]))
+	blob, err := gophLogic.FinishBlobUpload(1)
+	require.NoError(t, err)
+	assert.False(t, blob.Complete)
+
+	// продолжение с неверного смещения
+	_, err = gophLogic.StartBlobUpload(1, header, 100)
+	assert.ErrorIs(t, err, goph.ErrBlobOffset)
+
+	// продолжение загрузки
+	blob, err = gophLogic.StartBlobUpload(1, header, 150)
+	require.NoError(t, err)
+	assert.Equal(t, int64(150), blob.Size)
+	require.NoError(t, gophLogic.WriteBlobChunk(1, 150, data[150:]))
+	blob, err = gophLogic.FinishBlobUpload(1)
+	require.NoError(t, err)
+	assert.True(t, blob.Complete)
+
+	var downloaded []byte
+	err = gophLogic.ReadBlob(1, 1, 0, func(*schema.Blob) error { return nil }, func(_ int64, chunk []byte) error {
+		downloaded = append(downloaded, chunk...)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, data, downloaded)
+}
+
+func TestBlobUpload_ChecksumMismatch(t *testing.T) {
+	bk := &blobKeeper{}
+	gophLogic, err := goph.New(bk, config.ServerConfig{})
+	require.NoError(t, err)
+
+	data := []byte("blob data")
+	sum := sha256.Sum256([]byte("other data"))
+	header := schema.Blob{InfoID: 1, TotalSize: int64(len(data)), SHA256: hex.EncodeToString(sum[:])}
+
+	_, err = gophLogic.StartBlobUpload(1, header, 0)
+	require.NoError(t, err)
+	require.NoError(t, gophLogic.WriteBlobChunk(1, 0, data))
+	_, err = gophLogic.FinishBlobUpload(1)
+	assert.ErrorIs(t, err, goph.ErrBlobChecksum)
+
+	// поврежденные данные удалены
+	blob, err := gophLogic.BlobStatus(1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), blob.Size)
+}
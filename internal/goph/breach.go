@@ -0,0 +1,27 @@
+package goph
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/breach"
+)
+
+// ErrBreachUnavailable возвращается, если на сервере не настроена база утекших паролей.
+var ErrBreachUnavailable = errors.New("breach dataset is not configured")
+
+// CheckPasswordPrefix возвращает суффиксы хешей SHA-1 утекших паролей с префиксом prefix и количество утечек.
+// Пароль и его хеш целиком сервер не получает.
+func (g *GophLogic) CheckPasswordPrefix(prefix string) (map[string]int64, error) {
+	if g.breaches == nil {
+		return nil, ErrBreachUnavailable
+	}
+	suffixes, err := g.breaches.Range(prefix)
+	if err != nil {
+		if errors.Is(err, breach.ErrPrefix) {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidData, err)
+		}
+		return nil, fmt.Errorf("failed to read breach dataset: %w", err)
+	}
+	return suffixes, nil
+}
@@ -0,0 +1,36 @@
+package goph_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bubu256/gophkeeper_pet/config"
+	"github.com/bubu256/gophkeeper_pet/internal/goph"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/breach"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckPasswordPrefix(t *testing.T) {
+	dir := t.TempDir()
+	prefix, suffix := breach.Hash("password")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, prefix+".txt"), []byte(suffix+":9545824\n"), 0o600))
+
+	logic, err := goph.New(nil, config.ServerConfig{BreachData: dir})
+	require.NoError(t, err)
+	suffixes, err := logic.CheckPasswordPrefix(prefix)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{suffix: 9545824}, suffixes)
+
+	_, err = logic.CheckPasswordPrefix("5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8")
+	assert.ErrorIs(t, err, goph.ErrInvalidData)
+
+	// без базы метод недоступен, а несуществующая база - ошибка запуска
+	logic, err = goph.New(nil, config.ServerConfig{})
+	require.NoError(t, err)
+	_, err = logic.CheckPasswordPrefix(prefix)
+	assert.ErrorIs(t, err, goph.ErrBreachUnavailable)
+	_, err = goph.New(nil, config.ServerConfig{BreachData: filepath.Join(dir, "missing")})
+	assert.Error(t, err)
+}
@@ -0,0 +1,249 @@
+package goph
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/bubu256/gophkeeper_pet/internal/schema"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper"
+)
+
+// ErrFolderNotFound возвращается, если папки нет среди папок пользователя.
+var ErrFolderNotFound = errors.New("folder not found")
+
+// ErrFolderExists возвращается, если у родительской папки уже есть папка с таким именем.
+var ErrFolderExists = errors.New("folder already exists")
+
+// ErrFolderCycle возвращается при попытке переместить папку в саму себя или во вложенную в нее папку.
+var ErrFolderCycle = errors.New("folder cannot be moved into itself")
+
+// Ограничения имен папок и меток.
+const (
+	maxFolderNameLength = 255
+	maxTagLength        = 64
+)
+
+// ListFolders возвращает папки пользователя.
+func (g *GophLogic) ListFolders(userID int64) ([]*schema.Folder, error) {
+	folders, err := g.keeper.GetFolders(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve folders: %w", err)
+	}
+
+	return folders, nil
+}
+
+// CreateFolder создает папку пользователя в папке parentID (0 - на верхнем уровне) и возвращает ее ID.
+func (g *GophLogic) CreateFolder(userID int64, name string, parentID int64) (int64, error) {
+	name, err := normalizeFolderName(name)
+	if err != nil {
+		return 0, err
+	}
+	if parentID != 0 {
+		if _, err := g.userFolders(userID, parentID); err != nil {
+			return 0, err
+		}
+	}
+
+	folder := &schema.Folder{OwnerID: userID, ParentID: parentID, Name: name}
+	err = g.keeper.CreateFolder(folder)
+	if errors.Is(err, keeper.ErrConflict) {
+		return 0, ErrFolderExists
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to create folder: %w", err)
+	}
+
+	return folder.ID, nil
+}
+
+// RenameFolder изменяет имя папки пользователя.
+func (g *GophLogic) RenameFolder(userID, folderID int64, name string) error {
+	name, err := normalizeFolderName(name)
+	if err != nil {
+		return err
+	}
+	folders, err := g.userFolders(userID, folderID)
+	if err != nil {
+		return err
+	}
+
+	folder := *folders[folderID]
+	folder.Name = name
+	return g.updateFolder(folder)
+}
+
+// MoveFolder перемещает папку пользователя вместе с вложенными папками в папку parentID (0 - на верхний уровень).
+// Если parentID - сама папка или вложенная в нее папка, возвращает ErrFolderCycle.
+// Вложенность проверяется хранилищем вместе с перемещением, чтобы параллельные перемещения не образовали цикл.
+func (g *GophLogic) MoveFolder(userID, folderID, parentID int64) error {
+	err := g.keeper.MoveFolder(userID, folderID, parentID)
+	if errors.Is(err, keeper.ErrCycle) {
+		return ErrFolderCycle
+	}
+	if errors.Is(err, keeper.ErrConflict) {
+		return ErrFolderExists
+	}
+	if errors.Is(err, keeper.ErrNotFound) {
+		return ErrFolderNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to move folder: %w", err)
+	}
+
+	return nil
+}
+
+// MoveData перемещает данные пользователя в папку folderID, folderID 0 убирает данные из папок.
+func (g *GophLogic) MoveData(userID int64, infoIDs []int64, folderID int64) error {
+	if len(infoIDs) == 0 {
+		return errors.New("no data")
+	}
+	if folderID != 0 {
+		if _, err := g.userFolders(userID, folderID); err != nil {
+			return err
+		}
+	}
+	if err := g.checkOwnedIDs(userID, infoIDs); err != nil {
+		return err
+	}
+
+	err := g.keeper.MoveData(infoIDs, folderID)
+	if errors.Is(err, keeper.ErrNotFound) {
+		return ErrAccessDenied
+	}
+	if err != nil {
+		return fmt.Errorf("failed to move data: %w", err)
+	}
+
+	return nil
+}
+
+// TagData добавляет данным пользователя метки add и снимает метки remove.
+// Метки приводятся к нижнему регистру, символ # в начале метки отбрасывается.
+func (g *GophLogic) TagData(userID int64, infoIDs []int64, add, remove []string) error {
+	if len(infoIDs) == 0 {
+		return errors.New("no data")
+	}
+	add, err := normalizeTags(add)
+	if err != nil {
+		return err
+	}
+	remove, err = normalizeTags(remove)
+	if err != nil {
+		return err
+	}
+	if len(add) == 0 && len(remove) == 0 {
+		return fmt.Errorf("%w: no tags", ErrInvalidData)
+	}
+	if err := g.checkOwnedIDs(userID, infoIDs); err != nil {
+		return err
+	}
+
+	err = g.keeper.TagData(infoIDs, add, remove)
+	if errors.Is(err, keeper.ErrNotFound) {
+		return ErrAccessDenied
+	}
+	if err != nil {
+		return fmt.Errorf("failed to tag data: %w", err)
+	}
+
+	return nil
+}
+
+// ListTags возвращает метки пользователя с количеством отмеченных данных.
+func (g *GophLogic) ListTags(userID int64) ([]*schema.Tag, error) {
+	tags, err := g.keeper.GetTags(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+// userFolders возвращает папки пользователя по ID. Если среди них нет папки folderID, возвращает ErrFolderNotFound.
+func (g *GophLogic) userFolders(userID, folderID int64) (map[int64]*schema.Folder, error) {
+	list, err := g.keeper.GetFolders(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve folders: %w", err)
+	}
+
+	folders := make(map[int64]*schema.Folder, len(list))
+	for _, folder := range list {
+		folders[folder.ID] = folder
+	}
+	if _, ok := folders[folderID]; !ok {
+		return nil, ErrFolderNotFound
+	}
+
+	return folders, nil
+}
+
+// updateFolder сохраняет измененную папку.
+func (g *GophLogic) updateFolder(folder schema.Folder) error {
+	err := g.keeper.UpdateFolder(folder)
+	if errors.Is(err, keeper.ErrConflict) {
+		return ErrFolderExists
+	}
+	if errors.Is(err, keeper.ErrNotFound) {
+		return ErrFolderNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update folder: %w", err)
+	}
+
+	return nil
+}
+
+// checkOwnedIDs проверяет, что все данные принадлежат пользователю, иначе возвращает ErrAccessDenied.
+func (g *GophLogic) checkOwnedIDs(userID int64, infoIDs []int64) error {
+	owned, err := g.filterOwnedIDs(userID, infoIDs)
+	if err != nil {
+		return err
+	}
+	if len(owned) != len(infoIDs) {
+		return ErrAccessDenied
+	}
+	return nil
+}
+
+// normalizeFolderName проверяет имя папки и отбрасывает пробелы по краям.
+// Символ / в имени запрещен, так как используется клиентом для записи пути к папке.
+func normalizeFolderName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	switch {
+	case name == "":
+		return "", fmt.Errorf("%w: folder name is empty", ErrInvalidData)
+	case utf8.RuneCountInString(name) > maxFolderNameLength:
+		return "", fmt.Errorf("%w: folder name is too long", ErrInvalidData)
+	case strings.Contains(name, "/"):
+		return "", fmt.Errorf("%w: folder name contains /", ErrInvalidData)
+	}
+	return name, nil
+}
+
+// normalizeTags приводит метки к единому виду и убирает повторы.
+func normalizeTags(tags []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(tags))
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+		switch {
+		case tag == "":
+			return nil, fmt.Errorf("%w: tag is empty", ErrInvalidData)
+		case utf8.RuneCountInString(tag) > maxTagLength:
+			return nil, fmt.Errorf("%w: tag %q is too long", ErrInvalidData, tag)
+		case strings.IndexFunc(tag, func(r rune) bool { return unicode.IsSpace(r) || r == ',' }) >= 0:
+			return nil, fmt.Errorf("%w: tag %q contains spaces or commas", ErrInvalidData, tag)
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		result = append(result, tag)
+	}
+	return result, nil
+}
@@ -0,0 +1,131 @@
+package goph_test
+
+import (
+	"testing"
+
+	"github.com/bubu256/gophkeeper_pet/config"
+	"github.com/bubu256/gophkeeper_pet/internal/goph"
+	"github.com/bubu256/gophkeeper_pet/internal/schema"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// folderKeeper - папки пользователя 1 в памяти: 1 "work" -> 2 "projects" -> 3 "old", 4 "home";
+// пользователю 1 принадлежат данные с InfoID 10 и 11.
+type folderKeeper struct {
+	keeper.Keeper
+	folders map[int64]*schema.Folder
+	tagged  []int64
+	added   []string
+	removed []string
+}
+
+func newFolderKeeper() *folderKeeper {
+	return &folderKeeper{folders: map[int64]*schema.Folder{
+		1: {ID: 1, OwnerID: 1, Name: "work"},
+		2: {ID: 2, OwnerID: 1, ParentID: 1, Name: "projects"},
+		3: {ID: 3, OwnerID: 1, ParentID: 2, Name: "old"},
+		4: {ID: 4, OwnerID: 1, Name: "home"},
+	}}
+}
+
+func (k *folderKeeper) GetFolders(userID int64) ([]*schema.Folder, error) {
+	var folders []*schema.Folder
+	for _, folder := range k.folders {
+		if folder.OwnerID == userID {
+			copied := *folder
+			folders = append(folders, &copied)
+		}
+	}
+	return folders, nil
+}
+
+func (k *folderKeeper) UpdateFolder(folder schema.Folder) error {
+	for _, other := range k.folders {
+		if other.ID != folder.ID && other.ParentID == folder.ParentID && other.Name == folder.Name {
+			return keeper.ErrConflict
+		}
+	}
+	k.folders[folder.ID] = &folder
+	return nil
+}
+
+func (k *folderKeeper) MoveFolder(userID, folderID, parentID int64) error {
+	folder, ok := k.folders[folderID]
+	if !ok || folder.OwnerID != userID {
+		return keeper.ErrNotFound
+	}
+	if parent, ok := k.folders[parentID]; parentID != 0 && (!ok || parent.OwnerID != userID) {
+		return keeper.ErrNotFound
+	}
+	for id := parentID; id != 0; id = k.folders[id].ParentID {
+		if id == folderID {
+			return keeper.ErrCycle
+		}
+	}
+	moved := *folder
+	moved.ParentID = parentID
+	return k.UpdateFolder(moved)
+}
+
+func (k *folderKeeper) GetUserDataInfo(userID int64) ([]*schema.InfoCell, error) {
+	if userID != 1 {
+		return nil, nil
+	}
+	return []*schema.InfoCell{{ID: 10, OwnerID: 1}, {ID: 11, OwnerID: 1}}, nil
+}
+
+func (k *folderKeeper) TagData(infoIDs []int64, add, remove []string) error {
+	k.tagged, k.added, k.removed = infoIDs, add, remove
+	return nil
+}
+
+func TestMoveFolder(t *testing.T) {
+	storage := newFolderKeeper()
+	logic, err := goph.New(storage, config.ServerConfig{})
+	require.NoError(t, err)
+
+	// папку нельзя переместить в нее саму и во вложенные в нее папки
+	assert.ErrorIs(t, logic.MoveFolder(1, 1, 1), goph.ErrFolderCycle)
+	assert.ErrorIs(t, logic.MoveFolder(1, 1, 3), goph.ErrFolderCycle)
+
+	// чужие и несуществующие папки
+	assert.ErrorIs(t, logic.MoveFolder(2, 3, 0), goph.ErrFolderNotFound)
+	assert.ErrorIs(t, logic.MoveFolder(1, 3, 5), goph.ErrFolderNotFound)
+
+	require.NoError(t, logic.MoveFolder(1, 3, 4))
+	assert.Equal(t, int64(4), storage.folders[3].ParentID)
+	require.NoError(t, logic.MoveFolder(1, 2, 0))
+	assert.Equal(t, int64(0), storage.folders[2].ParentID)
+	assert.Equal(t, "projects", storage.folders[2].Name)
+}
+
+func TestRenameFolder(t *testing.T) {
+	storage := newFolderKeeper()
+	logic, err := goph.New(storage, config.ServerConfig{})
+	require.NoError(t, err)
+
+	require.NoError(t, logic.RenameFolder(1, 4, "  personal "))
+	assert.Equal(t, "personal", storage.folders[4].Name)
+
+	assert.ErrorIs(t, logic.RenameFolder(1, 4, "work"), goph.ErrFolderExists)
+	assert.ErrorIs(t, logic.RenameFolder(1, 4, " "), goph.ErrInvalidData)
+	assert.ErrorIs(t, logic.RenameFolder(1, 4, "a/b"), goph.ErrInvalidData)
+}
+
+func TestTagData(t *testing.T) {
+	storage := newFolderKeeper()
+	logic, err := goph.New(storage, config.ServerConfig{})
+	require.NoError(t, err)
+
+	// метки приводятся к нижнему регистру без # и повторов
+	require.NoError(t, logic.TagData(1, []int64{10, 11}, []string{"#Bank", "bank", " travel "}, []string{"Old"}))
+	assert.Equal(t, []int64{10, 11}, storage.tagged)
+	assert.Equal(t, []string{"bank", "travel"}, storage.added)
+	assert.Equal(t, []string{"old"}, storage.removed)
+
+	assert.ErrorIs(t, logic.TagData(1, []int64{10}, []string{"two words"}, nil), goph.ErrInvalidData)
+	assert.ErrorIs(t, logic.TagData(1, []int64{10}, nil, nil), goph.ErrInvalidData)
+	assert.ErrorIs(t, logic.TagData(1, []int64{10, 12}, []string{"bank"}, nil), goph.ErrAccessDenied)
+}
@@ -1,120 +1,322 @@
 package goph
 
 import (
-	"crypto/hmac"
 	"crypto/rand"
-	"crypto/sha256"
-	"encoding/base64"
-	"encoding/binary"
-	"encoding/hex"
 	"fmt"
+	"log"
+	"time"
 
 	"errors"
 
 	"github.com/bubu256/gophkeeper_pet/config"
 	"github.com/bubu256/gophkeeper_pet/internal/schema"
 	"github.com/bubu256/gophkeeper_pet/pkg/keeper"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/breach"
 )
 
 // Goph представляет интерфейс для работы с бизнес-логикой приложения.
 type Goph interface {
 	CheckToken(token string) (bool, error)
-	CreateUser(username, password string) error
-	Authenticate(username, password string) (string, error)
+	CreateUser(username, password, clientKDF string) error
+	GetClientKDF(username string) (string, error)
+	Authenticate(username, password string) (Tokens, error)
+	ChangePassword(userID int64, oldPassword, newPassword, clientKDF string) error
+	RefreshToken(refreshToken string) (Tokens, error)
+	Logout(token string, allDevices bool) error
 	SaveData(userID int64, memoryCell *schema.MemoryCell) (int64, error)
 	GetUserDataInfo(userID int64) ([]*schema.InfoCell, error)
+	QueryDataInfo(userID int64, query InfoQuery) ([]*schema.InfoCell, string, error)
 	GetUserMemoryData(userID int64, infoIDs []int64) ([]*schema.MemoryCell, error)
+	SyncSince(userID, revision int64) (*schema.Changes, error)
 	UserExists(username string) (bool, error)
 	GetUserIDFromToken(token string) (int64, error)
+	UpdateData(userID int64, memoryCell *schema.MemoryCell, expectedRevision int64) error
+	DeleteData(userID int64, infoIDs []int64) error
+	ListVersions(userID, infoID int64) ([]*schema.Version, error)
+	RestoreVersion(userID, infoID, revision int64) (int64, error)
+	ListTrash(userID int64) ([]*schema.InfoCell, error)
+	RestoreFromTrash(userID int64, infoIDs []int64) error
+	EmptyTrash(userID int64) (int64, error)
+	ListFolders(userID int64) ([]*schema.Folder, error)
+	CreateFolder(userID int64, name string, parentID int64) (int64, error)
+	RenameFolder(userID, folderID int64, name string) error
+	MoveFolder(userID, folderID, parentID int64) error
+	MoveData(userID int64, infoIDs []int64, folderID int64) error
+	TagData(userID int64, infoIDs []int64, add, remove []string) error
+	ListTags(userID int64) ([]*schema.Tag, error)
+	CheckPasswordPrefix(prefix string) (map[string]int64, error)
+	BlobStatus(userID, infoID int64) (*schema.Blob, error)
+	StartBlobUpload(userID int64, header schema.Blob, offset int64) (*schema.Blob, error)
+	WriteBlobChunk(infoID, offset int64, chunk []byte) error
+	FinishBlobUpload(infoID int64) (*schema.Blob, error)
+	ReadBlob(userID, infoID, offset int64, header func(blob *schema.Blob) error, fn func(offset int64, chunk []byte) error) error
 }
 
+// ErrInvalidCredentials возвращается при неверном логине или пароле.
+var ErrInvalidCredentials = errors.New("invalid username or password")
+
+// ErrAccessDenied возвращается, когда пользователь обращается к данным, которые ему не принадлежат.
+var ErrAccessDenied = errors.New("access denied")
+
+// ErrConflict возвращается, если данные были изменены после ревизии, на основе которой сделано изменение.
+var ErrConflict = errors.New("data was modified concurrently")
+
+// Время жизни токенов по умолчанию.
+const (
+	defaultAccessTokenTTL  = 15 * time.Minute
+	defaultRefreshTokenTTL = 30 * 24 * time.Hour
+)
+
 // GophLogic представляет реализацию интерфейса Goph.
 type GophLogic struct {
-	secretKey []byte
-	keeper    keeper.Keeper
+	signingKeys     map[string][]byte
+	activeKeyID     string
+	accessTokenTTL  time.Duration
+	refreshTokenTTL time.Duration
+	keeper          keeper.Keeper
+	passwordParams  PasswordParams
+	trashRetention  time.Duration
+	purgeInterval   time.Duration
+	// breaches - база утекших паролей, nil, если не настроена.
+	breaches breach.Source
 }
 
 var _ Goph = &GophLogic{}
 
 // New создает новый экземпляр GophLogic с заданным Keeper и ServerConfig.
-// Генерирует случайный секретный ключ.
-func New(keeper keeper.Keeper, config config.ServerConfig) *GophLogic {
-	// Генерация случайного секретного ключа
-	secretKey := make([]byte, 32)
-	_, err := rand.Read(secretKey)
-	if err != nil {
-		panic(fmt.Errorf("failed to generate secret key: %w", err))
+// Ключи подписи токенов загружаются из конфигурации. Если они не заданы, генерируется
+// случайный ключ, и выданные токены перестают действовать после перезапуска сервера.
+func New(keeper keeper.Keeper, config config.ServerConfig) (*GophLogic, error) {
+	g := &GophLogic{
+		accessTokenTTL:  config.AccessTokenTTL,
+		refreshTokenTTL: config.RefreshTokenTTL,
+		keeper:          keeper,
+		passwordParams:  PasswordParamsFromConfig(config),
+		trashRetention:  config.TrashRetention,
+		purgeInterval:   config.PurgeInterval,
+	}
+	if g.accessTokenTTL == 0 {
+		g.accessTokenTTL = defaultAccessTokenTTL
+	}
+	if g.refreshTokenTTL == 0 {
+		g.refreshTokenTTL = defaultRefreshTokenTTL
+	}
+	if g.trashRetention == 0 {
+		g.trashRetention = defaultTrashRetention
+	}
+	if g.purgeInterval == 0 {
+		g.purgeInterval = defaultPurgeInterval
+	}
+
+	if config.BreachData != "" {
+		source, err := breach.Open(config.BreachData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open breach dataset: %w", err)
+		}
+		g.breaches = source
+	}
+
+	if config.TokenKeys == "" {
+		log.Println("TOKEN_KEYS is not set: using a random signing key, tokens will not survive a restart")
+		secretKey := make([]byte, 32)
+		if _, err := rand.Read(secretKey); err != nil {
+			return nil, fmt.Errorf("failed to generate secret key: %w", err)
+		}
+		g.SetSecretKey(secretKey)
+		return g, nil
 	}
 
-	return &GophLogic{
-		secretKey: secretKey,
-		keeper:    keeper,
+	keys, firstKeyID, err := ParseSigningKeys(config.TokenKeys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load token signing keys: %w", err)
 	}
+	activeKeyID := config.TokenActiveKey
+	if activeKeyID == "" {
+		activeKeyID = firstKeyID
+	}
+	if _, ok := keys[activeKeyID]; !ok {
+		return nil, fmt.Errorf("active signing key %q is not in TOKEN_KEYS", activeKeyID)
+	}
+	g.signingKeys = keys
+	g.activeKeyID = activeKeyID
+
+	return g, nil
 }
 
-// SetSecretKey устанавливает секретный ключ для GophLogic.
+// SetSecretKey устанавливает единственный секретный ключ подписи токенов для GophLogic.
 func (g *GophLogic) SetSecretKey(secretKey []byte) {
-	g.secretKey = secretKey
+	g.signingKeys = map[string][]byte{"default": secretKey}
+	g.activeKeyID = "default"
 }
 
-// GenerateToken - генерирует токен из ID пользователя и секретного ключа
-func (g *GophLogic) GenerateToken(userID int64) (string, error) {
-	idBytes := make([]byte, 4)
-	if userID < 0 || userID > (1<<31-1) {
-		return "", fmt.Errorf("userID is out of range")
+// GenerateToken - генерирует токен доступа для сессии пользователя, подписанный активным ключом.
+// Возвращает токен и время окончания его действия.
+func (g *GophLogic) GenerateToken(userID int64, sessionID string) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(g.accessTokenTTL)
+	claims := TokenClaims{
+		UserID:    userID,
+		SessionID: sessionID,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: expiresAt.Unix(),
 	}
-	binary.BigEndian.PutUint32(idBytes, uint32(userID))
 
-	h := hmac.New(sha256.New, g.secretKey)
-	h.Write(idBytes)
-	signature := h.Sum(nil)
-
-	token := append(idBytes, signature...)
-	return hex.EncodeToString(token), nil
+	token, err := signToken(g.activeKeyID, g.signingKeys[g.activeKeyID], claims)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return token, expiresAt, nil
 }
 
-// CheckToken - проверяет токен на подлинность
+// CheckToken - проверяет подпись и срок действия токена, а также то, что его сессия не отозвана.
+// Ошибка возвращается только при сбое хранилища, недействительный токен дает false.
 func (g *GophLogic) CheckToken(token string) (bool, error) {
-	decodeToken, err := hex.DecodeString(token)
+	claims, err := verifyToken(g.signingKeys, token, time.Now())
 	if err != nil {
-		return false, err
+		return false, nil
+	}
+
+	session, err := g.keeper.GetSession(claims.SessionID)
+	if errors.Is(err, keeper.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to retrieve session: %w", err)
 	}
-	idUser := decodeToken[:4]
-	sing := decodeToken[4:]
-	h := hmac.New(sha256.New, g.secretKey)
-	h.Write(idUser)
-	dst := h.Sum(nil)
-	return hmac.Equal(sing, dst), nil
+
+	return session.UserID == claims.UserID && session.Active(time.Now()), nil
 }
 
 // GetUserIDFromToken - получает ID пользователя из токена.
 func (g *GophLogic) GetUserIDFromToken(token string) (int64, error) {
-	decodedToken, err := hex.DecodeString(token)
+	claims, err := verifyToken(g.signingKeys, token, time.Now())
 	if err != nil {
 		return 0, err
 	}
 
-	if len(decodedToken) < 4 {
-		return 0, fmt.Errorf("invalid token length")
+	return claims.UserID, nil
+}
+
+// CreateSession - открывает новую сессию пользователя и выдает для нее пару токенов.
+func (g *GophLogic) CreateSession(userID int64) (Tokens, error) {
+	sessionID, err := randomString(16)
+	if err != nil {
+		return Tokens{}, err
+	}
+	refreshToken, refreshHash, err := newRefreshToken(sessionID)
+	if err != nil {
+		return Tokens{}, err
+	}
+
+	session := &schema.Session{
+		ID:               sessionID,
+		UserID:           userID,
+		RefreshTokenHash: refreshHash,
+		ExpiresAt:        time.Now().Add(g.refreshTokenTTL),
+	}
+	if err := g.keeper.CreateSession(session); err != nil {
+		return Tokens{}, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	accessToken, expiresAt, err := g.GenerateToken(userID, sessionID)
+	if err != nil {
+		return Tokens{}, err
+	}
+
+	return Tokens{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresAt:    expiresAt,
+	}, nil
+}
+
+// RefreshToken - выдает новую пару токенов по токену обновления.
+// Токен обновления одноразовый: при каждом обновлении он заменяется новым. Повторное
+// использование уже замененного токена считается признаком утечки, и сессия отзывается.
+func (g *GophLogic) RefreshToken(refreshToken string) (Tokens, error) {
+	sessionID, secret, err := splitRefreshToken(refreshToken)
+	if err != nil {
+		return Tokens{}, err
+	}
+
+	session, err := g.keeper.GetSession(sessionID)
+	if errors.Is(err, keeper.ErrNotFound) {
+		return Tokens{}, ErrInvalidToken
+	}
+	if err != nil {
+		return Tokens{}, fmt.Errorf("failed to retrieve session: %w", err)
+	}
+	if !session.Active(time.Now()) {
+		return Tokens{}, ErrInvalidToken
+	}
+
+	newToken, newHash, err := newRefreshToken(sessionID)
+	if err != nil {
+		return Tokens{}, err
+	}
+	rotated, err := g.keeper.RotateRefreshToken(sessionID, hashRefreshSecret(secret), newHash, time.Now().Add(g.refreshTokenTTL))
+	if err != nil {
+		return Tokens{}, fmt.Errorf("failed to rotate refresh token: %w", err)
+	}
+	if !rotated {
+		if err := g.keeper.RevokeSession(sessionID); err != nil {
+			log.Printf("failed to revoke session %s after refresh token reuse: %v", sessionID, err)
+		}
+		return Tokens{}, ErrInvalidToken
+	}
+
+	accessToken, expiresAt, err := g.GenerateToken(session.UserID, sessionID)
+	if err != nil {
+		return Tokens{}, err
 	}
 
-	idBytes := decodedToken[:4]
-	userID := binary.BigEndian.Uint32(idBytes)
+	return Tokens{
+		AccessToken:  accessToken,
+		RefreshToken: newToken,
+		ExpiresAt:    expiresAt,
+	}, nil
+}
+
+// Logout - отзывает сессию, к которой относится токен, или все сессии пользователя при allDevices.
+func (g *GophLogic) Logout(token string, allDevices bool) error {
+	claims, err := verifyToken(g.signingKeys, token, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if allDevices {
+		err = g.keeper.RevokeUserSessions(claims.UserID)
+	} else {
+		err = g.keeper.RevokeSession(claims.SessionID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
 
-	return int64(userID), nil
+	return nil
 }
 
 // CreateUser создает нового пользователя.
-// Хеширует логин и вызывает метод Keeper.CreateUser для сохранения пользователя.
-func (g *GophLogic) CreateUser(username, password string) error {
-	hashedPassword := HashPassword(password)
+// Хеширует пароль функцией Argon2id и вызывает метод Keeper.CreateUser для сохранения пользователя.
+// clientKDF описывает, как клиент получил пароль из мастер-пароля; пустое значение означает ClientKDFNone.
+func (g *GophLogic) CreateUser(username, password, clientKDF string) error {
+	clientKDF, err := normalizeClientKDF(clientKDF)
+	if err != nil {
+		return err
+	}
+
+	hashedPassword, err := HashPassword(password, g.passwordParams)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
 
 	user := &schema.User{
-		Username: username,
-		Password: hashedPassword,
+		Username:  username,
+		Password:  hashedPassword,
+		ClientKDF: clientKDF,
 	}
 
-	err := g.keeper.CreateUser(user)
+	err = g.keeper.CreateUser(user)
 	if err != nil {
 		return fmt.Errorf("failed to save user: %w", err)
 	}
@@ -125,12 +327,72 @@ func (g *GophLogic) CreateUser(username, password string) error {
 // ExistUser - проверяет существует ли пользователь
 func (g *GophLogic) UserExists(username string) (bool, error) {
 	user, err := g.keeper.GetUserByUsername(username)
+	if errors.Is(err, keeper.ErrNotFound) {
+		return false, nil
+	}
 	if err != nil {
 		return false, err
 	}
 	return user != nil, nil
 }
 
+// GetClientKDF - сообщает клиенту, как получать пароль для входа из мастер-пароля.
+// Для несуществующих пользователей возвращается схема по умолчанию, чтобы ответ не выдавал наличие учетной записи.
+func (g *GophLogic) GetClientKDF(username string) (string, error) {
+	user, err := g.keeper.GetUserByUsername(username)
+	if errors.Is(err, keeper.ErrNotFound) {
+		return schema.ClientKDFArgon2id, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve user: %w", err)
+	}
+	return user.ClientKDF, nil
+}
+
+// ChangePassword заменяет пароль пользователя после проверки текущего.
+// Используется в том числе для перевода старых учетных записей на ключ аутентификации.
+func (g *GophLogic) ChangePassword(userID int64, oldPassword, newPassword, clientKDF string) error {
+	clientKDF, err := normalizeClientKDF(clientKDF)
+	if err != nil {
+		return err
+	}
+
+	user, err := g.keeper.GetUserByID(userID)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve user: %w", err)
+	}
+
+	match, _, err := VerifyPassword(oldPassword, user.Password, g.passwordParams)
+	if err != nil {
+		return fmt.Errorf("failed to verify password: %w", err)
+	}
+	if !match {
+		return ErrInvalidCredentials
+	}
+
+	hashedPassword, err := HashPassword(newPassword, g.passwordParams)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+	if err := g.keeper.UpdateUserCredentials(userID, hashedPassword, clientKDF); err != nil {
+		return fmt.Errorf("failed to save password: %w", err)
+	}
+
+	return nil
+}
+
+// normalizeClientKDF - проверяет название схемы получения пароля на клиенте.
+func normalizeClientKDF(clientKDF string) (string, error) {
+	switch clientKDF {
+	case "":
+		return schema.ClientKDFNone, nil
+	case schema.ClientKDFNone, schema.ClientKDFArgon2id:
+		return clientKDF, nil
+	default:
+		return "", fmt.Errorf("unknown client kdf %q", clientKDF)
+	}
+}
+
 // GetUserID - возвращает ID пользователя
 func (g *GophLogic) GetUserID(username string) (int64, error) {
 	user, err := g.keeper.GetUserByUsername(username)
@@ -141,23 +403,56 @@ func (g *GophLogic) GetUserID(username string) (int64, error) {
 }
 
 // Authenticate выполняет аутентификацию пользователя.
-// Хеширует пароль и проверяет его с хранимым хешем в Keeper.
-// Возвращает токен или ошибку, если аутентификация не удалась.
-func (g *GophLogic) Authenticate(username, password string) (string, error) {
+// Проверяет пароль по хранимому в Keeper хешу. Если хеш получен устаревшей функцией
+// или с другими параметрами Argon2id, после успешной проверки он пересчитывается.
+// Открывает новую сессию и возвращает ее токены или ошибку, если аутентификация не удалась.
+func (g *GophLogic) Authenticate(username, password string) (Tokens, error) {
 	user, err := g.keeper.GetUserByUsername(username)
+	if errors.Is(err, keeper.ErrNotFound) {
+		return Tokens{}, ErrInvalidCredentials
+	}
 	if err != nil {
-		return "", fmt.Errorf("failed to retrieve user: %w", err)
+		return Tokens{}, fmt.Errorf("failed to retrieve user: %w", err)
 	}
 
-	if HashPassword(password) != user.Password {
-		return "", fmt.Errorf("authentication failed: %w", err)
+	match, needsRehash, err := VerifyPassword(password, user.Password, g.passwordParams)
+	if err != nil {
+		return Tokens{}, fmt.Errorf("failed to verify password: %w", err)
 	}
+	if !match {
+		return Tokens{}, ErrInvalidCredentials
+	}
+
+	if needsRehash {
+		g.rehashPassword(user.ID, password)
+	}
+
+	return g.CreateSession(user.ID)
+}
 
-	return g.GenerateToken(user.ID)
+// rehashPassword пересчитывает хеш пароля с текущими параметрами и сохраняет его.
+// Ошибка не прерывает аутентификацию: хеш будет пересчитан при следующем входе.
+func (g *GophLogic) rehashPassword(userID int64, password string) {
+	hashedPassword, err := HashPassword(password, g.passwordParams)
+	if err != nil {
+		log.Printf("failed to rehash password for user %d: %v", userID, err)
+		return
+	}
+	if err := g.keeper.UpdateUserPassword(userID, hashedPassword); err != nil {
+		log.Printf("failed to save rehashed password for user %d: %v", userID, err)
+	}
 }
 
 // SaveData сохраняет новые данные для пользователя.
+// Если данные не соответствуют своему виду, возвращает ErrInvalidData.
 func (g *GophLogic) SaveData(userID int64, memoryCell *schema.MemoryCell) (int64, error) {
+	if memoryCell.InfoCell == nil {
+		return 0, errors.New("info cell is empty")
+	}
+	if err := validateCell(memoryCell); err != nil {
+		return 0, err
+	}
+
 	memoryCell.InfoCell.OwnerID = userID
 	memoryCell.InfoCell.DataSize = int32(len(memoryCell.BinaryData))
 	infoID, err := g.keeper.AddData(*memoryCell.InfoCell, memoryCell)
@@ -181,26 +476,9 @@ func (g *GophLogic) GetUserDataInfo(userID int64) ([]*schema.InfoCell, error) {
 // GetUserMemoryData возвращает данные пользователя для указанных идентификаторов InfoID.
 // Проверяет, принадлежат ли идентификаторы пользователю, и вызывает соответствующий метод Keeper для получения данных из базы данных.
 func (g *GophLogic) GetUserMemoryData(userID int64, infoIDs []int64) ([]*schema.MemoryCell, error) {
-	infoCells, err := g.keeper.GetUserDataInfo(userID)
+	filteredInfoIDs, err := g.filterOwnedIDs(userID, infoIDs)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve user data: %w", err)
-	}
-
-	// Создаем слайс из InfoCell.ID
-	infoCellIDs := make([]int64, 0, len(infoCells))
-	for _, infoCell := range infoCells {
-		infoCellIDs = append(infoCellIDs, infoCell.ID)
-	}
-
-	// Фильтруем infoIDs, оставляем только те ID, которые есть в infoCellIDs
-	filteredInfoIDs := make([]int64, 0, len(infoIDs))
-	for _, id := range infoIDs {
-		for _, infoCellID := range infoCellIDs {
-			if id == infoCellID {
-				filteredInfoIDs = append(filteredInfoIDs, id)
-				break
-			}
-		}
+		return nil, err
 	}
 
 	memoryCells := make([]*schema.MemoryCell, 0)
@@ -217,8 +495,104 @@ func (g *GophLogic) GetUserMemoryData(userID int64, infoIDs []int64) ([]*schema.
 	return memoryCells, nil
 }
 
-// hashUsername хеширует логин пользователя.
-func HashPassword(username string) string {
-	hashedUsername := sha256.Sum256([]byte(username))
-	return base64.URLEncoding.EncodeToString(hashedUsername[:])
+// SyncSince возвращает изменения данных пользователя после указанной ревизии.
+// Ревизия 0 возвращает все данные пользователя.
+func (g *GophLogic) SyncSince(userID, revision int64) (*schema.Changes, error) {
+	if revision < 0 {
+		revision = 0
+	}
+
+	changes, err := g.keeper.GetChangesSince(userID, revision)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve changes: %w", err)
+	}
+
+	return changes, nil
+}
+
+// UpdateData обновляет данные пользователя.
+// Изменять можно только данные, принадлежащие пользователю, иначе возвращается ErrAccessDenied.
+// Если expectedRevision не равна нулю и данные уже изменены (ревизия отличается), возвращается ErrConflict.
+// Новая ревизия данных записывается в memoryCell.InfoCell.Revision.
+func (g *GophLogic) UpdateData(userID int64, memoryCell *schema.MemoryCell, expectedRevision int64) error {
+	if memoryCell.InfoCell == nil {
+		return errors.New("info cell is empty")
+	}
+	if err := validateCell(memoryCell); err != nil {
+		return err
+	}
+
+	if err := g.checkOwnership(userID, memoryCell.InfoCell.ID); err != nil {
+		return err
+	}
+
+	memoryCell.InfoID = memoryCell.InfoCell.ID
+	memoryCell.InfoCell.OwnerID = userID
+	memoryCell.InfoCell.DataSize = int32(len(memoryCell.BinaryData))
+
+	// Файл, загруженный потоком, сохраняется, пока его не заменили или не удалили
+	memoryCell.Blob = false
+	blob, err := g.keeper.GetBlob(memoryCell.InfoID)
+	switch {
+	case errors.Is(err, keeper.ErrNotFound):
+	case err != nil:
+		return fmt.Errorf("failed to retrieve blob: %w", err)
+	case len(memoryCell.BinaryData) == 0 && memoryCell.FileName != "" && blob.Complete:
+		memoryCell.InfoCell.DataSize = int32(blob.TotalSize)
+		memoryCell.Blob = true
+	}
+
+	revision, err := g.keeper.UpdateData(*memoryCell.InfoCell, *memoryCell, expectedRevision)
+	if errors.Is(err, keeper.ErrConflict) {
+		return ErrConflict
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update memory cell: %w", err)
+	}
+	memoryCell.InfoCell.Revision = revision
+
+	return nil
+}
+
+// DeleteData перемещает данные пользователя с указанными InfoID в корзину.
+// Если хотя бы один из идентификаторов не принадлежит пользователю, ничего не удаляется и возвращается ErrAccessDenied.
+func (g *GophLogic) DeleteData(userID int64, infoIDs []int64) error {
+	if len(infoIDs) == 0 {
+		return errors.New("no data")
+	}
+
+	if err := g.checkOwnedIDs(userID, infoIDs); err != nil {
+		return err
+	}
+
+	_, err := g.keeper.DeleteData(infoIDs)
+	if err != nil {
+		return fmt.Errorf("failed to delete data: %w", err)
+	}
+
+	return nil
+}
+
+// filterOwnedIDs - оставляет из infoIDs только идентификаторы, принадлежащие пользователю.
+func (g *GophLogic) filterOwnedIDs(userID int64, infoIDs []int64) ([]int64, error) {
+	infoCells, err := g.keeper.GetUserDataInfo(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve user data: %w", err)
+	}
+
+	// Создаем множество из InfoCell.ID
+	infoCellIDs := make(map[int64]struct{}, len(infoCells))
+	for _, infoCell := range infoCells {
+		infoCellIDs[infoCell.ID] = struct{}{}
+	}
+
+	// Фильтруем infoIDs, оставляем только те ID, которые есть в infoCellIDs
+	filteredInfoIDs := make([]int64, 0, len(infoIDs))
+	for _, id := range infoIDs {
+		if _, ok := infoCellIDs[id]; ok {
+			filteredInfoIDs = append(filteredInfoIDs, id)
+		}
+	}
+
+	return filteredInfoIDs, nil
 }
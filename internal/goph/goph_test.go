@@ -1,56 +1,421 @@
 package goph_test
 
 import (
+	"encoding/hex"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/bubu256/gophkeeper_pet/config"
 	"github.com/bubu256/gophkeeper_pet/internal/goph"
+	"github.com/bubu256/gophkeeper_pet/internal/schema"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+// fakeKeeper - хранилище пользователей, сессий и владельцев данных в памяти. Остальные методы Keeper не реализованы.
+type fakeKeeper struct {
+	keeper.Keeper
+	users    []*schema.User
+	sessions map[string]*schema.Session
+	// owners - владелец данных по InfoID, updated и deleted - InfoID измененных и удаленных данных.
+	owners  map[int64]int64
+	updated []int64
+	deleted []int64
+}
+
+func newFakeKeeper() *fakeKeeper {
+	return &fakeKeeper{sessions: make(map[string]*schema.Session), owners: make(map[int64]int64)}
+}
+
+func (f *fakeKeeper) CreateUser(user *schema.User) error {
+	user.ID = int64(len(f.users) + 1)
+	copied := *user
+	f.users = append(f.users, &copied)
+	return nil
+}
+
+func (f *fakeKeeper) GetUserByUsername(username string) (*schema.User, error) {
+	for _, user := range f.users {
+		if user.Username == username {
+			copied := *user
+			return &copied, nil
+		}
+	}
+	return nil, keeper.ErrNotFound
+}
+
+func (f *fakeKeeper) GetUserByID(userID int64) (*schema.User, error) {
+	for _, user := range f.users {
+		if user.ID == userID {
+			copied := *user
+			return &copied, nil
+		}
+	}
+	return nil, keeper.ErrNotFound
+}
+
+func (f *fakeKeeper) UpdateUserPassword(userID int64, passwordHash string) error {
+	user, err := f.GetUserByID(userID)
+	if err != nil {
+		return err
+	}
+	return f.UpdateUserCredentials(userID, passwordHash, user.ClientKDF)
+}
+
+func (f *fakeKeeper) UpdateUserCredentials(userID int64, passwordHash, clientKDF string) error {
+	for _, user := range f.users {
+		if user.ID == userID {
+			user.Password = passwordHash
+			user.ClientKDF = clientKDF
+			return nil
+		}
+	}
+	return keeper.ErrNotFound
+}
+
+func (f *fakeKeeper) CreateSession(session *schema.Session) error {
+	session.CreatedAt = time.Now()
+	copied := *session
+	f.sessions[session.ID] = &copied
+	return nil
+}
+
+func (f *fakeKeeper) GetSession(sessionID string) (*schema.Session, error) {
+	session, ok := f.sessions[sessionID]
+	if !ok {
+		return nil, keeper.ErrNotFound
+	}
+	copied := *session
+	return &copied, nil
+}
+
+func (f *fakeKeeper) RotateRefreshToken(sessionID, oldHash, newHash string, expiresAt time.Time) (bool, error) {
+	session, ok := f.sessions[sessionID]
+	if !ok || session.RefreshTokenHash != oldHash || !session.Active(time.Now()) {
+		return false, nil
+	}
+	session.RefreshTokenHash = newHash
+	session.ExpiresAt = expiresAt
+	return true, nil
+}
+
+func (f *fakeKeeper) RevokeSession(sessionID string) error {
+	if session, ok := f.sessions[sessionID]; ok {
+		now := time.Now()
+		session.RevokedAt = &now
+	}
+	return nil
+}
+
+func (f *fakeKeeper) RevokeUserSessions(userID int64) error {
+	for id, session := range f.sessions {
+		if session.UserID == userID {
+			f.RevokeSession(id)
+		}
+	}
+	return nil
+}
+
+func (f *fakeKeeper) GetUserDataInfo(userID int64) ([]*schema.InfoCell, error) {
+	var infoCells []*schema.InfoCell
+	for id, owner := range f.owners {
+		if owner == userID {
+			infoCells = append(infoCells, &schema.InfoCell{ID: id, OwnerID: owner})
+		}
+	}
+	return infoCells, nil
+}
+
+func (f *fakeKeeper) GetBlob(infoID int64) (*schema.Blob, error) {
+	return nil, keeper.ErrNotFound
+}
+
+func (f *fakeKeeper) UpdateData(infoCell schema.InfoCell, memoryCell schema.MemoryCell, expectedRevision int64) (int64, error) {
+	f.updated = append(f.updated, infoCell.ID)
+	return int64(len(f.updated)), nil
+}
+
+func (f *fakeKeeper) DeleteData(infoIDs []int64) (bool, error) {
+	f.deleted = append(f.deleted, infoIDs...)
+	return true, nil
+}
+
+func newTestLogic(t *testing.T, cfg config.ServerConfig) (*goph.GophLogic, *fakeKeeper) {
+	t.Helper()
+	fk := newFakeKeeper()
+	gophLogic, err := goph.New(fk, cfg)
+	require.NoError(t, err)
+	gophLogic.SetSecretKey([]byte("secret_key"))
+	return gophLogic, fk
+}
+
 func TestCheckToken_ValidToken(t *testing.T) {
-	secretKey := []byte("secret_key")
-	gophLogic := goph.New(nil, config.ServerConfig{})
-	gophLogic.SetSecretKey(secretKey)
+	gophLogic, _ := newTestLogic(t, config.ServerConfig{})
 
 	userID := int64(123)
-	token, _ := gophLogic.GenerateToken(userID)
+	tokens, err := gophLogic.CreateSession(userID)
+	require.NoError(t, err)
 
-	valid, err := gophLogic.CheckToken(token)
+	valid, err := gophLogic.CheckToken(tokens.AccessToken)
 	assert.True(t, valid)
 	assert.NoError(t, err)
+
+	id, err := gophLogic.GetUserIDFromToken(tokens.AccessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, userID, id)
 }
 
 func TestCheckToken_InvalidToken(t *testing.T) {
-	secretKey := []byte("secret_key")
-	gophLogic := goph.New(nil, config.ServerConfig{})
-	gophLogic.SetSecretKey(secretKey)
+	gophLogic, _ := newTestLogic(t, config.ServerConfig{})
 
 	// Create an invalid token by modifying a valid token
 	userID := int64(123)
-	validToken, _ := gophLogic.GenerateToken(userID)
-	invalidToken := validToken[1:] + "f"
+	tokens, err := gophLogic.CreateSession(userID)
+	require.NoError(t, err)
+	last := "f"
+	if strings.HasSuffix(tokens.AccessToken, last) {
+		last = "g"
+	}
+	invalidToken := tokens.AccessToken[:len(tokens.AccessToken)-1] + last
 
 	valid, err := gophLogic.CheckToken(invalidToken)
 	assert.False(t, valid)
 	assert.NoError(t, err)
+
+	// последний символ подписи содержит и биты выравнивания base64: токен, отличающийся только ими, недействителен
+	const alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+	index := strings.IndexByte(alphabet, tokens.AccessToken[len(tokens.AccessToken)-1])
+	nonCanonical := tokens.AccessToken[:len(tokens.AccessToken)-1] + string(alphabet[index^1])
+	valid, err = gophLogic.CheckToken(nonCanonical)
+	assert.False(t, valid)
+	assert.NoError(t, err)
+
+	valid, err = gophLogic.CheckToken("garbage")
+	assert.False(t, valid)
+	assert.NoError(t, err)
+}
+
+func TestCheckToken_Expired(t *testing.T) {
+	gophLogic, _ := newTestLogic(t, config.ServerConfig{AccessTokenTTL: -time.Minute})
+
+	tokens, err := gophLogic.CreateSession(123)
+	require.NoError(t, err)
+
+	valid, err := gophLogic.CheckToken(tokens.AccessToken)
+	assert.False(t, valid)
+	assert.NoError(t, err)
+}
+
+func TestCheckToken_RevokedSession(t *testing.T) {
+	gophLogic, _ := newTestLogic(t, config.ServerConfig{})
+
+	tokens, err := gophLogic.CreateSession(123)
+	require.NoError(t, err)
+	other, err := gophLogic.CreateSession(123)
+	require.NoError(t, err)
+
+	require.NoError(t, gophLogic.Logout(tokens.AccessToken, false))
+	valid, _ := gophLogic.CheckToken(tokens.AccessToken)
+	assert.False(t, valid)
+	valid, _ = gophLogic.CheckToken(other.AccessToken)
+	assert.True(t, valid)
+
+	require.NoError(t, gophLogic.Logout(other.AccessToken, true))
+	valid, _ = gophLogic.CheckToken(other.AccessToken)
+	assert.False(t, valid)
 }
 
 func TestGenerateToken(t *testing.T) {
-	secretKey := []byte("secret_key")
-	gophLogic := goph.New(nil, config.ServerConfig{})
-	gophLogic.SetSecretKey(secretKey)
+	gophLogic, _ := newTestLogic(t, config.ServerConfig{AccessTokenTTL: time.Hour})
 
 	userID := int64(123)
-	token, err := gophLogic.GenerateToken(userID)
+	token, expiresAt, err := gophLogic.GenerateToken(userID, "session")
 	assert.NoError(t, err)
 	assert.NotEmpty(t, token)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), expiresAt, time.Minute)
+	assert.True(t, strings.HasPrefix(token, "default."))
+}
+
+func TestRefreshToken(t *testing.T) {
+	gophLogic, _ := newTestLogic(t, config.ServerConfig{})
+
+	tokens, err := gophLogic.CreateSession(123)
+	require.NoError(t, err)
+
+	refreshed, err := gophLogic.RefreshToken(tokens.RefreshToken)
+	require.NoError(t, err)
+	assert.NotEqual(t, tokens.RefreshToken, refreshed.RefreshToken)
+	valid, _ := gophLogic.CheckToken(refreshed.AccessToken)
+	assert.True(t, valid)
+
+	// Повторное использование замененного токена обновления отзывает сессию
+	_, err = gophLogic.RefreshToken(tokens.RefreshToken)
+	assert.ErrorIs(t, err, goph.ErrInvalidToken)
+	valid, _ = gophLogic.CheckToken(refreshed.AccessToken)
+	assert.False(t, valid)
+	_, err = gophLogic.RefreshToken(refreshed.RefreshToken)
+	assert.ErrorIs(t, err, goph.ErrInvalidToken)
+}
+
+func TestSigningKeyRotation(t *testing.T) {
+	oldKey := hex.EncodeToString([]byte(strings.Repeat("o", 32)))
+	newKey := hex.EncodeToString([]byte(strings.Repeat("n", 32)))
+
+	fk := newFakeKeeper()
+	before, err := goph.New(fk, config.ServerConfig{TokenKeys: "k1:" + oldKey})
+	require.NoError(t, err)
+	tokens, err := before.CreateSession(123)
+	require.NoError(t, err)
+
+	// После ротации токены, подписанные старым ключом, остаются действительными
+	after, err := goph.New(fk, config.ServerConfig{TokenKeys: "k1:" + oldKey + ",k2:" + newKey, TokenActiveKey: "k2"})
+	require.NoError(t, err)
+	valid, err := after.CheckToken(tokens.AccessToken)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	newTokens, err := after.CreateSession(123)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(newTokens.AccessToken, "k2."))
+
+	// После удаления старого ключа его токены не принимаются
+	removed, err := goph.New(fk, config.ServerConfig{TokenKeys: "k2:" + newKey})
+	require.NoError(t, err)
+	valid, _ = removed.CheckToken(tokens.AccessToken)
+	assert.False(t, valid)
+}
+
+func TestParseSigningKeys(t *testing.T) {
+	key := hex.EncodeToString([]byte(strings.Repeat("k", 32)))
+
+	keys, first, err := goph.ParseSigningKeys("a:" + key + ", b:" + key)
+	assert.NoError(t, err)
+	assert.Equal(t, "a", first)
+	assert.Len(t, keys, 2)
+
+	_, _, err = goph.ParseSigningKeys("a:abcd")
+	assert.Error(t, err)
+	_, _, err = goph.ParseSigningKeys("a:" + key + ",a:" + key)
+	assert.Error(t, err)
+	_, _, err = goph.ParseSigningKeys("")
+	assert.Error(t, err)
+
+	_, err = goph.New(nil, config.ServerConfig{TokenKeys: "a:" + key, TokenActiveKey: "b"})
+	assert.Error(t, err)
+}
+
+func TestAuthenticate(t *testing.T) {
+	gophLogic, fk := newTestLogic(t, config.ServerConfig{Argon2Time: 1, Argon2Memory: 1024, Argon2Threads: 1})
+
+	require.NoError(t, gophLogic.CreateUser("user", "auth-key", schema.ClientKDFArgon2id))
+	exists, err := gophLogic.UserExists("user")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	exists, err = gophLogic.UserExists("nobody")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	tokens, err := gophLogic.Authenticate("user", "auth-key")
+	require.NoError(t, err)
+	valid, _ := gophLogic.CheckToken(tokens.AccessToken)
+	assert.True(t, valid)
+
+	_, err = gophLogic.Authenticate("user", "wrong")
+	assert.ErrorIs(t, err, goph.ErrInvalidCredentials)
+	_, err = gophLogic.Authenticate("nobody", "auth-key")
+	assert.ErrorIs(t, err, goph.ErrInvalidCredentials)
+
+	// Устаревший хеш пересчитывается после успешного входа
+	fk.users[0].Password = goph.HashPasswordSHA256("auth-key")
+	_, err = gophLogic.Authenticate("user", "auth-key")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(fk.users[0].Password, "$argon2id$"))
+}
+
+func TestChangePassword_UpgradeLegacyAccount(t *testing.T) {
+	gophLogic, _ := newTestLogic(t, config.ServerConfig{Argon2Time: 1, Argon2Memory: 1024, Argon2Threads: 1})
+
+	// Учетная запись старого клиента, который передавал мастер-пароль как есть
+	require.NoError(t, gophLogic.CreateUser("user", "master", ""))
+	kdf, err := gophLogic.GetClientKDF("user")
+	require.NoError(t, err)
+	assert.Equal(t, schema.ClientKDFNone, kdf)
+
+	tokens, err := gophLogic.Authenticate("user", "master")
+	require.NoError(t, err)
+	userID, err := gophLogic.GetUserIDFromToken(tokens.AccessToken)
+	require.NoError(t, err)
+
+	err = gophLogic.ChangePassword(userID, "wrong", "auth-key", schema.ClientKDFArgon2id)
+	assert.ErrorIs(t, err, goph.ErrInvalidCredentials)
+
+	require.NoError(t, gophLogic.ChangePassword(userID, "master", "auth-key", schema.ClientKDFArgon2id))
+	kdf, err = gophLogic.GetClientKDF("user")
+	require.NoError(t, err)
+	assert.Equal(t, schema.ClientKDFArgon2id, kdf)
+	_, err = gophLogic.Authenticate("user", "master")
+	assert.ErrorIs(t, err, goph.ErrInvalidCredentials)
+	_, err = gophLogic.Authenticate("user", "auth-key")
+	assert.NoError(t, err)
+
+	// Для неизвестных пользователей возвращается схема по умолчанию
+	kdf, err = gophLogic.GetClientKDF("nobody")
+	require.NoError(t, err)
+	assert.Equal(t, schema.ClientKDFArgon2id, kdf)
+
+	assert.Error(t, gophLogic.CreateUser("other", "pass", "md5"))
 }
 
-func TestHashPassword(t *testing.T) {
+func TestHashPasswordSHA256(t *testing.T) {
 	username := "test_user"
 	expectedHash := "EWATCHX9oIEsmcXj8aA1FkcaY3DE-XEpsiGTjrR2PmM="
 
-	hash := goph.HashPassword(username)
+	hash := goph.HashPasswordSHA256(username)
 	assert.Equal(t, expectedHash, hash)
 }
+
+func TestUpdateData_Ownership(t *testing.T) {
+	gophLogic, fk := newTestLogic(t, config.ServerConfig{})
+	fk.owners[10] = 1
+	fk.owners[20] = 2
+
+	newCell := func(infoID int64) *schema.MemoryCell {
+		return &schema.MemoryCell{
+			InfoCell: &schema.InfoCell{ID: infoID, DataType: "note"},
+			Payload:  &schema.Payload{Note: &schema.NotePayload{Text: "text"}},
+		}
+	}
+
+	// данные другого пользователя не изменяются
+	err := gophLogic.UpdateData(1, newCell(20), 0)
+	assert.ErrorIs(t, err, goph.ErrAccessDenied)
+	err = gophLogic.UpdateData(1, newCell(30), 0)
+	assert.ErrorIs(t, err, goph.ErrAccessDenied)
+	assert.Empty(t, fk.updated)
+
+	cell := newCell(10)
+	require.NoError(t, gophLogic.UpdateData(1, cell, 0))
+	assert.Equal(t, []int64{10}, fk.updated)
+	assert.Equal(t, int64(1), cell.InfoCell.OwnerID)
+}
+
+func TestDeleteData_Ownership(t *testing.T) {
+	gophLogic, fk := newTestLogic(t, config.ServerConfig{})
+	fk.owners[10] = 1
+	fk.owners[11] = 1
+	fk.owners[20] = 2
+
+	err := gophLogic.DeleteData(1, []int64{20})
+	assert.ErrorIs(t, err, goph.ErrAccessDenied)
+	// если среди InfoID есть чужие, не удаляются и собственные данные
+	err = gophLogic.DeleteData(1, []int64{10, 20, 11})
+	assert.ErrorIs(t, err, goph.ErrAccessDenied)
+	assert.Empty(t, fk.deleted)
+
+	require.NoError(t, gophLogic.DeleteData(1, []int64{10, 11}))
+	assert.Equal(t, []int64{10, 11}, fk.deleted)
+	require.NoError(t, gophLogic.DeleteData(2, []int64{20}))
+	assert.Equal(t, []int64{10, 11, 20}, fk.deleted)
+}
@@ -0,0 +1,173 @@
+package goph
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bubu256/gophkeeper_pet/internal/kinds"
+	"github.com/bubu256/gophkeeper_pet/internal/schema"
+)
+
+// maxInfoPageSize - наибольший размер страницы информации о данных.
+const maxInfoPageSize = 1000
+
+// ErrInvalidPageToken возвращается, если токен страницы поврежден или выдан для запроса с другими условиями.
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// InfoQuery описывает запрос информации о данных пользователя.
+type InfoQuery struct {
+	Filter schema.InfoFilter
+	// Sort - порядок сортировки (schema.SortBy...), по умолчанию по ID.
+	Sort       string
+	Descending bool
+	// PageSize - количество записей на странице, 0 - все записи одной страницей.
+	PageSize int
+	// PageToken - токен следующей страницы из предыдущего ответа, пустой для первой страницы.
+	PageToken string
+}
+
+// pageToken - содержимое токена страницы: условия запроса, для которого он выдан, и позиция последней записи.
+type pageToken struct {
+	Sort       string `json:"s"`
+	Descending bool   `json:"d,omitempty"`
+	Filter     string `json:"f"`
+	schema.InfoCursor
+}
+
+// QueryDataInfo возвращает страницу информации о данных пользователя, отобранной и упорядоченной по query,
+// и токен следующей страницы. Если страница последняя, токен пустой.
+func (g *GophLogic) QueryDataInfo(userID int64, query InfoQuery) ([]*schema.InfoCell, string, error) {
+	if query.Sort == "" {
+		query.Sort = schema.SortByID
+	}
+	switch query.Sort {
+	case schema.SortByID, schema.SortByCreated, schema.SortByUpdated, schema.SortByDescription:
+	default:
+		return nil, "", fmt.Errorf("%w: unknown sort order %q", ErrInvalidData, query.Sort)
+	}
+	if query.PageSize < 0 {
+		return nil, "", fmt.Errorf("%w: negative page size", ErrInvalidData)
+	}
+	if query.PageSize > maxInfoPageSize {
+		query.PageSize = maxInfoPageSize
+	}
+
+	filter, err := normalizeFilter(query.Filter)
+	if err != nil {
+		return nil, "", err
+	}
+	fingerprint, err := filterFingerprint(filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	keeperQuery := schema.InfoQuery{Filter: filter, Sort: query.Sort, Descending: query.Descending}
+	if query.PageToken != "" {
+		token, err := decodePageToken(query.PageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		if token.Sort != query.Sort || token.Descending != query.Descending || token.Filter != fingerprint {
+			return nil, "", fmt.Errorf("%w: token was issued for another query", ErrInvalidPageToken)
+		}
+		keeperQuery.After = &token.InfoCursor
+	}
+	if query.PageSize > 0 {
+		// лишняя запись показывает, что за страницей есть еще данные
+		keeperQuery.Limit = query.PageSize + 1
+	}
+
+	infoCells, err := g.keeper.QueryUserDataInfo(userID, keeperQuery)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to retrieve info cells: %w", err)
+	}
+	if query.PageSize == 0 || len(infoCells) <= query.PageSize {
+		return infoCells, "", nil
+	}
+
+	infoCells = infoCells[:query.PageSize]
+	next, err := encodePageToken(pageToken{
+		Sort:       query.Sort,
+		Descending: query.Descending,
+		Filter:     fingerprint,
+		InfoCursor: cursorOf(infoCells[len(infoCells)-1], query.Sort),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return infoCells, next, nil
+}
+
+// normalizeFilter приводит виды данных и метки фильтра к единому виду.
+func normalizeFilter(filter schema.InfoFilter) (schema.InfoFilter, error) {
+	dataTypes := make([]string, 0, len(filter.DataTypes))
+	for _, dataType := range filter.DataTypes {
+		// данные старых версий могут иметь вид, не входящий в список известных видов
+		if kind, err := kinds.Parse(dataType); err == nil {
+			dataType = kind
+		}
+		dataTypes = append(dataTypes, dataType)
+	}
+	filter.DataTypes = dataTypes
+
+	tags, err := normalizeTags(filter.Tags)
+	if err != nil {
+		return filter, err
+	}
+	filter.Tags = tags
+	filter.Text = strings.TrimSpace(filter.Text)
+
+	return filter, nil
+}
+
+// filterFingerprint возвращает отпечаток условий отбора, по которому токен страницы связывается с запросом.
+func filterFingerprint(filter schema.InfoFilter) (string, error) {
+	data, err := json.Marshal(filter)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal filter: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+// cursorOf возвращает позицию записи в порядке сортировки sort.
+func cursorOf(infoCell *schema.InfoCell, sort string) schema.InfoCursor {
+	cursor := schema.InfoCursor{ID: infoCell.ID}
+	switch sort {
+	case schema.SortByCreated:
+		cursor.Time = infoCell.CreatedAt
+	case schema.SortByUpdated:
+		cursor.Time = infoCell.UpdatedAt
+	case schema.SortByDescription:
+		cursor.Text = infoCell.Description
+	}
+	return cursor
+}
+
+// encodePageToken кодирует токен страницы в строку.
+func encodePageToken(token pageToken) (string, error) {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal page token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodePageToken разбирает строку, полученную из encodePageToken.
+func decodePageToken(s string) (pageToken, error) {
+	var token pageToken
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return token, fmt.Errorf("%w: %v", ErrInvalidPageToken, err)
+	}
+	if err := json.Unmarshal(data, &token); err != nil {
+		return token, fmt.Errorf("%w: %v", ErrInvalidPageToken, err)
+	}
+	return token, nil
+}
@@ -0,0 +1,145 @@
+package goph_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/bubu256/gophkeeper_pet/config"
+	"github.com/bubu256/gophkeeper_pet/internal/goph"
+	"github.com/bubu256/gophkeeper_pet/internal/schema"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// infoKeeper - информация о данных в памяти, поддерживает сортировку по ID и описанию.
+type infoKeeper struct {
+	keeper.Keeper
+	infoCells []*schema.InfoCell
+	queries   []schema.InfoQuery
+}
+
+func (k *infoKeeper) QueryUserDataInfo(userID int64, query schema.InfoQuery) ([]*schema.InfoCell, error) {
+	k.queries = append(k.queries, query)
+
+	less := func(a, b *schema.InfoCell) bool {
+		if query.Sort == schema.SortByDescription && a.Description != b.Description {
+			return a.Description < b.Description
+		}
+		return a.ID < b.ID
+	}
+	sorted := append([]*schema.InfoCell(nil), k.infoCells...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if query.Descending {
+			return less(sorted[j], sorted[i])
+		}
+		return less(sorted[i], sorted[j])
+	})
+
+	var result []*schema.InfoCell
+	for _, infoCell := range sorted {
+		if after := query.After; after != nil {
+			// пропускаются записи до позиции курсора включительно
+			cursor := &schema.InfoCell{ID: after.ID, Description: after.Text}
+			if infoCell.ID == cursor.ID || less(cursor, infoCell) == query.Descending {
+				continue
+			}
+		}
+		result = append(result, infoCell)
+		if query.Limit > 0 && len(result) == query.Limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+func newInfoKeeper() *infoKeeper {
+	return &infoKeeper{infoCells: []*schema.InfoCell{
+		{ID: 1, Description: "bank"},
+		{ID: 2, Description: "mail"},
+		{ID: 3, Description: "bank"},
+		{ID: 4, Description: "airline"},
+		{ID: 5, Description: "work"},
+	}}
+}
+
+// collectPages - получает все страницы запроса и возвращает ID данных в порядке получения.
+func collectPages(t *testing.T, logic *goph.GophLogic, query goph.InfoQuery) []int64 {
+	var ids []int64
+	for page := 0; page < 10; page++ {
+		infoCells, next, err := logic.QueryDataInfo(1, query)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(infoCells), query.PageSize)
+		for _, infoCell := range infoCells {
+			ids = append(ids, infoCell.ID)
+		}
+		if next == "" {
+			return ids
+		}
+		query.PageToken = next
+	}
+	t.Fatal("too many pages")
+	return nil
+}
+
+func TestQueryDataInfo_Pagination(t *testing.T) {
+	logic, err := goph.New(newInfoKeeper(), config.ServerConfig{})
+	require.NoError(t, err)
+
+	ids := collectPages(t, logic, goph.InfoQuery{PageSize: 2})
+	assert.Equal(t, []int64{1, 2, 3, 4, 5}, ids)
+
+	ids = collectPages(t, logic, goph.InfoQuery{PageSize: 2, Descending: true})
+	assert.Equal(t, []int64{5, 4, 3, 2, 1}, ids)
+
+	// записи с одинаковым описанием упорядочиваются по ID и не теряются на границе страниц
+	ids = collectPages(t, logic, goph.InfoQuery{PageSize: 2, Sort: schema.SortByDescription})
+	assert.Equal(t, []int64{4, 1, 3, 2, 5}, ids)
+
+	// без размера страницы возвращаются все данные
+	infoCells, next, err := logic.QueryDataInfo(1, goph.InfoQuery{})
+	require.NoError(t, err)
+	assert.Len(t, infoCells, 5)
+	assert.Empty(t, next)
+}
+
+func TestQueryDataInfo_InvalidQuery(t *testing.T) {
+	storage := newInfoKeeper()
+	logic, err := goph.New(storage, config.ServerConfig{})
+	require.NoError(t, err)
+
+	_, _, err = logic.QueryDataInfo(1, goph.InfoQuery{Sort: "size"})
+	assert.ErrorIs(t, err, goph.ErrInvalidData)
+	_, _, err = logic.QueryDataInfo(1, goph.InfoQuery{PageSize: -1})
+	assert.ErrorIs(t, err, goph.ErrInvalidData)
+	_, _, err = logic.QueryDataInfo(1, goph.InfoQuery{PageToken: "not a token"})
+	assert.ErrorIs(t, err, goph.ErrInvalidPageToken)
+
+	// токен действует только для запроса с теми же условиями и порядком
+	_, next, err := logic.QueryDataInfo(1, goph.InfoQuery{PageSize: 2})
+	require.NoError(t, err)
+	_, _, err = logic.QueryDataInfo(1, goph.InfoQuery{PageSize: 2, PageToken: next, Sort: schema.SortByDescription})
+	assert.ErrorIs(t, err, goph.ErrInvalidPageToken)
+	query := goph.InfoQuery{PageSize: 2, PageToken: next, Filter: schema.InfoFilter{Text: "bank"}}
+	_, _, err = logic.QueryDataInfo(1, query)
+	assert.ErrorIs(t, err, goph.ErrInvalidPageToken)
+}
+
+func TestQueryDataInfo_Filter(t *testing.T) {
+	storage := newInfoKeeper()
+	logic, err := goph.New(storage, config.ServerConfig{})
+	require.NoError(t, err)
+
+	filter := schema.InfoFilter{DataTypes: []string{"Card"}, Text: " bank ", Tags: []string{"#Travel", "travel"}}
+	_, _, err = logic.QueryDataInfo(1, goph.InfoQuery{Filter: filter, PageSize: 5000})
+	require.NoError(t, err)
+
+	require.Len(t, storage.queries, 1)
+	query := storage.queries[0]
+	assert.Equal(t, []string{"card"}, query.Filter.DataTypes)
+	assert.Equal(t, "bank", query.Filter.Text)
+	assert.Equal(t, []string{"travel"}, query.Filter.Tags)
+	assert.Equal(t, schema.SortByID, query.Sort)
+	// размер страницы ограничен, лишняя запись запрашивается для определения следующей страницы
+	assert.Equal(t, 1001, query.Limit)
+}
@@ -0,0 +1,164 @@
+package goph
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/bubu256/gophkeeper_pet/internal/kinds"
+	"github.com/bubu256/gophkeeper_pet/internal/schema"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/otp"
+)
+
+// ErrInvalidData возвращается, если данные не соответствуют своему виду.
+var ErrInvalidData = errors.New("invalid data")
+
+// validateCell проверяет вид данных и соответствие ему содержимого ячейки.
+// Зашифрованное содержимое проверяется только на наличие обязательных полей. Открытое содержимое
+// проверяется полностью и приводится к единому виду: номер карты, срок ее действия и адрес сайта.
+// Данные без содержимого, сохраненные до появления видов данных, проверяются только по виду.
+func validateCell(cell *schema.MemoryCell) error {
+	if err := checkCell(cell); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidData, err)
+	}
+	return nil
+}
+
+// checkCell выполняет проверку для validateCell.
+func checkCell(cell *schema.MemoryCell) error {
+	kind := cell.InfoCell.DataType
+	if !kinds.Valid(kind) {
+		return fmt.Errorf("%w: %q", kinds.ErrUnknownKind, kind)
+	}
+	if cell.Payload == nil {
+		return nil
+	}
+
+	payloadKind, err := payloadKind(cell.Payload)
+	if err != nil {
+		return err
+	}
+	if payloadKind != kind {
+		return fmt.Errorf("%s payload does not match data kind %s", payloadKind, kind)
+	}
+
+	switch kind {
+	case kinds.Login:
+		return checkLogin(cell.Payload.Login, cell.Encrypted)
+	case kinds.Card:
+		return checkCard(cell.Payload.Card, cell.Encrypted)
+	case kinds.Note:
+		if cell.Payload.Note.Text == "" {
+			return errors.New("note text is required")
+		}
+	case kinds.File:
+		if cell.FileName == "" {
+			return errors.New("file is required")
+		}
+	case kinds.OTP:
+		return checkOTP(cell.Payload.OTP, cell.Encrypted)
+	}
+	return nil
+}
+
+// payloadKind возвращает вид данных по заполненному полю содержимого.
+func payloadKind(payload *schema.Payload) (string, error) {
+	var found []string
+	if payload.Login != nil {
+		found = append(found, kinds.Login)
+	}
+	if payload.Card != nil {
+		found = append(found, kinds.Card)
+	}
+	if payload.Note != nil {
+		found = append(found, kinds.Note)
+	}
+	if payload.File != nil {
+		found = append(found, kinds.File)
+	}
+	if payload.OTP != nil {
+		found = append(found, kinds.OTP)
+	}
+	if len(found) != 1 {
+		return "", fmt.Errorf("exactly one payload is required, got %d", len(found))
+	}
+	return found[0], nil
+}
+
+// checkLogin проверяет логин и пароль.
+func checkLogin(login *schema.LoginPayload, encrypted bool) error {
+	if login.Username == "" && login.Password == "" {
+		return errors.New("username or password is required")
+	}
+	if encrypted || login.URL == "" {
+		return nil
+	}
+
+	url, err := kinds.NormalizeURL(login.URL)
+	if err != nil {
+		return err
+	}
+	login.URL = url
+	return nil
+}
+
+// checkCard проверяет данные банковской карты.
+func checkCard(card *schema.CardPayload, encrypted bool) error {
+	if card.Number == "" || card.Expiry == "" {
+		return errors.New("card number and expiry date are required")
+	}
+	if encrypted {
+		return nil
+	}
+
+	number, err := kinds.NormalizeCardNumber(card.Number)
+	if err != nil {
+		return err
+	}
+	expiry, err := kinds.ParseExpiry(card.Expiry)
+	if err != nil {
+		return err
+	}
+	if card.CVV != "" {
+		if err := kinds.ValidateCVV(card.CVV); err != nil {
+			return err
+		}
+	}
+	card.Number = number
+	card.Expiry = expiry.String()
+	return nil
+}
+
+// checkOTP проверяет параметры одноразовых кодов и приводит их к единому виду.
+func checkOTP(payload *schema.OTPPayload, encrypted bool) error {
+	if payload.Secret == "" {
+		return errors.New("otp secret is required")
+	}
+	if encrypted {
+		return nil
+	}
+
+	key := otp.Key{Type: payload.Type, Issuer: payload.Issuer, Account: payload.Account, Secret: payload.Secret, Algorithm: payload.Algorithm}
+	var err error
+	if key.Digits, err = otp.ParseDigits(payload.Digits); err != nil {
+		return err
+	}
+	if key.Period, err = otp.ParsePeriod(payload.Period); err != nil {
+		return err
+	}
+	if key.Counter, err = otp.ParseCounter(payload.Counter); err != nil {
+		return err
+	}
+	if key, err = key.Normalize(); err != nil {
+		return err
+	}
+	payload.Type, payload.Secret, payload.Algorithm = key.Type, key.Secret, key.Algorithm
+	payload.Digits, payload.Period, payload.Counter = strconv.Itoa(key.Digits), "", ""
+	// период нужен только кодам TOTP, счетчик - только кодам HOTP
+	if key.Type == otp.HOTP {
+		payload.Counter = strconv.FormatUint(key.Counter, 10)
+	} else {
+		payload.Period = strconv.Itoa(key.Period)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package goph_test
+
+import (
+	"testing"
+
+	"github.com/bubu256/gophkeeper_pet/config"
+	"github.com/bubu256/gophkeeper_pet/internal/goph"
+	"github.com/bubu256/gophkeeper_pet/internal/schema"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dataKeeper - хранилище, запоминающее последние добавленные данные.
+type dataKeeper struct {
+	keeper.Keeper
+	saved *schema.MemoryCell
+}
+
+func (d *dataKeeper) AddData(infoCell schema.InfoCell, memoryCell *schema.MemoryCell) (int64, error) {
+	d.saved = memoryCell
+	return 1, nil
+}
+
+func TestSaveData_Validation(t *testing.T) {
+	storage := &dataKeeper{}
+	logic, err := goph.New(storage, config.ServerConfig{})
+	require.NoError(t, err)
+
+	newCell := func(kind string, encrypted bool, payload *schema.Payload) *schema.MemoryCell {
+		return &schema.MemoryCell{
+			InfoCell:  &schema.InfoCell{DataType: kind},
+			Encrypted: encrypted,
+			Payload:   payload,
+		}
+	}
+
+	// открытое содержимое приводится к единому виду
+	cell := newCell("card", false, &schema.Payload{Card: &schema.CardPayload{Number: "4111 1111 1111 1111", Expiry: "7/2027", CVV: "123"}})
+	_, err = logic.SaveData(1, cell)
+	require.NoError(t, err)
+	assert.Equal(t, "4111111111111111", storage.saved.Payload.Card.Number)
+	assert.Equal(t, "07/27", storage.saved.Payload.Card.Expiry)
+
+	cell = newCell("login", false, &schema.Payload{Login: &schema.LoginPayload{URL: "Example.com/", Username: "user"}})
+	_, err = logic.SaveData(1, cell)
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com", storage.saved.Payload.Login.URL)
+
+	cell = newCell("otp", false, &schema.Payload{OTP: &schema.OTPPayload{Secret: "jbsw y3dp ehpk 3pxp", Algorithm: "sha-256"}})
+	_, err = logic.SaveData(1, cell)
+	require.NoError(t, err)
+	assert.Equal(t, &schema.OTPPayload{Type: "totp", Secret: "JBSWY3DPEHPK3PXP", Algorithm: "SHA256", Digits: "6", Period: "30"}, storage.saved.Payload.OTP)
+
+	// зашифрованное содержимое проверяется только на наличие обязательных полей
+	cell = newCell("card", true, &schema.Payload{Card: &schema.CardPayload{Number: "ZW5jcnlwdGVk", Expiry: "ZW5jcnlwdGVk"}})
+	_, err = logic.SaveData(1, cell)
+	assert.NoError(t, err)
+
+	// данные без содержимого проверяются только по виду
+	_, err = logic.SaveData(1, newCell("note", true, nil))
+	assert.NoError(t, err)
+
+	invalid := map[string]*schema.MemoryCell{
+		"неизвестный вид":           newCell("string", true, nil),
+		"неверный номер карты":      newCell("card", false, &schema.Payload{Card: &schema.CardPayload{Number: "4111 1111 1111 1112", Expiry: "07/27"}}),
+		"неверный срок действия":    newCell("card", false, &schema.Payload{Card: &schema.CardPayload{Number: "4111111111111111", Expiry: "13/27"}}),
+		"неверный код безопасности": newCell("card", false, &schema.Payload{Card: &schema.CardPayload{Number: "4111111111111111", Expiry: "07/27", CVV: "12"}}),
+		"неверный адрес сайта":      newCell("login", false, &schema.Payload{Login: &schema.LoginPayload{URL: "https://", Password: "secret"}}),
+		"содержимое другого вида":   newCell("login", true, &schema.Payload{Note: &schema.NotePayload{Text: "text"}}),
+		"пустая заметка":            newCell("note", true, &schema.Payload{Note: &schema.NotePayload{}}),
+		"файл без имени":            newCell("file", true, &schema.Payload{File: &schema.FilePayload{}}),
+		"ключ без секрета":          newCell("otp", true, &schema.Payload{OTP: &schema.OTPPayload{Issuer: "GitHub"}}),
+		"неверный секрет":           newCell("otp", false, &schema.Payload{OTP: &schema.OTPPayload{Secret: "not base32!"}}),
+		"неверное количество цифр":  newCell("otp", false, &schema.Payload{OTP: &schema.OTPPayload{Secret: "JBSWY3DPEHPK3PXP", Digits: "7"}}),
+	}
+	for name, cell := range invalid {
+		_, err := logic.SaveData(1, cell)
+		assert.ErrorIs(t, err, goph.ErrInvalidData, name)
+	}
+}
@@ -0,0 +1,136 @@
+package goph
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bubu256/gophkeeper_pet/config"
+	"golang.org/x/crypto/argon2"
+)
+
+// Значения параметров Argon2id по умолчанию (рекомендации OWASP).
+const (
+	defaultArgon2Time    uint32 = 3
+	defaultArgon2Memory  uint32 = 64 * 1024
+	defaultArgon2Threads uint8  = 2
+	argon2SaltLength            = 16
+	argon2KeyLength             = 32
+)
+
+// ErrInvalidHash возвращается, если сохраненный хеш пароля имеет неизвестный формат.
+var ErrInvalidHash = errors.New("invalid password hash format")
+
+// PasswordParams - параметры Argon2id, используемые при хешировании паролей.
+type PasswordParams struct {
+	Time    uint32 // количество проходов
+	Memory  uint32 // объем памяти в КиБ
+	Threads uint8  // степень параллелизма
+}
+
+// PasswordParamsFromConfig возвращает параметры хеширования из конфигурации сервера.
+// Незаданные значения заменяются значениями по умолчанию.
+func PasswordParamsFromConfig(cfg config.ServerConfig) PasswordParams {
+	params := PasswordParams{
+		Time:    cfg.Argon2Time,
+		Memory:  cfg.Argon2Memory,
+		Threads: cfg.Argon2Threads,
+	}
+	if params.Time == 0 {
+		params.Time = defaultArgon2Time
+	}
+	if params.Memory == 0 {
+		params.Memory = defaultArgon2Memory
+	}
+	if params.Threads == 0 {
+		params.Threads = defaultArgon2Threads
+	}
+	return params
+}
+
+// HashPassword хеширует пароль функцией Argon2id со случайной солью.
+// Результат записывается в формате PHC: $argon2id$v=19$m=65536,t=3,p=2$<соль>$<хеш>.
+func HashPassword(password string, params PasswordParams) (string, error) {
+	salt := make([]byte, argon2SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(password), salt, params.Time, params.Memory, params.Threads, argon2KeyLength)
+
+	return fmt.Sprintf(
+		"$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version,
+		params.Memory,
+		params.Time,
+		params.Threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// VerifyPassword сверяет пароль с сохраненным хешем.
+// Помимо результата проверки возвращает признак того, что хеш следует пересчитать:
+// хеш получен устаревшим SHA-256 или с параметрами, отличающимися от params.
+func VerifyPassword(password, encodedHash string, params PasswordParams) (match bool, needsRehash bool, err error) {
+	// Хеши без префикса PHC получены устаревшей функцией HashPasswordSHA256
+	if !strings.HasPrefix(encodedHash, "$") {
+		legacy := HashPasswordSHA256(password)
+		match = subtle.ConstantTimeCompare([]byte(legacy), []byte(encodedHash)) == 1
+		return match, match, nil
+	}
+
+	hashParams, salt, key, err := decodeHash(encodedHash)
+	if err != nil {
+		return false, false, err
+	}
+
+	otherKey := argon2.IDKey([]byte(password), salt, hashParams.Time, hashParams.Memory, hashParams.Threads, uint32(len(key)))
+	if subtle.ConstantTimeCompare(key, otherKey) != 1 {
+		return false, false, nil
+	}
+
+	return true, hashParams != params, nil
+}
+
+// decodeHash разбирает хеш в формате PHC.
+func decodeHash(encodedHash string) (params PasswordParams, salt, key []byte, err error) {
+	parts := strings.Split(encodedHash, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return params, nil, nil, ErrInvalidHash
+	}
+
+	var version int
+	if _, err = fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return params, nil, nil, ErrInvalidHash
+	}
+	if version != argon2.Version {
+		return params, nil, nil, fmt.Errorf("%w: unsupported argon2 version %d", ErrInvalidHash, version)
+	}
+
+	if _, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Time, &params.Threads); err != nil {
+		return params, nil, nil, ErrInvalidHash
+	}
+
+	salt, err = base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, ErrInvalidHash
+	}
+	key, err = base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return params, nil, nil, ErrInvalidHash
+	}
+
+	return params, salt, key, nil
+}
+
+// HashPasswordSHA256 - устаревшая схема хеширования пароля без соли.
+// Используется только для проверки паролей пользователей, зарегистрированных до перехода на Argon2id.
+func HashPasswordSHA256(password string) string {
+	hashedPassword := sha256.Sum256([]byte(password))
+	return base64.URLEncoding.EncodeToString(hashedPassword[:])
+}
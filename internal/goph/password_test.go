@@ -0,0 +1,76 @@
+package goph_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bubu256/gophkeeper_pet/config"
+	"github.com/bubu256/gophkeeper_pet/internal/goph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testParams - облегченные параметры Argon2id, чтобы тесты выполнялись быстро.
+var testParams = goph.PasswordParams{Time: 1, Memory: 1024, Threads: 1}
+
+func TestHashPassword(t *testing.T) {
+	hash, err := goph.HashPassword("password", testParams)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$"))
+
+	// Одинаковые пароли дают разные хеши из-за случайной соли
+	other, err := goph.HashPassword("password", testParams)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, other)
+}
+
+func TestVerifyPassword(t *testing.T) {
+	hash, err := goph.HashPassword("password", testParams)
+	require.NoError(t, err)
+
+	match, needsRehash, err := goph.VerifyPassword("password", hash, testParams)
+	assert.NoError(t, err)
+	assert.True(t, match)
+	assert.False(t, needsRehash)
+
+	match, _, err = goph.VerifyPassword("wrong", hash, testParams)
+	assert.NoError(t, err)
+	assert.False(t, match)
+}
+
+func TestVerifyPassword_ParamsChanged(t *testing.T) {
+	hash, err := goph.HashPassword("password", testParams)
+	require.NoError(t, err)
+
+	stronger := goph.PasswordParams{Time: 2, Memory: 1024, Threads: 1}
+	match, needsRehash, err := goph.VerifyPassword("password", hash, stronger)
+	assert.NoError(t, err)
+	assert.True(t, match)
+	assert.True(t, needsRehash)
+}
+
+func TestVerifyPassword_Legacy(t *testing.T) {
+	legacy := goph.HashPasswordSHA256("password")
+
+	match, needsRehash, err := goph.VerifyPassword("password", legacy, testParams)
+	assert.NoError(t, err)
+	assert.True(t, match)
+	assert.True(t, needsRehash)
+
+	match, needsRehash, err = goph.VerifyPassword("wrong", legacy, testParams)
+	assert.NoError(t, err)
+	assert.False(t, match)
+	assert.False(t, needsRehash)
+}
+
+func TestVerifyPassword_InvalidHash(t *testing.T) {
+	_, _, err := goph.VerifyPassword("password", "$argon2id$v=19$broken", testParams)
+	assert.ErrorIs(t, err, goph.ErrInvalidHash)
+}
+
+func TestPasswordParamsFromConfig(t *testing.T) {
+	params := goph.PasswordParamsFromConfig(config.ServerConfig{Argon2Time: 4})
+	assert.Equal(t, uint32(4), params.Time)
+	assert.Equal(t, uint32(64*1024), params.Memory)
+	assert.Equal(t, uint8(2), params.Threads)
+}
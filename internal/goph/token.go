@@ -0,0 +1,153 @@
+package goph
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrInvalidToken возвращается для поддельных, просроченных или отозванных токенов.
+var ErrInvalidToken = errors.New("invalid token")
+
+// TokenClaims - содержимое токена доступа.
+type TokenClaims struct {
+	UserID    int64  `json:"uid"`
+	SessionID string `json:"sid"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// Tokens - пара токенов, выдаваемая при входе и обновлении сессии.
+type Tokens struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresAt    time.Time
+}
+
+// ParseSigningKeys разбирает список ключей подписи токенов в формате "kid1:hexkey1,kid2:hexkey2".
+// Возвращает ключи и идентификатор первого ключа в списке.
+func ParseSigningKeys(spec string) (map[string][]byte, string, error) {
+	keys := make(map[string][]byte)
+	var firstKeyID string
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		keyID, hexKey, found := strings.Cut(entry, ":")
+		if !found || keyID == "" || strings.Contains(keyID, ".") {
+			return nil, "", fmt.Errorf("invalid signing key entry %q", entry)
+		}
+		key, err := hex.DecodeString(hexKey)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid signing key %q: %w", keyID, err)
+		}
+		if len(key) < 32 {
+			return nil, "", fmt.Errorf("signing key %q is shorter than 32 bytes", keyID)
+		}
+		if _, ok := keys[keyID]; ok {
+			return nil, "", fmt.Errorf("duplicate signing key %q", keyID)
+		}
+		keys[keyID] = key
+		if firstKeyID == "" {
+			firstKeyID = keyID
+		}
+	}
+	if len(keys) == 0 {
+		return nil, "", errors.New("no signing keys")
+	}
+	return keys, firstKeyID, nil
+}
+
+// signToken - формирует токен вида <kid>.<payload>.<signature> из данных claims.
+func signToken(keyID string, key []byte, claims TokenClaims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal token claims: %w", err)
+	}
+	signed := keyID + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(signed))
+	return signed + "." + base64.RawURLEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// verifyToken - проверяет подпись и срок действия токена и возвращает его содержимое.
+// Ключ подписи выбирается по идентификатору kid из токена, поэтому при ротации
+// ранее выданные токены остаются действительными, пока старый ключ есть в списке.
+func verifyToken(keys map[string][]byte, token string, now time.Time) (*TokenClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken
+	}
+	key, ok := keys[parts[0]]
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+
+	// строгое декодирование: иначе биты выравнивания в последнем символе не проверяются,
+	// и несколько разных строк проходят проверку как один и тот же токен
+	signature, err := base64.RawURLEncoding.Strict().DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, h.Sum(nil)) {
+		return nil, ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.Strict().DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	claims := &TokenClaims{}
+	if err := json.Unmarshal(payload, claims); err != nil {
+		return nil, ErrInvalidToken
+	}
+	if now.Unix() >= claims.ExpiresAt {
+		return nil, ErrInvalidToken
+	}
+
+	return claims, nil
+}
+
+// newRefreshToken - генерирует токен обновления вида <sessionID>.<secret> и хеш его секретной части.
+func newRefreshToken(sessionID string) (token string, hash string, err error) {
+	secret, err := randomString(32)
+	if err != nil {
+		return "", "", err
+	}
+	return sessionID + "." + secret, hashRefreshSecret(secret), nil
+}
+
+// splitRefreshToken - разделяет токен обновления на идентификатор сессии и секрет.
+func splitRefreshToken(token string) (sessionID, secret string, err error) {
+	sessionID, secret, found := strings.Cut(token, ".")
+	if !found || sessionID == "" || secret == "" {
+		return "", "", ErrInvalidToken
+	}
+	return sessionID, secret, nil
+}
+
+// hashRefreshSecret - хеш секрета токена обновления, хранимый в базе данных.
+func hashRefreshSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// randomString - возвращает n случайных байт в кодировке base64url.
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,102 @@
+package goph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/bubu256/gophkeeper_pet/internal/schema"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper"
+)
+
+// Параметры корзины по умолчанию.
+const (
+	defaultTrashRetention = 30 * 24 * time.Hour
+	defaultPurgeInterval  = time.Hour
+)
+
+// ListTrash возвращает информацию о данных пользователя в корзине.
+func (g *GophLogic) ListTrash(userID int64) ([]*schema.InfoCell, error) {
+	infoCells, err := g.keeper.GetTrash(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve trash: %w", err)
+	}
+
+	return infoCells, nil
+}
+
+// RestoreFromTrash восстанавливает данные пользователя из корзины.
+// Если хотя бы одних данных нет в корзине пользователя, ничего не восстанавливается и возвращается ErrAccessDenied.
+func (g *GophLogic) RestoreFromTrash(userID int64, infoIDs []int64) error {
+	if len(infoIDs) == 0 {
+		return errors.New("no data")
+	}
+
+	trash, err := g.keeper.GetTrash(userID)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve trash: %w", err)
+	}
+	inTrash := make(map[int64]struct{}, len(trash))
+	for _, infoCell := range trash {
+		inTrash[infoCell.ID] = struct{}{}
+	}
+	for _, id := range infoIDs {
+		if _, ok := inTrash[id]; !ok {
+			return ErrAccessDenied
+		}
+	}
+
+	err = g.keeper.RestoreData(infoIDs)
+	if errors.Is(err, keeper.ErrNotFound) {
+		return ErrAccessDenied
+	}
+	if err != nil {
+		return fmt.Errorf("failed to restore data: %w", err)
+	}
+
+	return nil
+}
+
+// EmptyTrash окончательно удаляет данные пользователя из корзины и возвращает количество удаленных данных.
+func (g *GophLogic) EmptyTrash(userID int64) (int64, error) {
+	deleted, err := g.keeper.EmptyTrash(userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to empty trash: %w", err)
+	}
+
+	return deleted, nil
+}
+
+// PurgeTrash окончательно удаляет данные, которые находятся в корзине дольше срока хранения на момент now.
+func (g *GophLogic) PurgeTrash(now time.Time) (int64, error) {
+	purged, err := g.keeper.PurgeTrash(now.Add(-g.trashRetention))
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge trash: %w", err)
+	}
+
+	return purged, nil
+}
+
+// RunPurger периодически очищает корзины пользователей от данных старше срока хранения.
+// Первая очистка выполняется сразу. Работает до отмены ctx.
+func (g *GophLogic) RunPurger(ctx context.Context) {
+	ticker := time.NewTicker(g.purgeInterval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := g.PurgeTrash(time.Now())
+		if err != nil {
+			log.Printf("trash purge failed: %v", err)
+		} else if purged > 0 {
+			log.Printf("purged %d items from trash", purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
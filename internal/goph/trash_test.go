@@ -0,0 +1,69 @@
+package goph_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bubu256/gophkeeper_pet/config"
+	"github.com/bubu256/gophkeeper_pet/internal/goph"
+	"github.com/bubu256/gophkeeper_pet/internal/schema"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// trashKeeper - корзина в памяти: данные с InfoID 1 и 2 в корзине пользователя 1.
+type trashKeeper struct {
+	keeper.Keeper
+	restored     []int64
+	purgedBefore time.Time
+}
+
+func (k *trashKeeper) GetTrash(userID int64) ([]*schema.InfoCell, error) {
+	if userID != 1 {
+		return nil, nil
+	}
+	deletedAt := time.Now()
+	return []*schema.InfoCell{
+		{ID: 1, OwnerID: 1, DeletedAt: &deletedAt},
+		{ID: 2, OwnerID: 1, DeletedAt: &deletedAt},
+	}, nil
+}
+
+func (k *trashKeeper) RestoreData(infoIDs []int64) error {
+	k.restored = append(k.restored, infoIDs...)
+	return nil
+}
+
+func (k *trashKeeper) PurgeTrash(before time.Time) (int64, error) {
+	k.purgedBefore = before
+	return 1, nil
+}
+
+func TestRestoreFromTrash(t *testing.T) {
+	storage := &trashKeeper{}
+	logic, err := goph.New(storage, config.ServerConfig{})
+	require.NoError(t, err)
+
+	require.NoError(t, logic.RestoreFromTrash(1, []int64{2}))
+	assert.Equal(t, []int64{2}, storage.restored)
+
+	// данные вне корзины пользователя не восстанавливаются, даже если часть из них в корзине
+	err = logic.RestoreFromTrash(1, []int64{1, 3})
+	assert.ErrorIs(t, err, goph.ErrAccessDenied)
+	err = logic.RestoreFromTrash(2, []int64{1})
+	assert.ErrorIs(t, err, goph.ErrAccessDenied)
+	assert.Equal(t, []int64{2}, storage.restored)
+}
+
+func TestPurgeTrash(t *testing.T) {
+	storage := &trashKeeper{}
+	logic, err := goph.New(storage, config.ServerConfig{TrashRetention: 48 * time.Hour})
+	require.NoError(t, err)
+
+	now := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+	purged, err := logic.PurgeTrash(now)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+	assert.Equal(t, time.Date(2026, time.March, 8, 12, 0, 0, 0, time.UTC), storage.purgedBefore)
+}
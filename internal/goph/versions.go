@@ -0,0 +1,51 @@
+package goph
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bubu256/gophkeeper_pet/internal/schema"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper"
+)
+
+// ErrVersionNotFound возвращается, если запрошенной версии данных нет в истории.
+var ErrVersionNotFound = errors.New("version not found")
+
+// ListVersions возвращает предыдущие версии данных пользователя, начиная с последней.
+// Если данные не принадлежат пользователю, возвращает ErrAccessDenied.
+func (g *GophLogic) ListVersions(userID, infoID int64) ([]*schema.Version, error) {
+	if err := g.checkOwnership(userID, infoID); err != nil {
+		return nil, err
+	}
+
+	versions, err := g.keeper.GetVersions(infoID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve versions: %w", err)
+	}
+
+	return versions, nil
+}
+
+// RestoreVersion восстанавливает версию данных с указанной ревизией и возвращает новую ревизию данных.
+// Восстановление сохраняется как обычное изменение, поэтому текущая версия остается в истории.
+// Если данные не принадлежат пользователю, возвращает ErrAccessDenied, если версии нет - ErrVersionNotFound.
+func (g *GophLogic) RestoreVersion(userID, infoID, revision int64) (int64, error) {
+	if err := g.checkOwnership(userID, infoID); err != nil {
+		return 0, err
+	}
+
+	version, err := g.keeper.GetVersion(infoID, revision)
+	if errors.Is(err, keeper.ErrNotFound) {
+		return 0, ErrVersionNotFound
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to retrieve version: %w", err)
+	}
+
+	memoryCell := version.Cell
+	if err := g.UpdateData(userID, memoryCell, 0); err != nil {
+		return 0, err
+	}
+
+	return memoryCell.InfoCell.Revision, nil
+}
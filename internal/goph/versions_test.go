@@ -0,0 +1,86 @@
+package goph_test
+
+import (
+	"testing"
+
+	"github.com/bubu256/gophkeeper_pet/config"
+	"github.com/bubu256/gophkeeper_pet/internal/goph"
+	"github.com/bubu256/gophkeeper_pet/internal/schema"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// versionKeeper - хранилище с историей версий данных с InfoID 1, принадлежащих пользователю 1.
+type versionKeeper struct {
+	keeper.Keeper
+	versions []*schema.Version
+	updated  *schema.MemoryCell
+	revision int64
+}
+
+func (v *versionKeeper) GetUserDataInfo(userID int64) ([]*schema.InfoCell, error) {
+	if userID != 1 {
+		return nil, nil
+	}
+	return []*schema.InfoCell{{ID: 1, OwnerID: 1}}, nil
+}
+
+func (v *versionKeeper) GetVersions(infoID int64) ([]*schema.Version, error) {
+	return v.versions, nil
+}
+
+func (v *versionKeeper) GetVersion(infoID, revision int64) (*schema.Version, error) {
+	for _, version := range v.versions {
+		if version.Revision == revision {
+			return version, nil
+		}
+	}
+	return nil, keeper.ErrNotFound
+}
+
+func (v *versionKeeper) GetBlob(infoID int64) (*schema.Blob, error) {
+	return nil, keeper.ErrNotFound
+}
+
+func (v *versionKeeper) UpdateData(infoCell schema.InfoCell, memoryCell schema.MemoryCell, expectedRevision int64) (int64, error) {
+	v.updated = &memoryCell
+	v.revision++
+	return v.revision, nil
+}
+
+func TestRestoreVersion(t *testing.T) {
+	storage := &versionKeeper{
+		revision: 5,
+		versions: []*schema.Version{
+			{Revision: 3, Cell: &schema.MemoryCell{
+				InfoCell:      &schema.InfoCell{ID: 1, DataType: "note", Description: "old", Revision: 3},
+				Encrypted:     true,
+				KeyValuePairs: map[string]string{"key": "old"},
+			}},
+		},
+	}
+	logic, err := goph.New(storage, config.ServerConfig{})
+	require.NoError(t, err)
+
+	versions, err := logic.ListVersions(1, 1)
+	require.NoError(t, err)
+	assert.Len(t, versions, 1)
+
+	// восстановленная версия сохраняется как новое изменение
+	revision, err := logic.RestoreVersion(1, 1, 3)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), revision)
+	require.NotNil(t, storage.updated)
+	assert.Equal(t, "old", storage.updated.InfoCell.Description)
+	assert.Equal(t, map[string]string{"key": "old"}, storage.updated.KeyValuePairs)
+
+	_, err = logic.RestoreVersion(1, 1, 4)
+	assert.ErrorIs(t, err, goph.ErrVersionNotFound)
+
+	// чужие данные
+	_, err = logic.ListVersions(2, 1)
+	assert.ErrorIs(t, err, goph.ErrAccessDenied)
+	_, err = logic.RestoreVersion(2, 1, 3)
+	assert.ErrorIs(t, err, goph.ErrAccessDenied)
+}
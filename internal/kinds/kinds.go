@@ -0,0 +1,221 @@
+// Package kinds - описывает виды сохраняемых данных и проверку их содержимого.
+// Используется и сервером, и клиентом, чтобы правила проверки совпадали.
+package kinds
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Виды данных.
+const (
+	Login = "login"
+	Card  = "card"
+	Note  = "note"
+	File  = "file"
+	// OTP - параметры генерации одноразовых кодов двухфакторной аутентификации (TOTP и HOTP).
+	OTP = "otp"
+)
+
+// All - все виды данных в порядке вывода пользователю.
+var All = []string{Login, Card, Note, File, OTP}
+
+// Ошибки проверки данных.
+var (
+	ErrUnknownKind       = errors.New("unknown data kind")
+	ErrInvalidCardNumber = errors.New("invalid card number")
+	ErrInvalidExpiry     = errors.New("invalid card expiry date")
+	ErrInvalidCVV        = errors.New("invalid card security code")
+	ErrInvalidURL        = errors.New("invalid url")
+)
+
+// aliases - другие названия видов данных, которые пользователи вводили до появления видов.
+var aliases = map[string]string{
+	"credentials": Login,
+	"password":    Login,
+	"логин":       Login,
+	"пароль":      Login,
+	"bank":        Card,
+	"bank card":   Card,
+	"bankcard":    Card,
+	"карта":       Card,
+	"text":        Note,
+	"заметка":     Note,
+	"текст":       Note,
+	"binary":      File,
+	"файл":        File,
+	"totp":        OTP,
+	"hotp":        OTP,
+	"2fa":         OTP,
+	"otpauth":     OTP,
+}
+
+// Parse - возвращает вид данных по названию без учета регистра, в том числе по устаревшим названиям.
+func Parse(name string) (string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for _, kind := range All {
+		if name == kind {
+			return kind, nil
+		}
+	}
+	if kind, ok := aliases[name]; ok {
+		return kind, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownKind, name)
+}
+
+// Valid - сообщает, что kind - один из видов данных.
+func Valid(kind string) bool {
+	for _, k := range All {
+		if kind == k {
+			return true
+		}
+	}
+	return false
+}
+
+// Luhn - проверяет контрольную цифру номера по алгоритму Луна.
+func Luhn(number string) bool {
+	if number == "" {
+		return false
+	}
+	sum := 0
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		d := int(number[i] - '0')
+		if d < 0 || d > 9 {
+			return false
+		}
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// NormalizeCardNumber - удаляет из номера карты пробелы и дефисы и проверяет его длину и контрольную цифру.
+func NormalizeCardNumber(number string) (string, error) {
+	number = strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' {
+			return -1
+		}
+		return r
+	}, number)
+	if len(number) < 12 || len(number) > 19 || !Luhn(number) {
+		return "", ErrInvalidCardNumber
+	}
+	return number, nil
+}
+
+// Expiry - срок действия карты: последний месяц, в котором карта действует.
+type Expiry struct {
+	Year  int
+	Month time.Month
+}
+
+// ParseExpiry - разбирает срок действия карты в форматах MM/YY, MM/YYYY, MM-YY и MMYY.
+func ParseExpiry(s string) (Expiry, error) {
+	s = strings.TrimSpace(s)
+	var month, year string
+	switch {
+	case strings.ContainsAny(s, "/-"):
+		parts := strings.FieldsFunc(s, func(r rune) bool { return r == '/' || r == '-' })
+		if len(parts) != 2 {
+			return Expiry{}, ErrInvalidExpiry
+		}
+		month, year = parts[0], parts[1]
+	case len(s) == 4:
+		month, year = s[:2], s[2:]
+	default:
+		return Expiry{}, ErrInvalidExpiry
+	}
+
+	m, err := strconv.Atoi(strings.TrimSpace(month))
+	if err != nil || m < 1 || m > 12 {
+		return Expiry{}, ErrInvalidExpiry
+	}
+	year = strings.TrimSpace(year)
+	y, err := strconv.Atoi(year)
+	if err != nil {
+		return Expiry{}, ErrInvalidExpiry
+	}
+	switch len(year) {
+	case 2:
+		y += 2000
+	case 4:
+	default:
+		return Expiry{}, ErrInvalidExpiry
+	}
+
+	return Expiry{Year: y, Month: time.Month(m)}, nil
+}
+
+// String - возвращает срок действия в формате MM/YY.
+func (e Expiry) String() string {
+	return fmt.Sprintf("%02d/%02d", int(e.Month), e.Year%100)
+}
+
+// End - возвращает момент окончания срока действия карты: начало следующего месяца.
+func (e Expiry) End(location *time.Location) time.Time {
+	return time.Date(e.Year, e.Month+1, 1, 0, 0, 0, 0, location)
+}
+
+// Expired - сообщает, что срок действия карты закончился к моменту now.
+func (e Expiry) Expired(now time.Time) bool {
+	return !now.Before(e.End(now.Location()))
+}
+
+// ValidateCVV - проверяет, что код безопасности карты состоит из 3 или 4 цифр.
+func ValidateCVV(cvv string) error {
+	if len(cvv) < 3 || len(cvv) > 4 {
+		return ErrInvalidCVV
+	}
+	if _, err := strconv.Atoi(cvv); err != nil {
+		return ErrInvalidCVV
+	}
+	return nil
+}
+
+// NormalizeURL - приводит адрес сайта к единому виду: добавляет схему https, если она не указана,
+// переводит схему и хост в нижний регистр, удаляет порт по умолчанию и завершающий слеш.
+func NormalizeURL(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", ErrInvalidURL
+	}
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" || strings.ContainsAny(u.Host, " ") {
+		return "", ErrInvalidURL
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	host, port := strings.ToLower(u.Hostname()), u.Port()
+	if (u.Scheme == "https" && port == "443") || (u.Scheme == "http" && port == "80") {
+		port = ""
+	}
+	if port != "" {
+		host = net.JoinHostPort(host, port)
+	} else if strings.Contains(host, ":") {
+		// IPv6 адрес без порта
+		host = "[" + host + "]"
+	}
+	u.Host = host
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	u.RawPath = ""
+	u.Fragment = ""
+
+	return u.String(), nil
+}
@@ -0,0 +1,98 @@
+package kinds_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bubu256/gophkeeper_pet/internal/kinds"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := map[string]string{
+		"login":     kinds.Login,
+		"Card":      kinds.Card,
+		" BANK ":    kinds.Card,
+		"bank card": kinds.Card,
+		"Заметка":   kinds.Note,
+		"binary":    kinds.File,
+		"TOTP":      kinds.OTP,
+		"2fa":       kinds.OTP,
+	}
+	for name, want := range tests {
+		kind, err := kinds.Parse(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, want, kind, name)
+	}
+
+	_, err := kinds.Parse("string")
+	assert.ErrorIs(t, err, kinds.ErrUnknownKind)
+}
+
+func TestNormalizeCardNumber(t *testing.T) {
+	number, err := kinds.NormalizeCardNumber("4111 1111-1111 1111")
+	require.NoError(t, err)
+	assert.Equal(t, "4111111111111111", number)
+
+	// неверная контрольная цифра, слишком короткий номер и посторонние символы
+	for _, invalid := range []string{"4111 1111 1111 1112", "4111", "4111 1111 1111 111a"} {
+		_, err := kinds.NormalizeCardNumber(invalid)
+		assert.ErrorIs(t, err, kinds.ErrInvalidCardNumber, invalid)
+	}
+}
+
+func TestParseExpiry(t *testing.T) {
+	for _, s := range []string{"07/27", "07/2027", "7-27", "0727"} {
+		expiry, err := kinds.ParseExpiry(s)
+		require.NoError(t, err, s)
+		assert.Equal(t, kinds.Expiry{Year: 2027, Month: time.July}, expiry, s)
+		assert.Equal(t, "07/27", expiry.String())
+	}
+
+	for _, s := range []string{"", "13/27", "00/27", "07/227", "july 27", "07/27/01"} {
+		_, err := kinds.ParseExpiry(s)
+		assert.ErrorIs(t, err, kinds.ErrInvalidExpiry, s)
+	}
+}
+
+func TestExpiryExpired(t *testing.T) {
+	expiry := kinds.Expiry{Year: 2026, Month: time.February}
+
+	// карта действует до конца последнего месяца
+	assert.False(t, expiry.Expired(time.Date(2026, time.February, 28, 23, 59, 0, 0, time.UTC)))
+	assert.True(t, expiry.Expired(time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)))
+
+	// декабрь переходит на следующий год
+	expiry = kinds.Expiry{Year: 2026, Month: time.December}
+	assert.False(t, expiry.Expired(time.Date(2026, time.December, 31, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, expiry.Expired(time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC), expiry.End(time.UTC))
+}
+
+func TestValidateCVV(t *testing.T) {
+	assert.NoError(t, kinds.ValidateCVV("123"))
+	assert.NoError(t, kinds.ValidateCVV("1234"))
+	assert.ErrorIs(t, kinds.ValidateCVV("12"), kinds.ErrInvalidCVV)
+	assert.ErrorIs(t, kinds.ValidateCVV("12a"), kinds.ErrInvalidCVV)
+}
+
+func TestNormalizeURL(t *testing.T) {
+	tests := map[string]string{
+		"example.com":                    "https://example.com",
+		"HTTPS://Example.COM/":           "https://example.com",
+		"https://example.com:443/login/": "https://example.com/login",
+		"http://example.com:8080/a?b=c":  "http://example.com:8080/a?b=c",
+		"https://example.com/#section":   "https://example.com",
+	}
+	for raw, want := range tests {
+		normalized, err := kinds.NormalizeURL(raw)
+		require.NoError(t, err, raw)
+		assert.Equal(t, want, normalized, raw)
+	}
+
+	for _, raw := range []string{"", "https://", "exa mple.com"} {
+		_, err := kinds.NormalizeURL(raw)
+		assert.ErrorIs(t, err, kinds.ErrInvalidURL, raw)
+	}
+}
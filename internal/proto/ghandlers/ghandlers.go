@@ -3,7 +3,10 @@ package ghandlers
 
 import (
 	"context"
+	"errors"
+	"io"
 	"path/filepath"
+	"sort"
 
 	"github.com/bubu256/gophkeeper_pet/config"
 	"github.com/bubu256/gophkeeper_pet/internal/goph"
@@ -15,6 +18,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // HandlerService представляет собой структуру, реализующую интерфейсы сервера gRPC.
@@ -31,7 +35,10 @@ func New(logic goph.Goph, serverConfig config.ServerConfig) *grpc.Server {
 		cfg:        serverConfig,
 	}
 
-	server := grpc.NewServer(grpc.UnaryInterceptor(handler.tokenInterceptor))
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(handler.tokenInterceptor),
+		grpc.StreamInterceptor(handler.streamTokenInterceptor),
+	)
 	pb.RegisterGophKeeperServiceServer(server, handler)
 
 	return server
@@ -49,7 +56,7 @@ func (h *HandlerService) Register(ctx context.Context, request *pb.RegistrationR
 	}
 
 	// Создание нового пользователя
-	err = h.gophKeeper.CreateUser(request.Username, request.Password)
+	err = h.gophKeeper.CreateUser(request.Username, request.Password, request.Kdf)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create user: %v", err)
 	}
@@ -61,15 +68,94 @@ func (h *HandlerService) Register(ctx context.Context, request *pb.RegistrationR
 	return response, nil
 }
 
+// PreLogin реализует метод получения схемы, по которой клиент вычисляет пароль из мастер-пароля
+func (h *HandlerService) PreLogin(ctx context.Context, request *pb.PreLoginRequest) (*pb.PreLoginResponse, error) {
+	kdf, err := h.gophKeeper.GetClientKDF(request.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get login parameters: %v", err)
+	}
+
+	response := &pb.PreLoginResponse{
+		Kdf: kdf,
+	}
+
+	return response, nil
+}
+
+// ChangePassword реализует метод смены пароля пользователя
+func (h *HandlerService) ChangePassword(ctx context.Context, request *pb.ChangePasswordRequest) (*pb.ChangePasswordResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.gophKeeper.ChangePassword(userID, request.OldPassword, request.NewPassword, request.Kdf)
+	if errors.Is(err, goph.ErrInvalidCredentials) {
+		return nil, status.Errorf(codes.PermissionDenied, "Failed to change password: %v", err)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to change password: %v", err)
+	}
+
+	response := &pb.ChangePasswordResponse{
+		Success: true,
+	}
+
+	return response, nil
+}
+
 // Authenticate реализует метод аутентификации пользователя
 func (h *HandlerService) Authenticate(ctx context.Context, request *pb.AuthenticationRequest) (*pb.AuthenticationResponse, error) {
-	token, err := h.gophKeeper.Authenticate(request.Username, request.Password)
+	tokens, err := h.gophKeeper.Authenticate(request.Username, request.Password)
+	if errors.Is(err, goph.ErrInvalidCredentials) {
+		return nil, status.Errorf(codes.Unauthenticated, "Authentication failed: %v", err)
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Authentication failed: %v", err)
 	}
 
 	response := &pb.AuthenticationResponse{
-		Token: token,
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresAt:    timestamppb.New(tokens.ExpiresAt),
+	}
+
+	return response, nil
+}
+
+// RefreshToken реализует метод обновления токенов сессии пользователя
+func (h *HandlerService) RefreshToken(ctx context.Context, request *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
+	tokens, err := h.gophKeeper.RefreshToken(request.RefreshToken)
+	if errors.Is(err, goph.ErrInvalidToken) {
+		return nil, status.Errorf(codes.Unauthenticated, "Failed to refresh token: %v", err)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to refresh token: %v", err)
+	}
+
+	response := &pb.RefreshTokenResponse{
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresAt:    timestamppb.New(tokens.ExpiresAt),
+	}
+
+	return response, nil
+}
+
+// Logout реализует метод завершения текущей сессии или всех сессий пользователя
+func (h *HandlerService) Logout(ctx context.Context, request *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	token, ok := GetTokenFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "No token in context")
+	}
+
+	err := h.gophKeeper.Logout(token, request.AllDevices)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to logout: %v", err)
+	}
+
+	response := &pb.LogoutResponse{
+		Success: true,
 	}
 
 	return response, nil
@@ -91,40 +177,42 @@ func (h *HandlerService) Authorize(ctx context.Context, request *pb.Authorizatio
 
 // AddData реализует метод добавлению данных пользователя
 func (h *HandlerService) AddData(ctx context.Context, request *pb.AddDataRequest) (*pb.AddDataResponse, error) {
-	token, ok := GetTokenFromContext(ctx)
-	if !ok {
-		return nil, status.Errorf(codes.Unauthenticated, "No token in context")
-	}
-	id, err := h.gophKeeper.GetUserIDFromToken(token)
+	id, err := h.userIDFromContext(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Unsuccessful getting id from token %v", err)
+		return nil, err
+	}
+
+	if request.Data == nil || request.Data.Info == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Data is empty")
 	}
 
 	memoryCell := ConvertPBMemoryCellToSchema(request.Data)
 
 	infoId, err := h.gophKeeper.SaveData(id, memoryCell)
+	if errors.Is(err, goph.ErrInvalidData) {
+		return nil, status.Errorf(codes.InvalidArgument, "Failed to save data: %v", err)
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Failed to save data %v", err)
 	}
 	response := &pb.AddDataResponse{
-		Id: infoId,
+		Id:       infoId,
+		Revision: memoryCell.InfoCell.Revision,
 	}
 	return response, nil
 }
 
 // GetInformation реализует метод получения метаинформации о данных пользователя
 func (h *HandlerService) GetInformation(ctx context.Context, request *pb.GetInformationRequest) (*pb.GetInformationResponse, error) {
-
-	token, ok := GetTokenFromContext(ctx)
-	if !ok {
-		return nil, status.Errorf(codes.Unauthenticated, "No token in context")
-	}
-	userID, err := h.gophKeeper.GetUserIDFromToken(token)
+	userID, err := h.userIDFromContext(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Unsuccessful getting id from token %v", err)
+		return nil, err
 	}
 
-	info, err := h.gophKeeper.GetUserDataInfo(userID)
+	info, nextPageToken, err := h.gophKeeper.QueryDataInfo(userID, convertPBInfoQuery(request))
+	if errors.Is(err, goph.ErrInvalidData) || errors.Is(err, goph.ErrInvalidPageToken) {
+		return nil, status.Errorf(codes.InvalidArgument, "Failed to get information: %v", err)
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Failed to get information: %v", err)
 	}
@@ -135,7 +223,8 @@ func (h *HandlerService) GetInformation(ctx context.Context, request *pb.GetInfo
 	}
 
 	response := &pb.GetInformationResponse{
-		Info: pbInfo,
+		Info:          pbInfo,
+		NextPageToken: nextPageToken,
 	}
 
 	return response, nil
@@ -143,13 +232,9 @@ func (h *HandlerService) GetInformation(ctx context.Context, request *pb.GetInfo
 
 // RetrieveData реализует метод получения данных пользователя.
 func (h *HandlerService) RetrieveData(ctx context.Context, request *pb.RetrieveDataRequest) (*pb.RetrieveDataResponse, error) {
-	token, ok := GetTokenFromContext(ctx)
-	if !ok {
-		return nil, status.Errorf(codes.Unauthenticated, "No token in context")
-	}
-	usedID, err := h.gophKeeper.GetUserIDFromToken(token)
+	usedID, err := h.userIDFromContext(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Unsuccessful getting id from token %v", err)
+		return nil, err
 	}
 
 	infoIDs := request.Ids
@@ -171,6 +256,478 @@ func (h *HandlerService) RetrieveData(ctx context.Context, request *pb.RetrieveD
 	return response, nil
 }
 
+// SyncSince - возвращает данные пользователя, измененные после указанной ревизии, и InfoID удаленных данных
+func (h *HandlerService) SyncSince(ctx context.Context, request *pb.SyncSinceRequest) (*pb.SyncSinceResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := h.gophKeeper.SyncSince(userID, request.Revision)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve changes: %v", err)
+	}
+
+	changed := make([]*pb.MemoryCell, len(changes.Changed))
+	for i, cell := range changes.Changed {
+		changed[i] = ConvertSchemaMemoryCellToPB(cell)
+	}
+
+	response := &pb.SyncSinceResponse{
+		Revision:   changes.Revision,
+		Changed:    changed,
+		DeletedIds: changes.DeletedIDs,
+	}
+
+	return response, nil
+}
+
+// ListVersions реализует метод получения предыдущих версий данных пользователя
+func (h *HandlerService) ListVersions(ctx context.Context, request *pb.ListVersionsRequest) (*pb.ListVersionsResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := h.gophKeeper.ListVersions(userID, request.InfoId)
+	if errors.Is(err, goph.ErrAccessDenied) {
+		return nil, status.Errorf(codes.PermissionDenied, "Failed to retrieve versions: %v", err)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve versions: %v", err)
+	}
+
+	response := &pb.ListVersionsResponse{Versions: make([]*pb.Version, len(versions))}
+	for i, version := range versions {
+		response.Versions[i] = &pb.Version{
+			Revision:   version.Revision,
+			ReplacedAt: timestamppb.New(version.ReplacedAt),
+			Data:       ConvertSchemaMemoryCellToPB(version.Cell),
+		}
+	}
+	return response, nil
+}
+
+// RestoreVersion реализует метод восстановления предыдущей версии данных пользователя
+func (h *HandlerService) RestoreVersion(ctx context.Context, request *pb.RestoreVersionRequest) (*pb.RestoreVersionResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	revision, err := h.gophKeeper.RestoreVersion(userID, request.InfoId, request.Revision)
+	switch {
+	case errors.Is(err, goph.ErrAccessDenied):
+		return nil, status.Errorf(codes.PermissionDenied, "Failed to restore version: %v", err)
+	case errors.Is(err, goph.ErrVersionNotFound):
+		return nil, status.Errorf(codes.NotFound, "Failed to restore version: %v", err)
+	case errors.Is(err, goph.ErrInvalidData):
+		return nil, status.Errorf(codes.InvalidArgument, "Failed to restore version: %v", err)
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "Failed to restore version: %v", err)
+	}
+
+	return &pb.RestoreVersionResponse{Revision: revision}, nil
+}
+
+// ListTrash реализует метод получения информации о данных пользователя в корзине
+func (h *HandlerService) ListTrash(ctx context.Context, request *pb.ListTrashRequest) (*pb.ListTrashResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	trash, err := h.gophKeeper.ListTrash(userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve trash: %v", err)
+	}
+
+	response := &pb.ListTrashResponse{Info: make([]*pb.InfoCell, len(trash))}
+	for i, infoCell := range trash {
+		response.Info[i] = ConvertSchemaInfoCellToPB(infoCell)
+	}
+	return response, nil
+}
+
+// RestoreFromTrash реализует метод восстановления данных пользователя из корзины
+func (h *HandlerService) RestoreFromTrash(ctx context.Context, request *pb.RestoreFromTrashRequest) (*pb.RestoreFromTrashResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(request.Ids) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Ids are empty")
+	}
+
+	err = h.gophKeeper.RestoreFromTrash(userID, request.Ids)
+	if errors.Is(err, goph.ErrAccessDenied) {
+		return nil, status.Errorf(codes.PermissionDenied, "Failed to restore data: %v", err)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to restore data: %v", err)
+	}
+
+	return &pb.RestoreFromTrashResponse{Success: true}, nil
+}
+
+// EmptyTrash реализует метод окончательного удаления данных пользователя из корзины
+func (h *HandlerService) EmptyTrash(ctx context.Context, request *pb.EmptyTrashRequest) (*pb.EmptyTrashResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := h.gophKeeper.EmptyTrash(userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to empty trash: %v", err)
+	}
+
+	return &pb.EmptyTrashResponse{Deleted: deleted}, nil
+}
+
+// ListFolders реализует метод получения папок пользователя
+func (h *HandlerService) ListFolders(ctx context.Context, request *pb.ListFoldersRequest) (*pb.ListFoldersResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	folders, err := h.gophKeeper.ListFolders(userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve folders: %v", err)
+	}
+
+	response := &pb.ListFoldersResponse{Folders: make([]*pb.Folder, len(folders))}
+	for i, folder := range folders {
+		response.Folders[i] = &pb.Folder{Id: folder.ID, ParentId: folder.ParentID, Name: folder.Name}
+	}
+	return response, nil
+}
+
+// CreateFolder реализует метод создания папки пользователя
+func (h *HandlerService) CreateFolder(ctx context.Context, request *pb.CreateFolderRequest) (*pb.CreateFolderResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := h.gophKeeper.CreateFolder(userID, request.Name, request.ParentId)
+	if err != nil {
+		return nil, folderError("Failed to create folder", err)
+	}
+
+	return &pb.CreateFolderResponse{Id: id}, nil
+}
+
+// RenameFolder реализует метод переименования папки пользователя
+func (h *HandlerService) RenameFolder(ctx context.Context, request *pb.RenameFolderRequest) (*pb.RenameFolderResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.gophKeeper.RenameFolder(userID, request.Id, request.Name); err != nil {
+		return nil, folderError("Failed to rename folder", err)
+	}
+
+	return &pb.RenameFolderResponse{Success: true}, nil
+}
+
+// MoveFolder реализует метод перемещения папки пользователя
+func (h *HandlerService) MoveFolder(ctx context.Context, request *pb.MoveFolderRequest) (*pb.MoveFolderResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.gophKeeper.MoveFolder(userID, request.Id, request.ParentId); err != nil {
+		return nil, folderError("Failed to move folder", err)
+	}
+
+	return &pb.MoveFolderResponse{Success: true}, nil
+}
+
+// MoveData реализует метод перемещения данных пользователя в папку
+func (h *HandlerService) MoveData(ctx context.Context, request *pb.MoveDataRequest) (*pb.MoveDataResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(request.Ids) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Ids are empty")
+	}
+
+	if err := h.gophKeeper.MoveData(userID, request.Ids, request.FolderId); err != nil {
+		return nil, folderError("Failed to move data", err)
+	}
+
+	return &pb.MoveDataResponse{Success: true}, nil
+}
+
+// TagData реализует метод добавления и снятия меток данных пользователя
+func (h *HandlerService) TagData(ctx context.Context, request *pb.TagDataRequest) (*pb.TagDataResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(request.Ids) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Ids are empty")
+	}
+
+	if err := h.gophKeeper.TagData(userID, request.Ids, request.Add, request.Remove); err != nil {
+		return nil, folderError("Failed to tag data", err)
+	}
+
+	return &pb.TagDataResponse{Success: true}, nil
+}
+
+// ListTags реализует метод получения меток пользователя
+func (h *HandlerService) ListTags(ctx context.Context, request *pb.ListTagsRequest) (*pb.ListTagsResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := h.gophKeeper.ListTags(userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve tags: %v", err)
+	}
+
+	response := &pb.ListTagsResponse{Tags: make([]*pb.Tag, len(tags))}
+	for i, tag := range tags {
+		response.Tags[i] = &pb.Tag{Name: tag.Name, Count: tag.Count}
+	}
+	return response, nil
+}
+
+// CheckPasswordPrefix реализует метод проверки пароля по базе утечек: возвращает суффиксы хешей
+// утекших паролей с переданным префиксом хеша, упорядоченные по суффиксу
+func (h *HandlerService) CheckPasswordPrefix(ctx context.Context, request *pb.CheckPasswordPrefixRequest) (*pb.CheckPasswordPrefixResponse, error) {
+	suffixes, err := h.gophKeeper.CheckPasswordPrefix(request.Prefix)
+	switch {
+	case errors.Is(err, goph.ErrInvalidData):
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid prefix: %v", err)
+	case errors.Is(err, goph.ErrBreachUnavailable):
+		return nil, status.Errorf(codes.Unimplemented, "Breach check is unavailable: %v", err)
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "Failed to check password prefix: %v", err)
+	}
+
+	response := &pb.CheckPasswordPrefixResponse{Suffixes: make([]*pb.BreachedSuffix, 0, len(suffixes))}
+	for suffix, count := range suffixes {
+		response.Suffixes = append(response.Suffixes, &pb.BreachedSuffix{Suffix: suffix, Count: count})
+	}
+	sort.Slice(response.Suffixes, func(i, j int) bool { return response.Suffixes[i].Suffix < response.Suffixes[j].Suffix })
+	return response, nil
+}
+
+// folderError - преобразует ошибку операций с папками и метками в статус gRPC.
+func folderError(message string, err error) error {
+	switch {
+	case errors.Is(err, goph.ErrAccessDenied):
+		return status.Errorf(codes.PermissionDenied, "%s: %v", message, err)
+	case errors.Is(err, goph.ErrFolderNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", message, err)
+	case errors.Is(err, goph.ErrFolderExists):
+		return status.Errorf(codes.AlreadyExists, "%s: %v", message, err)
+	case errors.Is(err, goph.ErrFolderCycle):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", message, err)
+	case errors.Is(err, goph.ErrInvalidData):
+		return status.Errorf(codes.InvalidArgument, "%s: %v", message, err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", message, err)
+	}
+}
+
+// UpdateData реализует метод изменения данных пользователя
+func (h *HandlerService) UpdateData(ctx context.Context, request *pb.UpdateDataRequest) (*pb.UpdateDataResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if request.Data == nil || request.Data.Info == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Data is empty")
+	}
+
+	memoryCell := ConvertPBMemoryCellToSchema(request.Data)
+	err = h.gophKeeper.UpdateData(userID, memoryCell, request.ExpectedRevision)
+	if errors.Is(err, goph.ErrAccessDenied) {
+		return nil, status.Errorf(codes.PermissionDenied, "Failed to update data: %v", err)
+	}
+	if errors.Is(err, goph.ErrConflict) {
+		return nil, status.Errorf(codes.Aborted, "Failed to update data: %v", err)
+	}
+	if errors.Is(err, goph.ErrInvalidData) {
+		return nil, status.Errorf(codes.InvalidArgument, "Failed to update data: %v", err)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to update data: %v", err)
+	}
+
+	response := &pb.UpdateDataResponse{
+		Success:  true,
+		Revision: memoryCell.InfoCell.Revision,
+	}
+	return response, nil
+}
+
+// DeleteData реализует метод удаления данных пользователя
+func (h *HandlerService) DeleteData(ctx context.Context, request *pb.DeleteDataRequest) (*pb.DeleteDataResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(request.Ids) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Ids are empty")
+	}
+
+	err = h.gophKeeper.DeleteData(userID, request.Ids)
+	if errors.Is(err, goph.ErrAccessDenied) {
+		return nil, status.Errorf(codes.PermissionDenied, "Failed to delete data: %v", err)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to delete data: %v", err)
+	}
+
+	response := &pb.DeleteDataResponse{
+		Success: true,
+	}
+	return response, nil
+}
+
+// UploadBlob реализует метод потоковой загрузки больших бинарных данных.
+// Первым сообщением передается заголовок, затем части данных по порядку.
+func (h *HandlerService) UploadBlob(stream pb.GophKeeperService_UploadBlobServer) error {
+	userID, err := h.userIDFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	request, err := stream.Recv()
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Failed to receive blob header: %v", err)
+	}
+	header := request.GetHeader()
+	if header == nil {
+		return status.Errorf(codes.InvalidArgument, "First message must be a blob header")
+	}
+
+	blob := schema.Blob{
+		InfoID:    header.InfoId,
+		TotalSize: header.TotalSize,
+		SHA256:    header.Sha256,
+	}
+	_, err = h.gophKeeper.StartBlobUpload(userID, blob, header.Offset)
+	if err != nil {
+		return blobStatusError("Failed to start upload", err)
+	}
+
+	offset := header.Offset
+	for {
+		request, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		chunk := request.GetChunk()
+		if chunk == nil {
+			return status.Errorf(codes.InvalidArgument, "Expected a blob chunk")
+		}
+		if offset+int64(len(chunk)) > header.TotalSize {
+			return status.Errorf(codes.InvalidArgument, "Blob is larger than declared size %d", header.TotalSize)
+		}
+		if err := h.gophKeeper.WriteBlobChunk(header.InfoId, offset, chunk); err != nil {
+			return blobStatusError("Failed to write chunk", err)
+		}
+		offset += int64(len(chunk))
+	}
+
+	result, err := h.gophKeeper.FinishBlobUpload(header.InfoId)
+	if err != nil {
+		return blobStatusError("Failed to finish upload", err)
+	}
+
+	return stream.SendAndClose(&pb.UploadBlobResponse{
+		Size:     result.Size,
+		Complete: result.Complete,
+	})
+}
+
+// BlobStatus реализует метод получения состояния загрузки бинарных данных
+func (h *HandlerService) BlobStatus(ctx context.Context, request *pb.BlobStatusRequest) (*pb.BlobStatusResponse, error) {
+	userID, err := h.userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blob, err := h.gophKeeper.BlobStatus(userID, request.InfoId)
+	if err != nil {
+		return nil, blobStatusError("Failed to get blob status", err)
+	}
+
+	response := &pb.BlobStatusResponse{
+		Size:      blob.Size,
+		TotalSize: blob.TotalSize,
+		Sha256:    blob.SHA256,
+		Complete:  blob.Complete,
+	}
+
+	return response, nil
+}
+
+// DownloadBlob реализует метод потоковой выгрузки больших бинарных данных начиная с указанного смещения
+func (h *HandlerService) DownloadBlob(request *pb.DownloadBlobRequest, stream pb.GophKeeperService_DownloadBlobServer) error {
+	userID, err := h.userIDFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	var totalSize int64
+	var checksum string
+	header := func(blob *schema.Blob) error {
+		totalSize = blob.TotalSize
+		checksum = blob.SHA256
+		return nil
+	}
+	err = h.gophKeeper.ReadBlob(userID, request.InfoId, request.Offset, header, func(offset int64, chunk []byte) error {
+		return stream.Send(&pb.DownloadBlobResponse{
+			Offset:    offset,
+			Chunk:     chunk,
+			TotalSize: totalSize,
+			Sha256:    checksum,
+		})
+	})
+	if err != nil {
+		return blobStatusError("Failed to download blob", err)
+	}
+
+	return nil
+}
+
+// blobStatusError - преобразует ошибку работы с бинарными данными в grpc status.
+func blobStatusError(message string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, goph.ErrAccessDenied):
+		return status.Errorf(codes.PermissionDenied, "%s: %v", message, err)
+	case errors.Is(err, goph.ErrBlobNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", message, err)
+	case errors.Is(err, goph.ErrBlobOffset):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", message, err)
+	case errors.Is(err, goph.ErrBlobChecksum):
+		return status.Errorf(codes.DataLoss, "%s: %v", message, err)
+	case errors.Is(err, goph.ErrBlobTooLarge):
+		return status.Errorf(codes.InvalidArgument, "%s: %v", message, err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", message, err)
+	}
+}
+
 // ConvertPBMemoryCellToSchema преобразует экземпляр типа pb.MemoryCell в тип schema.MemoryCell
 func ConvertPBMemoryCellToSchema(pbCell *pb.MemoryCell) *schema.MemoryCell {
 	schemaCell := &schema.MemoryCell{
@@ -180,6 +737,9 @@ func ConvertPBMemoryCellToSchema(pbCell *pb.MemoryCell) *schema.MemoryCell {
 			DataSize:    pbCell.Info.DataSize,
 			Description: pbCell.Info.Description,
 			OwnerID:     int64(pbCell.Info.OwnerId),
+			Revision:    pbCell.Info.Revision,
+			FolderID:    pbCell.Info.FolderId,
+			Tags:        pbCell.Info.Tags,
 		},
 		ID:            int64(pbCell.Id),
 		InfoID:        int64(pbCell.Info.Id),
@@ -187,6 +747,8 @@ func ConvertPBMemoryCellToSchema(pbCell *pb.MemoryCell) *schema.MemoryCell {
 		KeyValuePairs: pbCell.KeyValuePairs,
 		BinaryData:    pbCell.BinaryData,
 		FileName:      pbCell.FileName,
+		Blob:          pbCell.Blob,
+		Payload:       convertPBPayloadToSchema(pbCell),
 	}
 
 	return schemaCell
@@ -200,6 +762,9 @@ func ConvertPBInfoCellToSchema(pbCell *pb.InfoCell) *schema.InfoCell {
 		DataSize:    pbCell.DataSize,
 		Description: pbCell.Description,
 		OwnerID:     int64(pbCell.OwnerId),
+		Revision:    pbCell.Revision,
+		FolderID:    pbCell.FolderId,
+		Tags:        pbCell.Tags,
 	}
 
 	return schemaCell
@@ -207,30 +772,60 @@ func ConvertPBInfoCellToSchema(pbCell *pb.InfoCell) *schema.InfoCell {
 
 // tokenInterceptor - перехватчик проверяет наличие и валидность токена
 func (h *HandlerService) tokenInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := h.checkToken(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// streamTokenInterceptor - перехватчик потоковых методов, проверяет наличие и валидность токена
+func (h *HandlerService) streamTokenInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := h.checkToken(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// checkToken - проверяет токен из метаданных запроса для всех методов, кроме методов входа.
+func (h *HandlerService) checkToken(ctx context.Context, fullMethod string) error {
 	// Получаем название метода
-	methodName := filepath.Base(info.FullMethod)
+	methodName := filepath.Base(fullMethod)
 
-	// Исключаем методы "Register" и "Authenticate" из проверки токена
-	excludedMethods := []string{"Register", "Authenticate", "Authorize"}
+	// Исключаем методы входа и обновления токена из проверки токена
+	excludedMethods := []string{"Register", "PreLogin", "Authenticate", "Authorize", "RefreshToken"}
 	if slices.Contains(excludedMethods, methodName) {
-		return handler(ctx, req)
+		return nil
 	}
 
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return nil, status.Error(codes.Internal, "Failed to get metadata from context")
+		return status.Error(codes.Internal, "Failed to get metadata from context")
 	}
 
 	// Проверка наличия токена в метаданных
 	values := md.Get("token")
 	if len(values) == 0 {
-		return nil, status.Error(codes.Unauthenticated, "Token is missing")
+		return status.Error(codes.Unauthenticated, "Token is missing")
 	}
 	token := values[0]
 	if ok, _ := h.gophKeeper.CheckToken(token); !ok {
-		return nil, status.Error(codes.Unauthenticated, "Token is invalid")
+		return status.Error(codes.Unauthenticated, "Token is invalid")
 	}
-	return handler(ctx, req)
+	return nil
+}
+
+// userIDFromContext - получает ID пользователя по токену из контекста.
+// Возвращает ошибку в формате grpc status.
+func (h *HandlerService) userIDFromContext(ctx context.Context) (int64, error) {
+	token, ok := GetTokenFromContext(ctx)
+	if !ok {
+		return 0, status.Errorf(codes.Unauthenticated, "No token in context")
+	}
+	userID, err := h.gophKeeper.GetUserIDFromToken(token)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "Unsuccessful getting id from token %v", err)
+	}
+	return userID, nil
 }
 
 // GetTokenFromContext - получает токен из контекста.
@@ -252,19 +847,15 @@ func GetTokenFromContext(ctx context.Context) (string, bool) {
 // ConvertSchemaMemoryCellToPB преобразует экземпляр типа schema.MemoryCell в тип pb.MemoryCell
 func ConvertSchemaMemoryCellToPB(schemaCell *schema.MemoryCell) *pb.MemoryCell {
 	pbCell := &pb.MemoryCell{
-		Id: schemaCell.ID,
-		Info: &pb.InfoCell{
-			Id:          schemaCell.InfoCell.ID,
-			DataType:    schemaCell.InfoCell.DataType,
-			DataSize:    schemaCell.InfoCell.DataSize,
-			Description: schemaCell.InfoCell.Description,
-			OwnerId:     schemaCell.InfoCell.OwnerID,
-		},
+		Id:            schemaCell.ID,
+		Info:          ConvertSchemaInfoCellToPB(schemaCell.InfoCell),
 		Encrypted:     schemaCell.Encrypted,
 		KeyValuePairs: schemaCell.KeyValuePairs,
 		BinaryData:    schemaCell.BinaryData,
 		FileName:      schemaCell.FileName,
+		Blob:          schemaCell.Blob,
 	}
+	setPBPayload(pbCell, schemaCell.Payload)
 
 	return pbCell
 }
@@ -277,7 +868,120 @@ func ConvertSchemaInfoCellToPB(schemaCell *schema.InfoCell) *pb.InfoCell {
 		DataSize:    schemaCell.DataSize,
 		Description: schemaCell.Description,
 		OwnerId:     schemaCell.OwnerID,
+		Revision:    schemaCell.Revision,
+		FolderId:    schemaCell.FolderID,
+		Tags:        schemaCell.Tags,
+	}
+	if schemaCell.DeletedAt != nil {
+		pbCell.DeletedAt = timestamppb.New(*schemaCell.DeletedAt)
+	}
+	if !schemaCell.CreatedAt.IsZero() {
+		pbCell.CreatedAt = timestamppb.New(schemaCell.CreatedAt)
+	}
+	if !schemaCell.UpdatedAt.IsZero() {
+		pbCell.UpdatedAt = timestamppb.New(schemaCell.UpdatedAt)
 	}
 
 	return pbCell
 }
+
+// convertPBInfoQuery преобразует запрос информации о данных в тип goph.InfoQuery
+func convertPBInfoQuery(request *pb.GetInformationRequest) goph.InfoQuery {
+	query := goph.InfoQuery{
+		Sort:       request.Sort,
+		Descending: request.Descending,
+		PageSize:   int(request.PageSize),
+		PageToken:  request.PageToken,
+	}
+	if filter := request.Filter; filter != nil {
+		query.Filter = schema.InfoFilter{
+			DataTypes: filter.DataTypes,
+			Text:      filter.Text,
+			Tags:      filter.Tags,
+		}
+		if filter.CreatedAfter != nil {
+			query.Filter.CreatedAfter = filter.CreatedAfter.AsTime()
+		}
+		if filter.CreatedBefore != nil {
+			query.Filter.CreatedBefore = filter.CreatedBefore.AsTime()
+		}
+		if filter.UpdatedAfter != nil {
+			query.Filter.UpdatedAfter = filter.UpdatedAfter.AsTime()
+		}
+		if filter.UpdatedBefore != nil {
+			query.Filter.UpdatedBefore = filter.UpdatedBefore.AsTime()
+		}
+	}
+	return query
+}
+
+// convertPBPayloadToSchema возвращает содержимое ячейки в типе schema.Payload или nil, если содержимого нет
+func convertPBPayloadToSchema(pbCell *pb.MemoryCell) *schema.Payload {
+	switch payload := pbCell.Payload.(type) {
+	case *pb.MemoryCell_Login:
+		return &schema.Payload{Login: &schema.LoginPayload{
+			URL:      payload.Login.GetUrl(),
+			Username: payload.Login.GetUsername(),
+			Password: payload.Login.GetPassword(),
+		}}
+	case *pb.MemoryCell_Card:
+		return &schema.Payload{Card: &schema.CardPayload{
+			Number: payload.Card.GetNumber(),
+			Holder: payload.Card.GetHolder(),
+			Expiry: payload.Card.GetExpiry(),
+			CVV:    payload.Card.GetCvv(),
+		}}
+	case *pb.MemoryCell_Note:
+		return &schema.Payload{Note: &schema.NotePayload{Text: payload.Note.GetText()}}
+	case *pb.MemoryCell_File:
+		return &schema.Payload{File: &schema.FilePayload{MediaType: payload.File.GetMediaType()}}
+	case *pb.MemoryCell_Otp:
+		return &schema.Payload{OTP: &schema.OTPPayload{
+			Type:      payload.Otp.GetType(),
+			Issuer:    payload.Otp.GetIssuer(),
+			Account:   payload.Otp.GetAccount(),
+			Secret:    payload.Otp.GetSecret(),
+			Algorithm: payload.Otp.GetAlgorithm(),
+			Digits:    payload.Otp.GetDigits(),
+			Period:    payload.Otp.GetPeriod(),
+			Counter:   payload.Otp.GetCounter(),
+		}}
+	default:
+		return nil
+	}
+}
+
+// setPBPayload записывает содержимое из schema.Payload в ячейку pb.MemoryCell
+func setPBPayload(pbCell *pb.MemoryCell, payload *schema.Payload) {
+	switch {
+	case payload == nil:
+	case payload.Login != nil:
+		pbCell.Payload = &pb.MemoryCell_Login{Login: &pb.LoginPayload{
+			Url:      payload.Login.URL,
+			Username: payload.Login.Username,
+			Password: payload.Login.Password,
+		}}
+	case payload.Card != nil:
+		pbCell.Payload = &pb.MemoryCell_Card{Card: &pb.CardPayload{
+			Number: payload.Card.Number,
+			Holder: payload.Card.Holder,
+			Expiry: payload.Card.Expiry,
+			Cvv:    payload.Card.CVV,
+		}}
+	case payload.Note != nil:
+		pbCell.Payload = &pb.MemoryCell_Note{Note: &pb.NotePayload{Text: payload.Note.Text}}
+	case payload.File != nil:
+		pbCell.Payload = &pb.MemoryCell_File{File: &pb.FilePayload{MediaType: payload.File.MediaType}}
+	case payload.OTP != nil:
+		pbCell.Payload = &pb.MemoryCell_Otp{Otp: &pb.OTPPayload{
+			Type:      payload.OTP.Type,
+			Issuer:    payload.OTP.Issuer,
+			Account:   payload.OTP.Account,
+			Secret:    payload.OTP.Secret,
+			Algorithm: payload.OTP.Algorithm,
+			Digits:    payload.OTP.Digits,
+			Period:    payload.OTP.Period,
+			Counter:   payload.OTP.Counter,
+		}}
+	}
+}
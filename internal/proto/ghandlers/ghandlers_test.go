@@ -11,6 +11,7 @@ import (
 	pb "github.com/bubu256/gophkeeper_pet/internal/proto/pb"
 	"github.com/bubu256/gophkeeper_pet/internal/schema"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestConvertSchemaInfoCellToPB(t *testing.T) {
@@ -141,3 +142,26 @@ func TestConvertPBMemoryCellToSchema(t *testing.T) {
 
 	assert.Equal(t, expectedCell, schemaCell)
 }
+
+func TestConvertPayload(t *testing.T) {
+	pbCells := []*pb.MemoryCell{
+		{Payload: &pb.MemoryCell_Login{Login: &pb.LoginPayload{Url: "https://example.com", Username: "user", Password: "secret"}}},
+		{Payload: &pb.MemoryCell_Card{Card: &pb.CardPayload{Number: "4111111111111111", Holder: "IVAN IVANOV", Expiry: "07/27", Cvv: "123"}}},
+		{Payload: &pb.MemoryCell_Note{Note: &pb.NotePayload{Text: "note"}}},
+		{Payload: &pb.MemoryCell_File{File: &pb.FilePayload{MediaType: "text/plain"}}},
+		{Payload: &pb.MemoryCell_Otp{Otp: &pb.OTPPayload{Type: "totp", Issuer: "GitHub", Account: "alice", Secret: "JBSWY3DPEHPK3PXP", Algorithm: "SHA1", Digits: "6", Period: "30", Counter: "0"}}},
+		{},
+	}
+
+	for _, pbCell := range pbCells {
+		pbCell.Info = &pb.InfoCell{Id: 1}
+		// Содержимое сохраняется при преобразовании в обе стороны
+		schemaCell := ghandlers.ConvertPBMemoryCellToSchema(pbCell)
+		converted := ghandlers.ConvertSchemaMemoryCellToPB(schemaCell)
+		assert.True(t, proto.Equal(pbCell, converted), "%v != %v", pbCell, converted)
+	}
+
+	schemaCell := ghandlers.ConvertPBMemoryCellToSchema(pbCells[1])
+	assert.Equal(t, &schema.CardPayload{Number: "4111111111111111", Holder: "IVAN IVANOV", Expiry: "07/27", CVV: "123"}, schemaCell.Payload.Card)
+	assert.Nil(t, ghandlers.ConvertPBMemoryCellToSchema(pbCells[5]).Payload)
+}
@@ -19,12 +19,35 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	GophKeeperService_Register_FullMethodName       = "/pb.GophKeeperService/Register"
-	GophKeeperService_Authenticate_FullMethodName   = "/pb.GophKeeperService/Authenticate"
-	GophKeeperService_Authorize_FullMethodName      = "/pb.GophKeeperService/Authorize"
-	GophKeeperService_AddData_FullMethodName        = "/pb.GophKeeperService/AddData"
-	GophKeeperService_RetrieveData_FullMethodName   = "/pb.GophKeeperService/RetrieveData"
-	GophKeeperService_GetInformation_FullMethodName = "/pb.GophKeeperService/GetInformation"
+	GophKeeperService_Register_FullMethodName            = "/pb.GophKeeperService/Register"
+	GophKeeperService_PreLogin_FullMethodName            = "/pb.GophKeeperService/PreLogin"
+	GophKeeperService_Authenticate_FullMethodName        = "/pb.GophKeeperService/Authenticate"
+	GophKeeperService_ChangePassword_FullMethodName      = "/pb.GophKeeperService/ChangePassword"
+	GophKeeperService_Authorize_FullMethodName           = "/pb.GophKeeperService/Authorize"
+	GophKeeperService_RefreshToken_FullMethodName        = "/pb.GophKeeperService/RefreshToken"
+	GophKeeperService_Logout_FullMethodName              = "/pb.GophKeeperService/Logout"
+	GophKeeperService_AddData_FullMethodName             = "/pb.GophKeeperService/AddData"
+	GophKeeperService_RetrieveData_FullMethodName        = "/pb.GophKeeperService/RetrieveData"
+	GophKeeperService_GetInformation_FullMethodName      = "/pb.GophKeeperService/GetInformation"
+	GophKeeperService_UpdateData_FullMethodName          = "/pb.GophKeeperService/UpdateData"
+	GophKeeperService_DeleteData_FullMethodName          = "/pb.GophKeeperService/DeleteData"
+	GophKeeperService_UploadBlob_FullMethodName          = "/pb.GophKeeperService/UploadBlob"
+	GophKeeperService_BlobStatus_FullMethodName          = "/pb.GophKeeperService/BlobStatus"
+	GophKeeperService_DownloadBlob_FullMethodName        = "/pb.GophKeeperService/DownloadBlob"
+	GophKeeperService_SyncSince_FullMethodName           = "/pb.GophKeeperService/SyncSince"
+	GophKeeperService_ListVersions_FullMethodName        = "/pb.GophKeeperService/ListVersions"
+	GophKeeperService_RestoreVersion_FullMethodName      = "/pb.GophKeeperService/RestoreVersion"
+	GophKeeperService_ListTrash_FullMethodName           = "/pb.GophKeeperService/ListTrash"
+	GophKeeperService_RestoreFromTrash_FullMethodName    = "/pb.GophKeeperService/RestoreFromTrash"
+	GophKeeperService_EmptyTrash_FullMethodName          = "/pb.GophKeeperService/EmptyTrash"
+	GophKeeperService_ListFolders_FullMethodName         = "/pb.GophKeeperService/ListFolders"
+	GophKeeperService_CreateFolder_FullMethodName        = "/pb.GophKeeperService/CreateFolder"
+	GophKeeperService_RenameFolder_FullMethodName        = "/pb.GophKeeperService/RenameFolder"
+	GophKeeperService_MoveFolder_FullMethodName          = "/pb.GophKeeperService/MoveFolder"
+	GophKeeperService_MoveData_FullMethodName            = "/pb.GophKeeperService/MoveData"
+	GophKeeperService_TagData_FullMethodName             = "/pb.GophKeeperService/TagData"
+	GophKeeperService_ListTags_FullMethodName            = "/pb.GophKeeperService/ListTags"
+	GophKeeperService_CheckPasswordPrefix_FullMethodName = "/pb.GophKeeperService/CheckPasswordPrefix"
 )
 
 // GophKeeperServiceClient is the client API for GophKeeperService service.
@@ -32,11 +55,34 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type GophKeeperServiceClient interface {
 	Register(ctx context.Context, in *RegistrationRequest, opts ...grpc.CallOption) (*RegistrationResponse, error)
+	PreLogin(ctx context.Context, in *PreLoginRequest, opts ...grpc.CallOption) (*PreLoginResponse, error)
 	Authenticate(ctx context.Context, in *AuthenticationRequest, opts ...grpc.CallOption) (*AuthenticationResponse, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
 	Authorize(ctx context.Context, in *AuthorizationRequest, opts ...grpc.CallOption) (*AuthorizationResponse, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
 	AddData(ctx context.Context, in *AddDataRequest, opts ...grpc.CallOption) (*AddDataResponse, error)
 	RetrieveData(ctx context.Context, in *RetrieveDataRequest, opts ...grpc.CallOption) (*RetrieveDataResponse, error)
 	GetInformation(ctx context.Context, in *GetInformationRequest, opts ...grpc.CallOption) (*GetInformationResponse, error)
+	UpdateData(ctx context.Context, in *UpdateDataRequest, opts ...grpc.CallOption) (*UpdateDataResponse, error)
+	DeleteData(ctx context.Context, in *DeleteDataRequest, opts ...grpc.CallOption) (*DeleteDataResponse, error)
+	UploadBlob(ctx context.Context, opts ...grpc.CallOption) (GophKeeperService_UploadBlobClient, error)
+	BlobStatus(ctx context.Context, in *BlobStatusRequest, opts ...grpc.CallOption) (*BlobStatusResponse, error)
+	DownloadBlob(ctx context.Context, in *DownloadBlobRequest, opts ...grpc.CallOption) (GophKeeperService_DownloadBlobClient, error)
+	SyncSince(ctx context.Context, in *SyncSinceRequest, opts ...grpc.CallOption) (*SyncSinceResponse, error)
+	ListVersions(ctx context.Context, in *ListVersionsRequest, opts ...grpc.CallOption) (*ListVersionsResponse, error)
+	RestoreVersion(ctx context.Context, in *RestoreVersionRequest, opts ...grpc.CallOption) (*RestoreVersionResponse, error)
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	RestoreFromTrash(ctx context.Context, in *RestoreFromTrashRequest, opts ...grpc.CallOption) (*RestoreFromTrashResponse, error)
+	EmptyTrash(ctx context.Context, in *EmptyTrashRequest, opts ...grpc.CallOption) (*EmptyTrashResponse, error)
+	ListFolders(ctx context.Context, in *ListFoldersRequest, opts ...grpc.CallOption) (*ListFoldersResponse, error)
+	CreateFolder(ctx context.Context, in *CreateFolderRequest, opts ...grpc.CallOption) (*CreateFolderResponse, error)
+	RenameFolder(ctx context.Context, in *RenameFolderRequest, opts ...grpc.CallOption) (*RenameFolderResponse, error)
+	MoveFolder(ctx context.Context, in *MoveFolderRequest, opts ...grpc.CallOption) (*MoveFolderResponse, error)
+	MoveData(ctx context.Context, in *MoveDataRequest, opts ...grpc.CallOption) (*MoveDataResponse, error)
+	TagData(ctx context.Context, in *TagDataRequest, opts ...grpc.CallOption) (*TagDataResponse, error)
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	CheckPasswordPrefix(ctx context.Context, in *CheckPasswordPrefixRequest, opts ...grpc.CallOption) (*CheckPasswordPrefixResponse, error)
 }
 
 type gophKeeperServiceClient struct {
@@ -56,6 +102,15 @@ func (c *gophKeeperServiceClient) Register(ctx context.Context, in *Registration
 	return out, nil
 }
 
+func (c *gophKeeperServiceClient) PreLogin(ctx context.Context, in *PreLoginRequest, opts ...grpc.CallOption) (*PreLoginResponse, error) {
+	out := new(PreLoginResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_PreLogin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gophKeeperServiceClient) Authenticate(ctx context.Context, in *AuthenticationRequest, opts ...grpc.CallOption) (*AuthenticationResponse, error) {
 	out := new(AuthenticationResponse)
 	err := c.cc.Invoke(ctx, GophKeeperService_Authenticate_FullMethodName, in, out, opts...)
@@ -65,6 +120,15 @@ func (c *gophKeeperServiceClient) Authenticate(ctx context.Context, in *Authenti
 	return out, nil
 }
 
+func (c *gophKeeperServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
+	out := new(ChangePasswordResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_ChangePassword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gophKeeperServiceClient) Authorize(ctx context.Context, in *AuthorizationRequest, opts ...grpc.CallOption) (*AuthorizationResponse, error) {
 	out := new(AuthorizationResponse)
 	err := c.cc.Invoke(ctx, GophKeeperService_Authorize_FullMethodName, in, out, opts...)
@@ -74,6 +138,24 @@ func (c *gophKeeperServiceClient) Authorize(ctx context.Context, in *Authorizati
 	return out, nil
 }
 
+func (c *gophKeeperServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
+	out := new(RefreshTokenResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_RefreshToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	out := new(LogoutResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_Logout_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gophKeeperServiceClient) AddData(ctx context.Context, in *AddDataRequest, opts ...grpc.CallOption) (*AddDataResponse, error) {
 	out := new(AddDataResponse)
 	err := c.cc.Invoke(ctx, GophKeeperService_AddData_FullMethodName, in, out, opts...)
@@ -101,16 +183,258 @@ func (c *gophKeeperServiceClient) GetInformation(ctx context.Context, in *GetInf
 	return out, nil
 }
 
+func (c *gophKeeperServiceClient) UpdateData(ctx context.Context, in *UpdateDataRequest, opts ...grpc.CallOption) (*UpdateDataResponse, error) {
+	out := new(UpdateDataResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_UpdateData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) DeleteData(ctx context.Context, in *DeleteDataRequest, opts ...grpc.CallOption) (*DeleteDataResponse, error) {
+	out := new(DeleteDataResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_DeleteData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) UploadBlob(ctx context.Context, opts ...grpc.CallOption) (GophKeeperService_UploadBlobClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GophKeeperService_ServiceDesc.Streams[0], GophKeeperService_UploadBlob_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gophKeeperServiceUploadBlobClient{stream}
+	return x, nil
+}
+
+type GophKeeperService_UploadBlobClient interface {
+	Send(*UploadBlobRequest) error
+	CloseAndRecv() (*UploadBlobResponse, error)
+	grpc.ClientStream
+}
+
+type gophKeeperServiceUploadBlobClient struct {
+	grpc.ClientStream
+}
+
+func (x *gophKeeperServiceUploadBlobClient) Send(m *UploadBlobRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *gophKeeperServiceUploadBlobClient) CloseAndRecv() (*UploadBlobResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadBlobResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gophKeeperServiceClient) BlobStatus(ctx context.Context, in *BlobStatusRequest, opts ...grpc.CallOption) (*BlobStatusResponse, error) {
+	out := new(BlobStatusResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_BlobStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) DownloadBlob(ctx context.Context, in *DownloadBlobRequest, opts ...grpc.CallOption) (GophKeeperService_DownloadBlobClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GophKeeperService_ServiceDesc.Streams[1], GophKeeperService_DownloadBlob_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gophKeeperServiceDownloadBlobClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GophKeeperService_DownloadBlobClient interface {
+	Recv() (*DownloadBlobResponse, error)
+	grpc.ClientStream
+}
+
+type gophKeeperServiceDownloadBlobClient struct {
+	grpc.ClientStream
+}
+
+func (x *gophKeeperServiceDownloadBlobClient) Recv() (*DownloadBlobResponse, error) {
+	m := new(DownloadBlobResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gophKeeperServiceClient) SyncSince(ctx context.Context, in *SyncSinceRequest, opts ...grpc.CallOption) (*SyncSinceResponse, error) {
+	out := new(SyncSinceResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_SyncSince_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) ListVersions(ctx context.Context, in *ListVersionsRequest, opts ...grpc.CallOption) (*ListVersionsResponse, error) {
+	out := new(ListVersionsResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_ListVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) RestoreVersion(ctx context.Context, in *RestoreVersionRequest, opts ...grpc.CallOption) (*RestoreVersionResponse, error) {
+	out := new(RestoreVersionResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_RestoreVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_ListTrash_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) RestoreFromTrash(ctx context.Context, in *RestoreFromTrashRequest, opts ...grpc.CallOption) (*RestoreFromTrashResponse, error) {
+	out := new(RestoreFromTrashResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_RestoreFromTrash_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) EmptyTrash(ctx context.Context, in *EmptyTrashRequest, opts ...grpc.CallOption) (*EmptyTrashResponse, error) {
+	out := new(EmptyTrashResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_EmptyTrash_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) ListFolders(ctx context.Context, in *ListFoldersRequest, opts ...grpc.CallOption) (*ListFoldersResponse, error) {
+	out := new(ListFoldersResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_ListFolders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) CreateFolder(ctx context.Context, in *CreateFolderRequest, opts ...grpc.CallOption) (*CreateFolderResponse, error) {
+	out := new(CreateFolderResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_CreateFolder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) RenameFolder(ctx context.Context, in *RenameFolderRequest, opts ...grpc.CallOption) (*RenameFolderResponse, error) {
+	out := new(RenameFolderResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_RenameFolder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) MoveFolder(ctx context.Context, in *MoveFolderRequest, opts ...grpc.CallOption) (*MoveFolderResponse, error) {
+	out := new(MoveFolderResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_MoveFolder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) MoveData(ctx context.Context, in *MoveDataRequest, opts ...grpc.CallOption) (*MoveDataResponse, error) {
+	out := new(MoveDataResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_MoveData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) TagData(ctx context.Context, in *TagDataRequest, opts ...grpc.CallOption) (*TagDataResponse, error) {
+	out := new(TagDataResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_TagData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_ListTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) CheckPasswordPrefix(ctx context.Context, in *CheckPasswordPrefixRequest, opts ...grpc.CallOption) (*CheckPasswordPrefixResponse, error) {
+	out := new(CheckPasswordPrefixResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_CheckPasswordPrefix_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GophKeeperServiceServer is the server API for GophKeeperService service.
 // All implementations must embed UnimplementedGophKeeperServiceServer
 // for forward compatibility
 type GophKeeperServiceServer interface {
 	Register(context.Context, *RegistrationRequest) (*RegistrationResponse, error)
+	PreLogin(context.Context, *PreLoginRequest) (*PreLoginResponse, error)
 	Authenticate(context.Context, *AuthenticationRequest) (*AuthenticationResponse, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
 	Authorize(context.Context, *AuthorizationRequest) (*AuthorizationResponse, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
 	AddData(context.Context, *AddDataRequest) (*AddDataResponse, error)
 	RetrieveData(context.Context, *RetrieveDataRequest) (*RetrieveDataResponse, error)
 	GetInformation(context.Context, *GetInformationRequest) (*GetInformationResponse, error)
+	UpdateData(context.Context, *UpdateDataRequest) (*UpdateDataResponse, error)
+	DeleteData(context.Context, *DeleteDataRequest) (*DeleteDataResponse, error)
+	UploadBlob(GophKeeperService_UploadBlobServer) error
+	BlobStatus(context.Context, *BlobStatusRequest) (*BlobStatusResponse, error)
+	DownloadBlob(*DownloadBlobRequest, GophKeeperService_DownloadBlobServer) error
+	SyncSince(context.Context, *SyncSinceRequest) (*SyncSinceResponse, error)
+	ListVersions(context.Context, *ListVersionsRequest) (*ListVersionsResponse, error)
+	RestoreVersion(context.Context, *RestoreVersionRequest) (*RestoreVersionResponse, error)
+	ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	RestoreFromTrash(context.Context, *RestoreFromTrashRequest) (*RestoreFromTrashResponse, error)
+	EmptyTrash(context.Context, *EmptyTrashRequest) (*EmptyTrashResponse, error)
+	ListFolders(context.Context, *ListFoldersRequest) (*ListFoldersResponse, error)
+	CreateFolder(context.Context, *CreateFolderRequest) (*CreateFolderResponse, error)
+	RenameFolder(context.Context, *RenameFolderRequest) (*RenameFolderResponse, error)
+	MoveFolder(context.Context, *MoveFolderRequest) (*MoveFolderResponse, error)
+	MoveData(context.Context, *MoveDataRequest) (*MoveDataResponse, error)
+	TagData(context.Context, *TagDataRequest) (*TagDataResponse, error)
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	CheckPasswordPrefix(context.Context, *CheckPasswordPrefixRequest) (*CheckPasswordPrefixResponse, error)
 	mustEmbedUnimplementedGophKeeperServiceServer()
 }
 
@@ -121,12 +445,24 @@ type UnimplementedGophKeeperServiceServer struct {
 func (UnimplementedGophKeeperServiceServer) Register(context.Context, *RegistrationRequest) (*RegistrationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
 }
+func (UnimplementedGophKeeperServiceServer) PreLogin(context.Context, *PreLoginRequest) (*PreLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreLogin not implemented")
+}
 func (UnimplementedGophKeeperServiceServer) Authenticate(context.Context, *AuthenticationRequest) (*AuthenticationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authenticate not implemented")
 }
+func (UnimplementedGophKeeperServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
 func (UnimplementedGophKeeperServiceServer) Authorize(context.Context, *AuthorizationRequest) (*AuthorizationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authorize not implemented")
 }
+func (UnimplementedGophKeeperServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) Logout(context.Context, *LogoutRequest) (*LogoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
 func (UnimplementedGophKeeperServiceServer) AddData(context.Context, *AddDataRequest) (*AddDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddData not implemented")
 }
@@ -136,6 +472,63 @@ func (UnimplementedGophKeeperServiceServer) RetrieveData(context.Context, *Retri
 func (UnimplementedGophKeeperServiceServer) GetInformation(context.Context, *GetInformationRequest) (*GetInformationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInformation not implemented")
 }
+func (UnimplementedGophKeeperServiceServer) UpdateData(context.Context, *UpdateDataRequest) (*UpdateDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateData not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) DeleteData(context.Context, *DeleteDataRequest) (*DeleteDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteData not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) UploadBlob(GophKeeperService_UploadBlobServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadBlob not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) BlobStatus(context.Context, *BlobStatusRequest) (*BlobStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlobStatus not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) DownloadBlob(*DownloadBlobRequest, GophKeeperService_DownloadBlobServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadBlob not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) SyncSince(context.Context, *SyncSinceRequest) (*SyncSinceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncSince not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) ListVersions(context.Context, *ListVersionsRequest) (*ListVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVersions not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) RestoreVersion(context.Context, *RestoreVersionRequest) (*RestoreVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreVersion not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) RestoreFromTrash(context.Context, *RestoreFromTrashRequest) (*RestoreFromTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreFromTrash not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) EmptyTrash(context.Context, *EmptyTrashRequest) (*EmptyTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmptyTrash not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) ListFolders(context.Context, *ListFoldersRequest) (*ListFoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFolders not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) CreateFolder(context.Context, *CreateFolderRequest) (*CreateFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFolder not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) RenameFolder(context.Context, *RenameFolderRequest) (*RenameFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameFolder not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) MoveFolder(context.Context, *MoveFolderRequest) (*MoveFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveFolder not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) MoveData(context.Context, *MoveDataRequest) (*MoveDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveData not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) TagData(context.Context, *TagDataRequest) (*TagDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TagData not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) CheckPasswordPrefix(context.Context, *CheckPasswordPrefixRequest) (*CheckPasswordPrefixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPasswordPrefix not implemented")
+}
 func (UnimplementedGophKeeperServiceServer) mustEmbedUnimplementedGophKeeperServiceServer() {}
 
 // UnsafeGophKeeperServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -167,6 +560,24 @@ func _GophKeeperService_Register_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GophKeeperService_PreLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).PreLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_PreLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).PreLogin(ctx, req.(*PreLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GophKeeperService_Authenticate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AuthenticationRequest)
 	if err := dec(in); err != nil {
@@ -185,6 +596,24 @@ func _GophKeeperService_Authenticate_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GophKeeperService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GophKeeperService_Authorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AuthorizationRequest)
 	if err := dec(in); err != nil {
@@ -203,6 +632,42 @@ func _GophKeeperService_Authorize_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GophKeeperService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GophKeeperService_AddData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddDataRequest)
 	if err := dec(in); err != nil {
@@ -257,27 +722,396 @@ func _GophKeeperService_GetInformation_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
-// GophKeeperService_ServiceDesc is the grpc.ServiceDesc for GophKeeperService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var GophKeeperService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "pb.GophKeeperService",
-	HandlerType: (*GophKeeperServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Register",
-			Handler:    _GophKeeperService_Register_Handler,
-		},
-		{
-			MethodName: "Authenticate",
-			Handler:    _GophKeeperService_Authenticate_Handler,
-		},
-		{
-			MethodName: "Authorize",
-			Handler:    _GophKeeperService_Authorize_Handler,
-		},
-		{
-			MethodName: "AddData",
+func _GophKeeperService_UpdateData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).UpdateData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_UpdateData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).UpdateData(ctx, req.(*UpdateDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_DeleteData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).DeleteData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_DeleteData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).DeleteData(ctx, req.(*DeleteDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_UploadBlob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GophKeeperServiceServer).UploadBlob(&gophKeeperServiceUploadBlobServer{stream})
+}
+
+type GophKeeperService_UploadBlobServer interface {
+	SendAndClose(*UploadBlobResponse) error
+	Recv() (*UploadBlobRequest, error)
+	grpc.ServerStream
+}
+
+type gophKeeperServiceUploadBlobServer struct {
+	grpc.ServerStream
+}
+
+func (x *gophKeeperServiceUploadBlobServer) SendAndClose(m *UploadBlobResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *gophKeeperServiceUploadBlobServer) Recv() (*UploadBlobRequest, error) {
+	m := new(UploadBlobRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _GophKeeperService_BlobStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlobStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).BlobStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_BlobStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).BlobStatus(ctx, req.(*BlobStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_DownloadBlob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadBlobRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GophKeeperServiceServer).DownloadBlob(m, &gophKeeperServiceDownloadBlobServer{stream})
+}
+
+type GophKeeperService_DownloadBlobServer interface {
+	Send(*DownloadBlobResponse) error
+	grpc.ServerStream
+}
+
+type gophKeeperServiceDownloadBlobServer struct {
+	grpc.ServerStream
+}
+
+func (x *gophKeeperServiceDownloadBlobServer) Send(m *DownloadBlobResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GophKeeperService_SyncSince_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncSinceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).SyncSince(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_SyncSince_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).SyncSince(ctx, req.(*SyncSinceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_ListVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).ListVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_ListVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).ListVersions(ctx, req.(*ListVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_RestoreVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).RestoreVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_RestoreVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).RestoreVersion(ctx, req.(*RestoreVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_RestoreFromTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreFromTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).RestoreFromTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_RestoreFromTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).RestoreFromTrash(ctx, req.(*RestoreFromTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_EmptyTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).EmptyTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_EmptyTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).EmptyTrash(ctx, req.(*EmptyTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_ListFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).ListFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_ListFolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).ListFolders(ctx, req.(*ListFoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_CreateFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).CreateFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_CreateFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).CreateFolder(ctx, req.(*CreateFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_RenameFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).RenameFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_RenameFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).RenameFolder(ctx, req.(*RenameFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_MoveFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).MoveFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_MoveFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).MoveFolder(ctx, req.(*MoveFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_MoveData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).MoveData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_MoveData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).MoveData(ctx, req.(*MoveDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_TagData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).TagData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_TagData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).TagData(ctx, req.(*TagDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_CheckPasswordPrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPasswordPrefixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).CheckPasswordPrefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_CheckPasswordPrefix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).CheckPasswordPrefix(ctx, req.(*CheckPasswordPrefixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GophKeeperService_ServiceDesc is the grpc.ServiceDesc for GophKeeperService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GophKeeperService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.GophKeeperService",
+	HandlerType: (*GophKeeperServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Register",
+			Handler:    _GophKeeperService_Register_Handler,
+		},
+		{
+			MethodName: "PreLogin",
+			Handler:    _GophKeeperService_PreLogin_Handler,
+		},
+		{
+			MethodName: "Authenticate",
+			Handler:    _GophKeeperService_Authenticate_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _GophKeeperService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "Authorize",
+			Handler:    _GophKeeperService_Authorize_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _GophKeeperService_RefreshToken_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _GophKeeperService_Logout_Handler,
+		},
+		{
+			MethodName: "AddData",
 			Handler:    _GophKeeperService_AddData_Handler,
 		},
 		{
@@ -288,6 +1122,360 @@ var GophKeeperService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInformation",
 			Handler:    _GophKeeperService_GetInformation_Handler,
 		},
+		{
+			MethodName: "UpdateData",
+			Handler:    _GophKeeperService_UpdateData_Handler,
+		},
+		{
+			MethodName: "DeleteData",
+			Handler:    _GophKeeperService_DeleteData_Handler,
+		},
+		{
+			MethodName: "BlobStatus",
+			Handler:    _GophKeeperService_BlobStatus_Handler,
+		},
+		{
+			MethodName: "SyncSince",
+			Handler:    _GophKeeperService_SyncSince_Handler,
+		},
+		{
+			MethodName: "ListVersions",
+			Handler:    _GophKeeperService_ListVersions_Handler,
+		},
+		{
+			MethodName: "RestoreVersion",
+			Handler:    _GophKeeperService_RestoreVersion_Handler,
+		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _GophKeeperService_ListTrash_Handler,
+		},
+		{
+			MethodName: "RestoreFromTrash",
+			Handler:    _GophKeeperService_RestoreFromTrash_Handler,
+		},
+		{
+			MethodName: "EmptyTrash",
+			Handler:    _GophKeeperService_EmptyTrash_Handler,
+		},
+		{
+			MethodName: "ListFolders",
+			Handler:    _GophKeeperService_ListFolders_Handler,
+		},
+		{
+			MethodName: "CreateFolder",
+			Handler:    _GophKeeperService_CreateFolder_Handler,
+		},
+		{
+			MethodName: "RenameFolder",
+			Handler:    _GophKeeperService_RenameFolder_Handler,
+		},
+		{
+			MethodName: "MoveFolder",
+			Handler:    _GophKeeperService_MoveFolder_Handler,
+		},
+		{
+			MethodName: "MoveData",
+			Handler:    _GophKeeperService_MoveData_Handler,
+		},
+		{
+			MethodName: "TagData",
+			Handler:    _GophKeeperService_TagData_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _GophKeeperService_ListTags_Handler,
+		},
+		{
+			MethodName: "CheckPasswordPrefix",
+			Handler:    _GophKeeperService_CheckPasswordPrefix_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadBlob",
+			Handler:       _GophKeeperService_UploadBlob_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadBlob",
+			Handler:       _GophKeeperService_DownloadBlob_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "internal/proto/gophkeeper.proto",
+}
+
+const (
+	AgentService_Status_FullMethodName = "/pb.AgentService/Status"
+	AgentService_List_FullMethodName   = "/pb.AgentService/List"
+	AgentService_Get_FullMethodName    = "/pb.AgentService/Get"
+	AgentService_Unlock_FullMethodName = "/pb.AgentService/Unlock"
+	AgentService_Lock_FullMethodName   = "/pb.AgentService/Lock"
+	AgentService_Stop_FullMethodName   = "/pb.AgentService/Stop"
+)
+
+// AgentServiceClient is the client API for AgentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AgentServiceClient interface {
+	Status(ctx context.Context, in *AgentStatusRequest, opts ...grpc.CallOption) (*AgentStatusResponse, error)
+	List(ctx context.Context, in *AgentListRequest, opts ...grpc.CallOption) (*AgentListResponse, error)
+	Get(ctx context.Context, in *AgentGetRequest, opts ...grpc.CallOption) (*AgentGetResponse, error)
+	Unlock(ctx context.Context, in *AgentUnlockRequest, opts ...grpc.CallOption) (*AgentUnlockResponse, error)
+	Lock(ctx context.Context, in *AgentLockRequest, opts ...grpc.CallOption) (*AgentLockResponse, error)
+	Stop(ctx context.Context, in *AgentStopRequest, opts ...grpc.CallOption) (*AgentStopResponse, error)
+}
+
+type agentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAgentServiceClient(cc grpc.ClientConnInterface) AgentServiceClient {
+	return &agentServiceClient{cc}
+}
+
+func (c *agentServiceClient) Status(ctx context.Context, in *AgentStatusRequest, opts ...grpc.CallOption) (*AgentStatusResponse, error) {
+	out := new(AgentStatusResponse)
+	err := c.cc.Invoke(ctx, AgentService_Status_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) List(ctx context.Context, in *AgentListRequest, opts ...grpc.CallOption) (*AgentListResponse, error) {
+	out := new(AgentListResponse)
+	err := c.cc.Invoke(ctx, AgentService_List_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) Get(ctx context.Context, in *AgentGetRequest, opts ...grpc.CallOption) (*AgentGetResponse, error) {
+	out := new(AgentGetResponse)
+	err := c.cc.Invoke(ctx, AgentService_Get_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) Unlock(ctx context.Context, in *AgentUnlockRequest, opts ...grpc.CallOption) (*AgentUnlockResponse, error) {
+	out := new(AgentUnlockResponse)
+	err := c.cc.Invoke(ctx, AgentService_Unlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) Lock(ctx context.Context, in *AgentLockRequest, opts ...grpc.CallOption) (*AgentLockResponse, error) {
+	out := new(AgentLockResponse)
+	err := c.cc.Invoke(ctx, AgentService_Lock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) Stop(ctx context.Context, in *AgentStopRequest, opts ...grpc.CallOption) (*AgentStopResponse, error) {
+	out := new(AgentStopResponse)
+	err := c.cc.Invoke(ctx, AgentService_Stop_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AgentServiceServer is the server API for AgentService service.
+// All implementations must embed UnimplementedAgentServiceServer
+// for forward compatibility
+type AgentServiceServer interface {
+	Status(context.Context, *AgentStatusRequest) (*AgentStatusResponse, error)
+	List(context.Context, *AgentListRequest) (*AgentListResponse, error)
+	Get(context.Context, *AgentGetRequest) (*AgentGetResponse, error)
+	Unlock(context.Context, *AgentUnlockRequest) (*AgentUnlockResponse, error)
+	Lock(context.Context, *AgentLockRequest) (*AgentLockResponse, error)
+	Stop(context.Context, *AgentStopRequest) (*AgentStopResponse, error)
+	mustEmbedUnimplementedAgentServiceServer()
+}
+
+// UnimplementedAgentServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAgentServiceServer struct {
+}
+
+func (UnimplementedAgentServiceServer) Status(context.Context, *AgentStatusRequest) (*AgentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
+func (UnimplementedAgentServiceServer) List(context.Context, *AgentListRequest) (*AgentListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedAgentServiceServer) Get(context.Context, *AgentGetRequest) (*AgentGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedAgentServiceServer) Unlock(context.Context, *AgentUnlockRequest) (*AgentUnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+}
+func (UnimplementedAgentServiceServer) Lock(context.Context, *AgentLockRequest) (*AgentLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+}
+func (UnimplementedAgentServiceServer) Stop(context.Context, *AgentStopRequest) (*AgentStopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
+}
+func (UnimplementedAgentServiceServer) mustEmbedUnimplementedAgentServiceServer() {}
+
+// UnsafeAgentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AgentServiceServer will
+// result in compilation errors.
+type UnsafeAgentServiceServer interface {
+	mustEmbedUnimplementedAgentServiceServer()
+}
+
+func RegisterAgentServiceServer(s grpc.ServiceRegistrar, srv AgentServiceServer) {
+	s.RegisterService(&AgentService_ServiceDesc, srv)
+}
+
+func _AgentService_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_Status_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).Status(ctx, req.(*AgentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).List(ctx, req.(*AgentListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).Get(ctx, req.(*AgentGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentUnlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).Unlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_Unlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).Unlock(ctx, req.(*AgentUnlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).Lock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_Lock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).Lock(ctx, req.(*AgentLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentStopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).Stop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_Stop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).Stop(ctx, req.(*AgentStopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AgentService_ServiceDesc is the grpc.ServiceDesc for AgentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AgentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.AgentService",
+	HandlerType: (*AgentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Status",
+			Handler:    _AgentService_Status_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _AgentService_List_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _AgentService_Get_Handler,
+		},
+		{
+			MethodName: "Unlock",
+			Handler:    _AgentService_Unlock_Handler,
+		},
+		{
+			MethodName: "Lock",
+			Handler:    _AgentService_Lock_Handler,
+		},
+		{
+			MethodName: "Stop",
+			Handler:    _AgentService_Stop_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/proto/gophkeeper.proto",
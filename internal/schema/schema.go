@@ -1,11 +1,22 @@
 // Package schema - содержит структуры совместно используемые разными пакетами приложения
 package schema
 
+import "time"
+
+// Способы, которыми клиент получает из мастер-пароля значение, передаваемое серверу как пароль.
+const (
+	// ClientKDFNone - клиент передает мастер-пароль как есть (учетные записи старых версий клиента).
+	ClientKDFNone = "none"
+	// ClientKDFArgon2id - клиент передает ключ аутентификации, полученный из мастер-пароля через Argon2id.
+	ClientKDFArgon2id = "argon2id"
+)
+
 // User представляет структуру данных пользователя
 type User struct {
-	ID       int64  `json:"id"`
-	Username string `json:"username"`
-	Password string `json:"password"`
+	ID        int64  `json:"id"`
+	Username  string `json:"username"`
+	Password  string `json:"password"`
+	ClientKDF string `json:"clientKdf"`
 }
 
 // InfoCell представляет структуру данных информационной ячейки
@@ -15,6 +26,68 @@ type InfoCell struct {
 	DataSize    int32  `json:"dataSize"`
 	Description string `json:"description"`
 	OwnerID     int64  `json:"ownerId"`
+	Revision    int64  `json:"revision"`
+	// DeletedAt - время перемещения данных в корзину, nil для данных вне корзины.
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+	// FolderID - ID папки с данными, 0 для данных вне папок.
+	FolderID  int64     `json:"folderId"`
+	Tags      []string  `json:"tags"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Порядки сортировки информации о данных.
+const (
+	SortByID          = "id"
+	SortByCreated     = "created"
+	SortByUpdated     = "updated"
+	SortByDescription = "description"
+)
+
+// InfoFilter описывает условия отбора информации о данных. Пустые условия не применяются.
+type InfoFilter struct {
+	DataTypes []string `json:"dataTypes,omitempty"`
+	// Text - подстрока описания, регистр не учитывается.
+	Text          string    `json:"text,omitempty"`
+	CreatedAfter  time.Time `json:"createdAfter,omitempty"`
+	CreatedBefore time.Time `json:"createdBefore,omitempty"`
+	UpdatedAfter  time.Time `json:"updatedAfter,omitempty"`
+	UpdatedBefore time.Time `json:"updatedBefore,omitempty"`
+	// Tags - метки, которыми должны быть отмечены данные, все одновременно.
+	Tags []string `json:"tags,omitempty"`
+}
+
+// InfoQuery описывает запрос информации о данных: условия отбора, порядок сортировки и размер страницы.
+type InfoQuery struct {
+	Filter     InfoFilter
+	Sort       string
+	Descending bool
+	// Limit - наибольшее количество записей, 0 - без ограничения.
+	Limit int
+	// After - позиция последней записи предыдущей страницы, nil для первой страницы.
+	After *InfoCursor
+}
+
+// InfoCursor описывает позицию записи в порядке сортировки: значение поля сортировки и ID записи.
+type InfoCursor struct {
+	Time time.Time `json:"t,omitempty"`
+	Text string    `json:"x,omitempty"`
+	ID   int64     `json:"i"`
+}
+
+// Folder представляет папку пользователя. Папки вкладываются друг в друга и образуют дерево.
+type Folder struct {
+	ID      int64 `json:"id"`
+	OwnerID int64 `json:"ownerId"`
+	// ParentID - ID родительской папки, 0 для папок верхнего уровня.
+	ParentID int64  `json:"parentId"`
+	Name     string `json:"name"`
+}
+
+// Tag представляет метку данных и количество отмеченных ею данных
+type Tag struct {
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
 }
 
 // MemoryCell представляет структуру данных ячейки памяти
@@ -26,4 +99,92 @@ type MemoryCell struct {
 	KeyValuePairs map[string]string `json:"keyValuePairs"`
 	BinaryData    []byte            `json:"binaryData"`
 	FileName      string            `json:"fileName"`
+	Blob          bool              `json:"blob"`
+	Payload       *Payload          `json:"payload"`
+}
+
+// Payload представляет содержимое данных, зависящее от вида данных. Заполняется не более одного поля.
+// У данных, сохраненных до появления видов данных, содержимого нет.
+type Payload struct {
+	Login *LoginPayload `json:"login,omitempty"`
+	Card  *CardPayload  `json:"card,omitempty"`
+	Note  *NotePayload  `json:"note,omitempty"`
+	File  *FilePayload  `json:"file,omitempty"`
+	OTP   *OTPPayload   `json:"otp,omitempty"`
+}
+
+// LoginPayload представляет логин и пароль от сайта
+type LoginPayload struct {
+	URL      string `json:"url"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// CardPayload представляет данные банковской карты
+type CardPayload struct {
+	Number string `json:"number"`
+	Holder string `json:"holder"`
+	Expiry string `json:"expiry"`
+	CVV    string `json:"cvv"`
+}
+
+// NotePayload представляет текстовую заметку
+type NotePayload struct {
+	Text string `json:"text"`
+}
+
+// FilePayload представляет сведения о файле, сам файл хранится в ячейке памяти
+type FilePayload struct {
+	MediaType string `json:"mediaType"`
+}
+
+// OTPPayload представляет параметры генерации одноразовых кодов. Числовые параметры хранятся строками,
+// чтобы шифроваться клиентом вместе с остальными полями
+type OTPPayload struct {
+	Type      string `json:"type"`
+	Issuer    string `json:"issuer"`
+	Account   string `json:"account"`
+	Secret    string `json:"secret"`
+	Algorithm string `json:"algorithm"`
+	Digits    string `json:"digits"`
+	Period    string `json:"period"`
+	Counter   string `json:"counter"`
+}
+
+// Changes описывает изменения данных пользователя после указанной ревизии
+type Changes struct {
+	Revision   int64         `json:"revision"`
+	Changed    []*MemoryCell `json:"changed"`
+	DeletedIDs []int64       `json:"deletedIds"`
+}
+
+// Version описывает предыдущую версию данных, сохраненную при их изменении
+type Version struct {
+	Revision   int64       `json:"revision"`
+	ReplacedAt time.Time   `json:"replacedAt"`
+	Cell       *MemoryCell `json:"cell"`
+}
+
+// Blob описывает большие бинарные данные, загружаемые потоком отдельно от ячейки памяти
+type Blob struct {
+	InfoID    int64  `json:"infoId"`
+	Size      int64  `json:"size"`
+	TotalSize int64  `json:"totalSize"`
+	SHA256    string `json:"sha256"`
+	Complete  bool   `json:"complete"`
+}
+
+// Session представляет сессию пользователя, в рамках которой выдаются токены доступа
+type Session struct {
+	ID               string     `json:"id"`
+	UserID           int64      `json:"userId"`
+	RefreshTokenHash string     `json:"-"`
+	CreatedAt        time.Time  `json:"createdAt"`
+	ExpiresAt        time.Time  `json:"expiresAt"`
+	RevokedAt        *time.Time `json:"revokedAt"`
+}
+
+// Active сообщает, что сессия не отозвана и не истекла на момент now.
+func (s *Session) Active(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
 }
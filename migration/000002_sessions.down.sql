@@ -0,0 +1,3 @@
+-- Файл миграции для отката изменений
+
+DROP TABLE IF EXISTS sessions;
@@ -0,0 +1,3 @@
+-- Файл миграции для отката изменений
+
+ALTER TABLE users DROP COLUMN IF EXISTS client_kdf;
@@ -0,0 +1,4 @@
+-- Файл миграции: способ получения пароля из мастер-пароля на клиенте.
+-- Существующие пользователи передают мастер-пароль как есть и переводятся на ключ аутентификации при входе.
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS client_kdf VARCHAR(32) NOT NULL DEFAULT 'none';
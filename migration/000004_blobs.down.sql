@@ -0,0 +1,5 @@
+-- Файл миграции для отката изменений
+
+DROP TABLE IF EXISTS blob_chunks;
+
+DROP TABLE IF EXISTS blobs;
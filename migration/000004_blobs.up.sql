@@ -0,0 +1,19 @@
+-- Файл миграции для хранения больших бинарных данных, загружаемых частями
+
+CREATE TABLE IF NOT EXISTS blobs (
+  info_id INT PRIMARY KEY,
+  total_size BIGINT NOT NULL,
+  sha256 VARCHAR(64) NOT NULL,
+  size BIGINT NOT NULL DEFAULT 0,
+  complete BOOLEAN NOT NULL DEFAULT FALSE,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (info_id) REFERENCES info_cells (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS blob_chunks (
+  info_id INT NOT NULL,
+  chunk_offset BIGINT NOT NULL,
+  data BYTEA NOT NULL,
+  PRIMARY KEY (info_id, chunk_offset),
+  FOREIGN KEY (info_id) REFERENCES blobs (info_id) ON DELETE CASCADE
+);
@@ -0,0 +1,11 @@
+-- Файл миграции для отката изменений
+
+DROP TABLE IF EXISTS deleted_cells;
+
+DROP INDEX IF EXISTS info_cells_owner_revision_idx;
+
+ALTER TABLE memory_cells DROP COLUMN IF EXISTS revision;
+
+ALTER TABLE info_cells DROP COLUMN IF EXISTS revision;
+
+ALTER TABLE users DROP COLUMN IF EXISTS revision;
@@ -0,0 +1,27 @@
+-- Файл миграции для инкрементальной синхронизации по ревизиям данных пользователя
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS revision BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE info_cells ADD COLUMN IF NOT EXISTS revision BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE memory_cells ADD COLUMN IF NOT EXISTS revision BIGINT NOT NULL DEFAULT 0;
+
+-- Существующие данные получают первую ревизию, чтобы попасть в синхронизацию с нуля
+UPDATE info_cells SET revision = 1;
+
+UPDATE memory_cells SET revision = 1;
+
+UPDATE users SET revision = 1;
+
+CREATE INDEX IF NOT EXISTS info_cells_owner_revision_idx ON info_cells (owner_id, revision);
+
+-- Записи об удаленных данных, чтобы клиенты могли удалить их из локальной копии
+CREATE TABLE IF NOT EXISTS deleted_cells (
+  info_id INT PRIMARY KEY,
+  owner_id INT NOT NULL,
+  revision BIGINT NOT NULL,
+  deleted_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (owner_id) REFERENCES users (id)
+);
+
+CREATE INDEX IF NOT EXISTS deleted_cells_owner_revision_idx ON deleted_cells (owner_id, revision);
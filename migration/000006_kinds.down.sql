@@ -0,0 +1,4 @@
+-- Файл миграции для отката изменений
+-- Исходные названия типов данных не восстанавливаются
+
+ALTER TABLE memory_cells DROP COLUMN IF EXISTS payload;
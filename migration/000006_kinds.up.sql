@@ -0,0 +1,33 @@
+-- Файл миграции для видов данных с типизированным содержимым
+
+ALTER TABLE memory_cells ADD COLUMN IF NOT EXISTS payload JSONB;
+
+-- Произвольный тип данных заменяется видом данных: известные названия приводятся к виду,
+-- данные с файлом становятся файлами, остальные - заметками
+CREATE TEMPORARY TABLE kind_changes AS
+SELECT i.id, i.owner_id,
+  CASE
+    WHEN lower(i.data_type) IN ('login', 'credentials', 'password', 'логин', 'пароль') THEN 'login'
+    WHEN lower(i.data_type) IN ('card', 'bank', 'bank card', 'bankcard', 'карта') THEN 'card'
+    WHEN lower(i.data_type) IN ('note', 'text', 'заметка', 'текст') THEN 'note'
+    WHEN lower(i.data_type) IN ('file', 'binary', 'файл') OR COALESCE(m.file_name, '') <> '' THEN 'file'
+    ELSE 'note'
+  END AS kind
+FROM info_cells i
+LEFT JOIN memory_cells m ON m.info_id = i.id
+WHERE i.data_type NOT IN ('login', 'card', 'note', 'file');
+
+UPDATE info_cells i SET data_type = c.kind FROM kind_changes c WHERE i.id = c.id;
+
+-- Измененные данные получают новую ревизию, чтобы клиенты получили их при синхронизации
+UPDATE users u SET revision = u.revision + 1 WHERE u.id IN (SELECT owner_id FROM kind_changes);
+
+UPDATE info_cells i SET revision = u.revision
+FROM kind_changes c, users u
+WHERE i.id = c.id AND u.id = c.owner_id;
+
+UPDATE memory_cells m SET revision = i.revision
+FROM kind_changes c, info_cells i
+WHERE m.info_id = c.id AND i.id = c.id;
+
+DROP TABLE kind_changes;
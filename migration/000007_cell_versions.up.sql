@@ -0,0 +1,17 @@
+-- Файл миграции для хранения предыдущих версий данных
+
+CREATE TABLE IF NOT EXISTS cell_versions (
+  info_id INT NOT NULL,
+  revision BIGINT NOT NULL,
+  data_type VARCHAR(255) NOT NULL,
+  data_size INT NOT NULL,
+  description TEXT,
+  encrypted BOOLEAN NOT NULL,
+  key_value_pairs JSONB,
+  binary_data BYTEA,
+  file_name VARCHAR(255),
+  payload JSONB,
+  replaced_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (info_id, revision),
+  FOREIGN KEY (info_id) REFERENCES info_cells (id) ON DELETE CASCADE
+);
@@ -0,0 +1,13 @@
+-- Файл миграции для отката изменений
+-- Данные из корзины удаляются окончательно
+
+DELETE FROM info_cells WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE memory_cells DROP CONSTRAINT IF EXISTS memory_cells_info_id_fkey;
+
+ALTER TABLE memory_cells ADD CONSTRAINT memory_cells_info_id_fkey
+  FOREIGN KEY (info_id) REFERENCES info_cells (id);
+
+DROP INDEX IF EXISTS info_cells_deleted_at_idx;
+
+ALTER TABLE info_cells DROP COLUMN IF EXISTS deleted_at;
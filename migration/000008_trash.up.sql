@@ -0,0 +1,11 @@
+-- Файл миграции для корзины: удаленные данные хранятся до окончательной очистки
+
+ALTER TABLE info_cells ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS info_cells_deleted_at_idx ON info_cells (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- Ячейки памяти удаляются вместе с информационными ячейками при очистке корзины
+ALTER TABLE memory_cells DROP CONSTRAINT IF EXISTS memory_cells_info_id_fkey;
+
+ALTER TABLE memory_cells ADD CONSTRAINT memory_cells_info_id_fkey
+  FOREIGN KEY (info_id) REFERENCES info_cells (id) ON DELETE CASCADE;
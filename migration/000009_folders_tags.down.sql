@@ -0,0 +1,11 @@
+-- Файл миграции для отката изменений
+
+DROP TABLE IF EXISTS cell_tags;
+
+DROP TABLE IF EXISTS tags;
+
+DROP INDEX IF EXISTS info_cells_folder_id_idx;
+
+ALTER TABLE info_cells DROP COLUMN IF EXISTS folder_id;
+
+DROP TABLE IF EXISTS folders;
@@ -0,0 +1,36 @@
+-- Файл миграции для папок и меток данных
+
+CREATE TABLE IF NOT EXISTS folders (
+  id SERIAL PRIMARY KEY,
+  owner_id INT NOT NULL,
+  parent_id INT,
+  name VARCHAR(255) NOT NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (owner_id) REFERENCES users (id),
+  FOREIGN KEY (parent_id) REFERENCES folders (id) ON DELETE CASCADE
+);
+
+-- Имена папок уникальны среди папок с общим родителем
+CREATE UNIQUE INDEX IF NOT EXISTS folders_owner_parent_name_idx ON folders (owner_id, COALESCE(parent_id, 0), name);
+
+ALTER TABLE info_cells ADD COLUMN IF NOT EXISTS folder_id INT REFERENCES folders (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS info_cells_folder_id_idx ON info_cells (folder_id);
+
+CREATE TABLE IF NOT EXISTS tags (
+  id SERIAL PRIMARY KEY,
+  owner_id INT NOT NULL,
+  name VARCHAR(64) NOT NULL,
+  UNIQUE (owner_id, name),
+  FOREIGN KEY (owner_id) REFERENCES users (id)
+);
+
+CREATE TABLE IF NOT EXISTS cell_tags (
+  info_id INT NOT NULL,
+  tag_id INT NOT NULL,
+  PRIMARY KEY (info_id, tag_id),
+  FOREIGN KEY (info_id) REFERENCES info_cells (id) ON DELETE CASCADE,
+  FOREIGN KEY (tag_id) REFERENCES tags (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS cell_tags_tag_id_idx ON cell_tags (tag_id);
@@ -0,0 +1,17 @@
+-- Файл миграции для отката изменений
+
+DROP INDEX IF EXISTS info_cells_description_trgm_idx;
+
+DROP INDEX IF EXISTS info_cells_owner_data_type_idx;
+
+DROP INDEX IF EXISTS info_cells_owner_description_idx;
+
+DROP INDEX IF EXISTS info_cells_owner_updated_idx;
+
+DROP INDEX IF EXISTS info_cells_owner_created_idx;
+
+DROP INDEX IF EXISTS info_cells_owner_id_idx;
+
+ALTER TABLE info_cells DROP COLUMN IF EXISTS updated_at;
+
+ALTER TABLE info_cells ALTER COLUMN created_at DROP NOT NULL;
@@ -0,0 +1,30 @@
+-- Файл миграции для отбора, сортировки и постраничного получения информации о данных
+
+UPDATE info_cells SET created_at = CURRENT_TIMESTAMP WHERE created_at IS NULL;
+
+ALTER TABLE info_cells ALTER COLUMN created_at SET NOT NULL;
+
+-- Время последнего изменения данных обновляется вместе с ревизией
+ALTER TABLE info_cells ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP;
+
+UPDATE info_cells SET updated_at = created_at WHERE updated_at IS NULL;
+
+ALTER TABLE info_cells ALTER COLUMN updated_at SET DEFAULT CURRENT_TIMESTAMP;
+
+ALTER TABLE info_cells ALTER COLUMN updated_at SET NOT NULL;
+
+-- Индексы для постраничного получения данных вне корзины в каждом из порядков сортировки
+CREATE INDEX IF NOT EXISTS info_cells_owner_id_idx ON info_cells (owner_id, id) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS info_cells_owner_created_idx ON info_cells (owner_id, created_at, id) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS info_cells_owner_updated_idx ON info_cells (owner_id, updated_at, id) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS info_cells_owner_description_idx ON info_cells (owner_id, COALESCE(description, ''), id) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS info_cells_owner_data_type_idx ON info_cells (owner_id, data_type) WHERE deleted_at IS NULL;
+
+-- Индекс для поиска подстроки в описании без учета регистра
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS info_cells_description_trgm_idx ON info_cells USING GIN (description gin_trgm_ops);
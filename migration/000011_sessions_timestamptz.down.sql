@@ -0,0 +1,6 @@
+-- Файл миграции для отката изменений
+
+ALTER TABLE sessions
+  ALTER COLUMN created_at TYPE TIMESTAMP,
+  ALTER COLUMN expires_at TYPE TIMESTAMP,
+  ALTER COLUMN revoked_at TYPE TIMESTAMP;
@@ -0,0 +1,8 @@
+-- Файл миграции для хранения времени сессий с часовым поясом
+-- Время без пояса сравнивалось со временем сервера приложения как есть, и на сервере не в UTC
+-- сессии истекали раньше или позже срока. Сохраненные значения считаются временем в поясе сессии базы данных.
+
+ALTER TABLE sessions
+  ALTER COLUMN created_at TYPE TIMESTAMPTZ,
+  ALTER COLUMN expires_at TYPE TIMESTAMPTZ,
+  ALTER COLUMN revoked_at TYPE TIMESTAMPTZ;
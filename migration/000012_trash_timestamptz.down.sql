@@ -0,0 +1,3 @@
+-- Файл миграции для отката изменений
+
+ALTER TABLE info_cells ALTER COLUMN deleted_at TYPE TIMESTAMP;
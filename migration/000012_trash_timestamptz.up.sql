@@ -0,0 +1,5 @@
+-- Файл миграции для хранения времени перемещения в корзину с часовым поясом
+-- Время без пояса сравнивалось со временем сервера приложения как есть, и на сервере не в UTC
+-- данные удалялись из корзины раньше срока. Сохраненные значения считаются временем в поясе сессии базы данных.
+
+ALTER TABLE info_cells ALTER COLUMN deleted_at TYPE TIMESTAMPTZ;
@@ -0,0 +1,5 @@
+-- Файл миграции для отката изменений
+
+ALTER TABLE info_cells
+  ALTER COLUMN created_at TYPE TIMESTAMP,
+  ALTER COLUMN updated_at TYPE TIMESTAMP;
@@ -0,0 +1,8 @@
+-- Файл миграции для хранения времени создания и изменения данных с часовым поясом
+-- Время без пояса сравнивалось со временем сервера приложения как есть, и в базе данных не в UTC
+-- отбор по времени и постраничное получение в порядке времени смещались на разницу поясов.
+-- Сохраненные значения считаются временем в поясе сессии базы данных.
+
+ALTER TABLE info_cells
+  ALTER COLUMN created_at TYPE TIMESTAMPTZ,
+  ALTER COLUMN updated_at TYPE TIMESTAMPTZ;
@@ -0,0 +1,3 @@
+-- Файл миграции для отката изменений
+
+ALTER TABLE cell_versions ALTER COLUMN replaced_at TYPE TIMESTAMP;
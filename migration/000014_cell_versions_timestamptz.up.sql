@@ -0,0 +1,5 @@
+-- Файл миграции для хранения времени замены версии данных с часовым поясом
+-- Время без пояса возвращалось клиенту как время в UTC, и в базе данных не в UTC
+-- время замены версий смещалось на разницу поясов. Сохраненные значения считаются временем в поясе сессии базы данных.
+
+ALTER TABLE cell_versions ALTER COLUMN replaced_at TYPE TIMESTAMPTZ;
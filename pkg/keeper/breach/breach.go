@@ -0,0 +1,207 @@
+// Package breach - проверка паролей по базе утекших паролей в формате Have I Been Pwned "range".
+// Пароль хешируется SHA-1, а в базе ищутся только хеши с тем же префиксом из PrefixLength шестнадцатеричных символов,
+// поэтому удаленной базе (серверу) передается лишь префикс хеша, а не пароль или его хеш целиком (k-анонимность).
+package breach
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// PrefixLength - длина префикса хеша SHA-1, по которому запрашиваются хеши из базы.
+const PrefixLength = 5
+
+// hashLength - длина хеша SHA-1 в шестнадцатеричной записи.
+const hashLength = sha1.Size * 2
+
+// ErrPrefix возвращается, если префикс хеша не состоит из PrefixLength шестнадцатеричных символов.
+var ErrPrefix = fmt.Errorf("префикс хеша должен состоять из %d шестнадцатеричных символов", PrefixLength)
+
+// Source - база утекших паролей.
+type Source interface {
+	// Range - возвращает суффиксы хешей SHA-1 (в верхнем регистре, без префикса) с префиксом prefix
+	// и количество появлений пароля в утечках.
+	Range(prefix string) (map[string]int64, error)
+}
+
+// Hash - возвращает префикс и суффикс хеша SHA-1 пароля в верхнем регистре.
+func Hash(password string) (prefix, suffix string) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	return hash[:PrefixLength], hash[PrefixLength:]
+}
+
+// NormalizePrefix - проверяет префикс хеша и приводит его к верхнему регистру.
+func NormalizePrefix(prefix string) (string, error) {
+	if len(prefix) != PrefixLength || !isHex(prefix) {
+		return "", ErrPrefix
+	}
+	return strings.ToUpper(prefix), nil
+}
+
+// Count - возвращает, сколько раз пароль встречается в утечках по базе source, 0 - пароль в базе не найден.
+func Count(source Source, password string) (int64, error) {
+	prefix, suffix := Hash(password)
+	suffixes, err := source.Range(prefix)
+	if err != nil {
+		return 0, err
+	}
+	return suffixes[suffix], nil
+}
+
+// Open - открывает локальную базу: папку файлов диапазонов (Dir) или один файл с полными хешами (File).
+func Open(path string) (Source, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("база утечек недоступна: %w", err)
+	}
+	if info.IsDir() {
+		return Dir(path), nil
+	}
+	return File(path), nil
+}
+
+// Dir - база в виде папки с файлом на каждый префикс, как ее выгружает PwnedPasswordsDownloader:
+// файл называется префиксом ("21BD1" или "21BD1.txt") и содержит строки "СУФФИКС:КОЛИЧЕСТВО".
+// Отсутствие файла означает, что хешей с этим префиксом в базе нет.
+type Dir string
+
+// Range - читает файл диапазона с префиксом prefix.
+func (d Dir) Range(prefix string) (map[string]int64, error) {
+	prefix, err := NormalizePrefix(prefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range []string{prefix + ".txt", prefix, strings.ToLower(prefix) + ".txt", strings.ToLower(prefix)} {
+		file, err := os.Open(filepath.Join(string(d), name))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("ошибка чтения базы утечек: %w", err)
+		}
+		defer file.Close()
+		return readRange(file, "")
+	}
+	return map[string]int64{}, nil
+}
+
+// File - база одним файлом со строками "ХЕШ:КОЛИЧЕСТВО", упорядоченными по хешу.
+// Строки с префиксом ищутся двоичным поиском, поэтому файл не читается целиком.
+type File string
+
+// Range - ищет в файле строки с префиксом prefix.
+func (f File) Range(prefix string) (map[string]int64, error) {
+	prefix, err := NormalizePrefix(prefix)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(string(f))
+	if err != nil {
+		return nil, fmt.Errorf("ошибка чтения базы утечек: %w", err)
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("ошибка чтения базы утечек: %w", err)
+	}
+
+	// ищется наименьшее смещение, с которого первая целая строка не меньше префикса
+	low, high := int64(0), info.Size()
+	for low < high {
+		middle := low + (high-low)/2
+		reader, err := lineReader(file, middle)
+		if err != nil {
+			return nil, err
+		}
+		line, err := reader.ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("ошибка чтения базы утечек: %w", err)
+		}
+		if line == "" || strings.ToUpper(strings.TrimSpace(line)) >= prefix {
+			high = middle
+		} else {
+			low = middle + 1
+		}
+	}
+
+	reader, err := lineReader(file, low)
+	if err != nil {
+		return nil, err
+	}
+	return readRange(reader, prefix)
+}
+
+// lineReader - возвращает чтение файла с первой строки, начинающейся не раньше offset.
+func lineReader(file *os.File, offset int64) (*bufio.Reader, error) {
+	if offset == 0 {
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return nil, fmt.Errorf("ошибка чтения базы утечек: %w", err)
+		}
+		return bufio.NewReader(file), nil
+	}
+	// строка начинается с offset, если перед ним перевод строки
+	if _, err := file.Seek(offset-1, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("ошибка чтения базы утечек: %w", err)
+	}
+	reader := bufio.NewReader(file)
+	if _, err := reader.ReadString('\n'); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("ошибка чтения базы утечек: %w", err)
+	}
+	return reader, nil
+}
+
+// readRange - читает строки "ХЕШ:КОЛИЧЕСТВО". Если prefix не пустой, строки содержат полный хеш
+// и чтение заканчивается на первой строке с другим префиксом, иначе строки содержат суффиксы хешей.
+// Строки с нулевым количеством (дополнение ответа HIBP) пропускаются.
+func readRange(r io.Reader, prefix string) (map[string]int64, error) {
+	suffixes := make(map[string]int64)
+	length := hashLength - PrefixLength
+	if prefix != "" {
+		length = hashLength
+	}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		hash, count, ok := strings.Cut(line, ":")
+		hash = strings.ToUpper(hash)
+		if prefix != "" && !strings.HasPrefix(hash, prefix) {
+			break
+		}
+		if !ok || len(hash) != length || !isHex(hash) {
+			return nil, fmt.Errorf("некорректная строка базы утечек: %q", line)
+		}
+		n, err := strconv.ParseInt(strings.TrimSpace(count), 10, 64)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("некорректная строка базы утечек: %q", line)
+		}
+		if n > 0 {
+			suffixes[hash[len(prefix):]] += n
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка чтения базы утечек: %w", err)
+	}
+	return suffixes, nil
+}
+
+// isHex - сообщает, что строка состоит из шестнадцатеричных символов.
+func isHex(s string) bool {
+	for _, r := range s {
+		if !('0' <= r && r <= '9' || 'a' <= r && r <= 'f' || 'A' <= r && r <= 'F') {
+			return false
+		}
+	}
+	return true
+}
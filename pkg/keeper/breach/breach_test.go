@@ -0,0 +1,119 @@
+package breach_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/breach"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// breached - пароли базы и количество их появлений в утечках.
+var breached = map[string]int64{
+	"password":  9545824,
+	"password1": 2418984,
+	"123456":    37359195,
+	"qwerty":    10556095,
+}
+
+func TestHash(t *testing.T) {
+	prefix, suffix := breach.Hash("password")
+	assert.Equal(t, "5BAA6", prefix)
+	assert.Equal(t, "1E4C9B93F3F0682250B6CF8331B7EE68FD8", suffix)
+}
+
+func TestNormalizePrefix(t *testing.T) {
+	prefix, err := breach.NormalizePrefix("5baa6")
+	require.NoError(t, err)
+	assert.Equal(t, "5BAA6", prefix)
+
+	for _, prefix := range []string{"", "5BAA", "5BAA61", "5BAG6", "../.."} {
+		_, err := breach.NormalizePrefix(prefix)
+		assert.ErrorIs(t, err, breach.ErrPrefix, prefix)
+	}
+}
+
+func TestDir(t *testing.T) {
+	dir := t.TempDir()
+	files := make(map[string][]string)
+	for password, count := range breached {
+		prefix, suffix := breach.Hash(password)
+		files[prefix] = append(files[prefix], fmt.Sprintf("%s:%d", suffix, count))
+	}
+	for prefix, lines := range files {
+		// строки с нулевым количеством - дополнение ответа, их нужно пропустить
+		lines = append(lines, strings.Repeat("0", 35)+":0")
+		require.NoError(t, os.WriteFile(filepath.Join(dir, prefix+".txt"), []byte(strings.Join(lines, "\r\n")+"\r\n"), 0o600))
+	}
+
+	source, err := breach.Open(dir)
+	require.NoError(t, err)
+	assert.IsType(t, breach.Dir(""), source)
+	for password, count := range breached {
+		found, err := breach.Count(source, password)
+		require.NoError(t, err)
+		assert.Equal(t, count, found, password)
+	}
+
+	found, err := breach.Count(source, "x8#Lq2!vR7zT")
+	require.NoError(t, err)
+	assert.Zero(t, found)
+
+	prefix, _ := breach.Hash("password")
+	suffixes, err := source.Range(strings.ToLower(prefix))
+	require.NoError(t, err)
+	assert.Len(t, suffixes, 1)
+
+	_, err = source.Range("../../etc")
+	assert.ErrorIs(t, err, breach.ErrPrefix)
+}
+
+func TestFile(t *testing.T) {
+	var lines []string
+	for password, count := range breached {
+		prefix, suffix := breach.Hash(password)
+		lines = append(lines, fmt.Sprintf("%s%s:%d", prefix, suffix, count))
+	}
+	// соседние хеши с тем же и другими префиксами
+	prefix, _ := breach.Hash("password")
+	lines = append(lines,
+		prefix+strings.Repeat("0", 35)+":7",
+		prefix+strings.Repeat("F", 35)+":8",
+		"00000"+strings.Repeat("1", 35)+":1",
+		"FFFFF"+strings.Repeat("2", 35)+":2",
+	)
+	sort.Strings(lines)
+	path := filepath.Join(t.TempDir(), "pwned-passwords-sha1-ordered-by-hash.txt")
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0o600))
+
+	source, err := breach.Open(path)
+	require.NoError(t, err)
+	assert.IsType(t, breach.File(""), source)
+	for password, count := range breached {
+		found, err := breach.Count(source, password)
+		require.NoError(t, err)
+		assert.Equal(t, count, found, password)
+	}
+
+	suffixes, err := source.Range(prefix)
+	require.NoError(t, err)
+	assert.Len(t, suffixes, 3)
+	for _, prefix := range []string{"00000", "FFFFF"} {
+		suffixes, err := source.Range(prefix)
+		require.NoError(t, err)
+		assert.Len(t, suffixes, 1, prefix)
+	}
+	suffixes, err = source.Range("77777")
+	require.NoError(t, err)
+	assert.Empty(t, suffixes)
+}
+
+func TestOpen_Missing(t *testing.T) {
+	_, err := breach.Open(filepath.Join(t.TempDir(), "missing"))
+	assert.Error(t, err)
+}
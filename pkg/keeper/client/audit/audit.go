@@ -0,0 +1,197 @@
+// Package audit - проверка надежности данных пользователя на клиенте: слабые и повторяющиеся пароли,
+// пароли из известных утечек, давно не менявшиеся пароли и карты, срок действия которых истекает или истек.
+package audit
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bubu256/gophkeeper_pet/internal/kinds"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/breach"
+	"github.com/nbutton23/zxcvbn-go"
+)
+
+// MaxScore - наибольшая оценка надежности пароля по шкале zxcvbn.
+const MaxScore = 4
+
+// Options - пороги проверки.
+type Options struct {
+	// MinScore - пароль с оценкой zxcvbn ниже MinScore (от 0 до MaxScore) считается слабым.
+	MinScore int
+	// MaxAge - пароль, не менявшийся дольше MaxAge, рекомендуется сменить.
+	MaxAge time.Duration
+	// ExpiryWarning - за какое время до окончания срока действия карты о ней сообщается.
+	ExpiryWarning time.Duration
+}
+
+// DefaultOptions - возвращает пороги по умолчанию: оценка не ниже 3, пароли меняются раз в год, карты за 60 дней до окончания срока.
+func DefaultOptions() Options {
+	return Options{MinScore: 3, MaxAge: 365 * 24 * time.Hour, ExpiryWarning: 60 * 24 * time.Hour}
+}
+
+// Entry - расшифрованные данные, которые проверяются. Пустые поля не проверяются.
+type Entry struct {
+	ID          int64
+	Kind        string
+	Description string
+	URL         string
+	Username    string
+	Password    string
+	// Expiry - срок действия карты в формате kinds.ParseExpiry.
+	Expiry string
+	// UpdatedAt - время последнего изменения данных на сервере, нулевое для еще не отправленных данных.
+	// Пароль не менялся как минимум с этого времени.
+	UpdatedAt time.Time
+}
+
+// Item - данные, к которым относится замечание.
+type Item struct {
+	ID          int64
+	Kind        string
+	Description string
+}
+
+// WeakPassword - слабый пароль: оценка zxcvbn и энтропия в битах с учетом словарей и шаблонов.
+type WeakPassword struct {
+	Item
+	Score   int
+	Entropy float64
+}
+
+// BreachedPassword - пароль, найденный в базе утечек. Count - количество его появлений в утечках.
+type BreachedPassword struct {
+	Item
+	Count int64
+}
+
+// OldPassword - давно не менявшийся пароль.
+type OldPassword struct {
+	Item
+	UpdatedAt time.Time
+	Age       time.Duration
+}
+
+// ExpiringCard - карта, срок действия которой истекает в ближайшее время или уже истек.
+type ExpiringCard struct {
+	Item
+	Expiry  kinds.Expiry
+	Expired bool
+	// Left - время до окончания срока действия, 0 для истекших карт.
+	Left time.Duration
+}
+
+// Report - результат проверки. Списки упорядочены по InfoID, группы повторяющихся паролей - по InfoID первых данных.
+type Report struct {
+	// Checked - количество проверенных данных, Passwords - из них с паролями, Cards - с картами.
+	Checked   int
+	Passwords int
+	Cards     int
+	Weak      []WeakPassword
+	// Reused - группы данных с одинаковыми паролями.
+	Reused [][]Item
+	// Breached - пароли из известных утечек, заполняется CheckBreaches.
+	Breached []BreachedPassword
+	Old      []OldPassword
+	Expiring []ExpiringCard
+}
+
+// Problems - возвращает количество замечаний в отчете.
+func (r Report) Problems() int {
+	return len(r.Weak) + len(r.Reused) + len(r.Breached) + len(r.Old) + len(r.Expiring)
+}
+
+// CheckBreaches - проверяет пароли entries по базе утечек source и добавляет найденные в отчет.
+// Одинаковые пароли проверяются один раз.
+func (r *Report) CheckBreaches(entries []Entry, source breach.Source) error {
+	entries = append([]Entry(nil), entries...)
+	sort.Slice(entries, func(i, j int) bool { return entries[i].ID < entries[j].ID })
+
+	counts := make(map[string]int64)
+	r.Breached = nil
+	for _, entry := range entries {
+		if entry.Password == "" {
+			continue
+		}
+		count, ok := counts[entry.Password]
+		if !ok {
+			var err error
+			if count, err = breach.Count(source, entry.Password); err != nil {
+				return err
+			}
+			counts[entry.Password] = count
+		}
+		if count > 0 {
+			r.Breached = append(r.Breached, BreachedPassword{Item: Item{ID: entry.ID, Kind: entry.Kind, Description: entry.Description}, Count: count})
+		}
+	}
+	return nil
+}
+
+// Run - проверяет данные entries на момент now.
+func Run(entries []Entry, opts Options, now time.Time) Report {
+	entries = append([]Entry(nil), entries...)
+	sort.Slice(entries, func(i, j int) bool { return entries[i].ID < entries[j].ID })
+
+	report := Report{Checked: len(entries)}
+	byPassword := make(map[string][]Item)
+	var passwords []string
+	for _, entry := range entries {
+		item := Item{ID: entry.ID, Kind: entry.Kind, Description: entry.Description}
+
+		if entry.Password != "" {
+			report.Passwords++
+			strength := zxcvbn.PasswordStrength(entry.Password, userInputs(entry))
+			if strength.Score < opts.MinScore {
+				report.Weak = append(report.Weak, WeakPassword{Item: item, Score: strength.Score, Entropy: strength.Entropy})
+			}
+			if _, ok := byPassword[entry.Password]; !ok {
+				passwords = append(passwords, entry.Password)
+			}
+			byPassword[entry.Password] = append(byPassword[entry.Password], item)
+			if !entry.UpdatedAt.IsZero() && now.Sub(entry.UpdatedAt) > opts.MaxAge {
+				report.Old = append(report.Old, OldPassword{Item: item, UpdatedAt: entry.UpdatedAt, Age: now.Sub(entry.UpdatedAt)})
+			}
+		}
+
+		if entry.Expiry != "" {
+			expiry, err := kinds.ParseExpiry(entry.Expiry)
+			if err != nil {
+				continue
+			}
+			report.Cards++
+			left := expiry.End(now.Location()).Sub(now)
+			switch {
+			case expiry.Expired(now):
+				report.Expiring = append(report.Expiring, ExpiringCard{Item: item, Expiry: expiry, Expired: true})
+			case left <= opts.ExpiryWarning:
+				report.Expiring = append(report.Expiring, ExpiringCard{Item: item, Expiry: expiry, Left: left})
+			}
+		}
+	}
+
+	// пароли перебираются в порядке первого появления, поэтому группы упорядочены по InfoID первых данных
+	for _, password := range passwords {
+		if items := byPassword[password]; len(items) > 1 {
+			report.Reused = append(report.Reused, items)
+		}
+	}
+	return report
+}
+
+// userInputs - возвращает слова, связанные с данными: пароль, содержащий их, легче подобрать.
+func userInputs(entry Entry) []string {
+	inputs := strings.Fields(strings.ToLower(entry.Description))
+	if entry.Username != "" {
+		inputs = append(inputs, strings.ToLower(entry.Username))
+	}
+	if u, err := url.Parse(entry.URL); err == nil && u.Hostname() != "" {
+		host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+		inputs = append(inputs, host)
+		if name, _, ok := strings.Cut(host, "."); ok {
+			inputs = append(inputs, name)
+		}
+	}
+	return inputs
+}
@@ -0,0 +1,105 @@
+package audit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bubu256/gophkeeper_pet/internal/kinds"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/breach"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/client/audit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	now := time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC)
+	strong := "correct-Horse-battery-staple-91"
+	entries := []audit.Entry{
+		{ID: 4, Kind: kinds.Login, Description: "Форум", Password: strong, UpdatedAt: now.AddDate(-2, 0, 0)},
+		{ID: 1, Kind: kinds.Login, Description: "Почта", Password: "password1", UpdatedAt: now.AddDate(0, -1, 0)},
+		{ID: 2, Kind: kinds.Login, Description: "Банк", Password: strong, UpdatedAt: now.AddDate(0, -1, 0)},
+		{ID: 3, Kind: kinds.Card, Description: "Visa", Expiry: "11/26"},
+		{ID: 5, Kind: kinds.Card, Description: "Старая карта", Expiry: "09/26"},
+		{ID: 6, Kind: kinds.Card, Description: "Новая карта", Expiry: "12/30"},
+		{ID: 7, Kind: kinds.Note, Description: "Заметка"},
+		// пароль давно созданных данных недавно сменен
+		{ID: 8, Kind: kinds.Login, Description: "Облако", Password: "Tq7#mZ2!wK9pXv", UpdatedAt: now.AddDate(0, 0, -3)},
+		// новые данные, еще не отправленные на сервер, не имеют времени изменения
+		{ID: -1, Kind: kinds.Login, Description: "Новые", Password: "x8#Lq2!vR7zT"},
+	}
+
+	report := audit.Run(entries, audit.DefaultOptions(), now)
+	assert.Equal(t, 9, report.Checked)
+	assert.Equal(t, 5, report.Passwords)
+	assert.Equal(t, 3, report.Cards)
+
+	if assert.Len(t, report.Weak, 1) {
+		assert.Equal(t, int64(1), report.Weak[0].ID)
+		assert.Less(t, report.Weak[0].Score, 3)
+	}
+	assert.Equal(t, [][]audit.Item{{
+		{ID: 2, Kind: kinds.Login, Description: "Банк"},
+		{ID: 4, Kind: kinds.Login, Description: "Форум"},
+	}}, report.Reused)
+	if assert.Len(t, report.Old, 1) {
+		assert.Equal(t, int64(4), report.Old[0].ID)
+	}
+
+	assert.Equal(t, []audit.ExpiringCard{
+		{Item: audit.Item{ID: 3, Kind: kinds.Card, Description: "Visa"}, Expiry: kinds.Expiry{Year: 2026, Month: time.November}, Left: time.Date(2026, time.December, 1, 0, 0, 0, 0, time.UTC).Sub(now)},
+		{Item: audit.Item{ID: 5, Kind: kinds.Card, Description: "Старая карта"}, Expiry: kinds.Expiry{Year: 2026, Month: time.September}, Expired: true},
+	}, report.Expiring)
+	assert.Equal(t, 5, report.Problems())
+}
+
+func TestRun_UserInputs(t *testing.T) {
+	// пароль из имени пользователя и адреса сайта слабый, хотя длинный
+	entry := audit.Entry{ID: 1, Kind: kinds.Login, URL: "https://www.example.com", Username: "alice", Password: "alice-example"}
+	options := audit.DefaultOptions()
+
+	report := audit.Run([]audit.Entry{entry}, options, time.Now())
+	assert.Len(t, report.Weak, 1)
+
+	options.MinScore = 0
+	report = audit.Run([]audit.Entry{entry}, options, time.Now())
+	assert.Empty(t, report.Weak)
+	assert.Equal(t, 0, report.Problems())
+}
+
+// countingSource - база утечек в памяти, считает запросы диапазонов.
+type countingSource struct {
+	passwords map[string]int64
+	requests  int
+}
+
+func (s *countingSource) Range(prefix string) (map[string]int64, error) {
+	s.requests++
+	suffixes := make(map[string]int64)
+	for password, count := range s.passwords {
+		if p, suffix := breach.Hash(password); p == prefix {
+			suffixes[suffix] = count
+		}
+	}
+	return suffixes, nil
+}
+
+func TestReport_CheckBreaches(t *testing.T) {
+	entries := []audit.Entry{
+		{ID: 3, Kind: kinds.Login, Description: "Форум", Password: "password1"},
+		{ID: 1, Kind: kinds.Login, Description: "Почта", Password: "password1"},
+		{ID: 2, Kind: kinds.Login, Description: "Банк", Password: "x8#Lq2!vR7zT"},
+		{ID: 4, Kind: kinds.Note, Description: "Заметка"},
+	}
+	source := &countingSource{passwords: map[string]int64{"password1": 2418984}}
+
+	report := audit.Run(entries, audit.DefaultOptions(), time.Now())
+	problems := report.Problems()
+	require.NoError(t, report.CheckBreaches(entries, source))
+	assert.Equal(t, []audit.BreachedPassword{
+		{Item: audit.Item{ID: 1, Kind: kinds.Login, Description: "Почта"}, Count: 2418984},
+		{Item: audit.Item{ID: 3, Kind: kinds.Login, Description: "Форум"}, Count: 2418984},
+	}, report.Breached)
+	assert.Equal(t, problems+2, report.Problems())
+	// одинаковые пароли проверяются один раз
+	assert.Equal(t, 2, source.requests)
+}
@@ -0,0 +1,94 @@
+// Package kvpairs - операции над парами ключ-значение пользовательских данных: сравнение и трехстороннее слияние версий.
+package kvpairs
+
+import "sort"
+
+// Value - значение ключа в одной из версий. Present равно false, если ключа в версии нет.
+type Value struct {
+	Value   string
+	Present bool
+}
+
+// Conflict - ключ, по-разному измененный в обеих версиях относительно общей базовой версии.
+type Conflict struct {
+	Key    string
+	Base   Value
+	Mine   Value
+	Theirs Value
+}
+
+// Merge3 - выполняет трехстороннее слияние пар ключ-значение.
+// Ключ, измененный только в одной из версий относительно base, берется из нее;
+// ключ, одинаково измененный в обеих версиях, берется как есть. Ключи, измененные по-разному,
+// в результат не попадают и возвращаются в conflicts, отсортированными по имени.
+// Базовая версия nil равносильна пустой: ключ, который есть только в одной из версий, считается добавленным
+// в ней и берется из нее, конфликтом считается только ключ, который есть в обеих версиях с разными значениями.
+func Merge3(base, mine, theirs map[string]string) (merged map[string]string, conflicts []Conflict) {
+	merged = make(map[string]string)
+
+	for _, key := range keys(base, mine, theirs) {
+		b, m, t := lookup(base, key), lookup(mine, key), lookup(theirs, key)
+
+		var result Value
+		switch {
+		case m == t:
+			result = m
+		case m == b:
+			result = t
+		case t == b:
+			result = m
+		default:
+			conflicts = append(conflicts, Conflict{Key: key, Base: b, Mine: m, Theirs: t})
+			continue
+		}
+
+		if result.Present {
+			merged[key] = result.Value
+		}
+	}
+
+	return merged, conflicts
+}
+
+// Change - изменение ключа между двумя версиями.
+type Change struct {
+	Key  string
+	From Value
+	To   Value
+}
+
+// Diff - возвращает ключи, добавленные, удаленные или измененные в версии to относительно from,
+// отсортированные по имени.
+func Diff(from, to map[string]string) []Change {
+	var changes []Change
+	for _, key := range keys(from, to) {
+		f, t := lookup(from, key), lookup(to, key)
+		if f != t {
+			changes = append(changes, Change{Key: key, From: f, To: t})
+		}
+	}
+	return changes
+}
+
+// keys - возвращает отсортированное объединение ключей всех версий.
+func keys(versions ...map[string]string) []string {
+	set := make(map[string]struct{})
+	for _, version := range versions {
+		for key := range version {
+			set[key] = struct{}{}
+		}
+	}
+
+	result := make([]string, 0, len(set))
+	for key := range set {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// lookup - возвращает значение ключа в версии.
+func lookup(version map[string]string, key string) Value {
+	value, ok := version[key]
+	return Value{Value: value, Present: ok}
+}
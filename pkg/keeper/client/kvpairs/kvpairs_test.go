@@ -0,0 +1,72 @@
+package kvpairs_test
+
+import (
+	"testing"
+
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/client/kvpairs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMerge3(t *testing.T) {
+	base := map[string]string{"login": "user", "password": "old", "url": "example.com", "note": "text"}
+	mine := map[string]string{"login": "user", "password": "mine", "url": "example.com", "pin": "1234"}
+	theirs := map[string]string{"login": "admin", "password": "theirs", "url": "example.com", "note": "text"}
+
+	merged, conflicts := kvpairs.Merge3(base, mine, theirs)
+
+	// изменение только на сервере, удаление только у меня, добавление только у меня
+	assert.Equal(t, map[string]string{"login": "admin", "url": "example.com", "pin": "1234"}, merged)
+	// пароль изменен по-разному в обеих версиях
+	assert.Equal(t, []kvpairs.Conflict{{
+		Key:    "password",
+		Base:   kvpairs.Value{Value: "old", Present: true},
+		Mine:   kvpairs.Value{Value: "mine", Present: true},
+		Theirs: kvpairs.Value{Value: "theirs", Present: true},
+	}}, conflicts)
+}
+
+func TestMerge3_SameChange(t *testing.T) {
+	base := map[string]string{"password": "old"}
+	changed := map[string]string{"password": "new"}
+
+	merged, conflicts := kvpairs.Merge3(base, changed, changed)
+	assert.Equal(t, changed, merged)
+	assert.Empty(t, conflicts)
+}
+
+func TestMerge3_DeleteAndEdit(t *testing.T) {
+	base := map[string]string{"password": "old"}
+	mine := map[string]string{}
+	theirs := map[string]string{"password": "new"}
+
+	merged, conflicts := kvpairs.Merge3(base, mine, theirs)
+	assert.Empty(t, merged)
+	assert.Len(t, conflicts, 1)
+	assert.False(t, conflicts[0].Mine.Present)
+	assert.Equal(t, "new", conflicts[0].Theirs.Value)
+}
+
+func TestMerge3_UnknownBase(t *testing.T) {
+	mine := map[string]string{"login": "user", "password": "mine", "pin": "1234"}
+	theirs := map[string]string{"login": "user", "password": "theirs", "note": "text"}
+
+	// ключи, которые есть только в одной из версий, считаются добавленными в ней
+	merged, conflicts := kvpairs.Merge3(nil, mine, theirs)
+	assert.Equal(t, map[string]string{"login": "user", "pin": "1234", "note": "text"}, merged)
+	assert.Len(t, conflicts, 1)
+	assert.Equal(t, "password", conflicts[0].Key)
+}
+
+func TestDiff(t *testing.T) {
+	from := map[string]string{"login": "user", "password": "old", "note": "text"}
+	to := map[string]string{"login": "user", "password": "new", "pin": "1234"}
+
+	assert.Equal(t, []kvpairs.Change{
+		{Key: "note", From: kvpairs.Value{Value: "text", Present: true}},
+		{Key: "password", From: kvpairs.Value{Value: "old", Present: true}, To: kvpairs.Value{Value: "new", Present: true}},
+		{Key: "pin", To: kvpairs.Value{Value: "1234", Present: true}},
+	}, kvpairs.Diff(from, to))
+
+	assert.Empty(t, kvpairs.Diff(from, from))
+	assert.Empty(t, kvpairs.Diff(nil, map[string]string{}))
+}
@@ -0,0 +1,377 @@
+// Package passgen - генерация паролей и парольных фраз и оценка их энтропии.
+// Случайные значения берутся из crypto/rand, сервер для генерации не нужен.
+package passgen
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"unicode"
+)
+
+// Классы символов паролей.
+const (
+	Lower   = "abcdefghijklmnopqrstuvwxyz"
+	Upper   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	Digits  = "0123456789"
+	Symbols = "!#$%&*+-=?@^_~.,:;()[]{}<>/|"
+	// Ambiguous - символы, которые легко спутать при чтении и переписывании пароля.
+	Ambiguous = "Il1|O0o"
+)
+
+// Ограничения длины пароля и количества слов парольной фразы.
+const (
+	MinLength = 4
+	MaxLength = 128
+	MinWords  = 3
+	MaxWords  = 20
+	// otherPool - размер алфавита, принимаемый для символов вне классов Lower, Upper, Digits и Symbols.
+	otherPool = 33
+)
+
+var (
+	// ErrLength возвращается, если длина пароля вне допустимых пределов.
+	ErrLength = fmt.Errorf("длина пароля должна быть от %d до %d символов", MinLength, MaxLength)
+	// ErrNoClasses возвращается, если не выбран ни один класс символов.
+	ErrNoClasses = errors.New("не выбран ни один класс символов")
+	// ErrWords возвращается, если количество слов парольной фразы вне допустимых пределов.
+	ErrWords = fmt.Errorf("количество слов должно быть от %d до %d", MinWords, MaxWords)
+)
+
+// Options - параметры пароля.
+type Options struct {
+	Length  int
+	Lower   bool
+	Upper   bool
+	Digits  bool
+	Symbols bool
+	// ExcludeAmbiguous - не использовать символы из Ambiguous.
+	ExcludeAmbiguous bool
+	// Pronounceable - пароль из чередующихся согласных и гласных. Заглавная буква, символ и две цифры
+	// в конце добавляются, если выбраны классы Upper, Symbols и Digits.
+	Pronounceable bool
+}
+
+// DefaultOptions - возвращает параметры пароля по умолчанию: 20 символов всех классов.
+func DefaultOptions() Options {
+	return Options{Length: 20, Lower: true, Upper: true, Digits: true, Symbols: true}
+}
+
+// Password - генерирует пароль. Обычный пароль содержит хотя бы один символ каждого выбранного класса.
+func Password(opts Options) (string, error) {
+	if opts.Length < MinLength || opts.Length > MaxLength {
+		return "", ErrLength
+	}
+	if opts.Pronounceable {
+		return pronounceable(opts)
+	}
+
+	classes := opts.classes()
+	if len(classes) == 0 {
+		return "", ErrNoClasses
+	}
+	pool := strings.Join(classes, "")
+	for {
+		password, err := randomString(pool, opts.Length)
+		if err != nil {
+			return "", err
+		}
+		if opts.Length < len(classes) || containsAll(password, classes) {
+			return password, nil
+		}
+	}
+}
+
+// Entropy - возвращает энтропию пароля с параметрами opts в битах.
+func (opts Options) Entropy() float64 {
+	if opts.Pronounceable {
+		layout := opts.pronounceableLayout()
+		bits := 0.0
+		for i := 0; i < layout.letters; i++ {
+			if i%2 == 0 {
+				bits += math.Log2(float64(len(layout.consonants)))
+			} else {
+				bits += math.Log2(float64(len(layout.vowels)))
+			}
+		}
+		if opts.Upper {
+			bits += math.Log2(float64(layout.letters))
+		}
+		if layout.symbol {
+			bits += math.Log2(float64(len(opts.filter(Symbols))))
+		}
+		return bits + float64(layout.digits)*math.Log2(float64(len(opts.filter(Digits))))
+	}
+
+	pool := len(strings.Join(opts.classes(), ""))
+	if pool == 0 {
+		return 0
+	}
+	return float64(opts.Length) * math.Log2(float64(pool))
+}
+
+// classes - возвращает выбранные классы символов без неоднозначных символов, если они исключены.
+func (opts Options) classes() []string {
+	var classes []string
+	for _, class := range []struct {
+		chars    string
+		selected bool
+	}{{Lower, opts.Lower}, {Upper, opts.Upper}, {Digits, opts.Digits}, {Symbols, opts.Symbols}} {
+		if class.selected {
+			classes = append(classes, opts.filter(class.chars))
+		}
+	}
+	return classes
+}
+
+// filter - удаляет из набора символов неоднозначные символы, если они исключены.
+func (opts Options) filter(chars string) string {
+	if !opts.ExcludeAmbiguous {
+		return chars
+	}
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(Ambiguous, r) {
+			return -1
+		}
+		return r
+	}, chars)
+}
+
+// filterLetters - удаляет из набора букв неоднозначные символы, если они исключены. Если выбран класс Upper,
+// удаляются и буквы, заглавная форма которых неоднозначна (i и I), так как любая буква может стать заглавной.
+func (opts Options) filterLetters(chars string) string {
+	chars = opts.filter(chars)
+	if !opts.Upper {
+		return chars
+	}
+	return strings.Map(func(r rune) rune {
+		if opts.filter(string(unicode.ToUpper(r))) == "" {
+			return -1
+		}
+		return r
+	}, chars)
+}
+
+// layout - состав произносимого пароля.
+type layout struct {
+	consonants string
+	vowels     string
+	letters    int
+	symbol     bool
+	digits     int
+}
+
+// pronounceableLayout - возвращает состав произносимого пароля: буквы, затем символ и две цифры.
+// Согласные l, q и y не используются, так как плохо читаются в сочетаниях.
+func (opts Options) pronounceableLayout() layout {
+	l := layout{consonants: opts.filterLetters("bcdfghjkmnprstvwxz"), vowels: opts.filterLetters("aeiou"), letters: opts.Length}
+	if opts.Digits && l.letters-2 >= MinLength {
+		l.digits = 2
+		l.letters -= 2
+	}
+	if opts.Symbols && l.letters-1 >= MinLength {
+		l.symbol = true
+		l.letters--
+	}
+	return l
+}
+
+// pronounceable - генерирует пароль из чередующихся согласных и гласных.
+func pronounceable(opts Options) (string, error) {
+	l := opts.pronounceableLayout()
+	var b strings.Builder
+	for i := 0; i < l.letters; i++ {
+		chars := l.consonants
+		if i%2 == 1 {
+			chars = l.vowels
+		}
+		s, err := randomString(chars, 1)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(s)
+	}
+
+	letters := []rune(b.String())
+	if opts.Upper {
+		i, err := randomIndex(len(letters))
+		if err != nil {
+			return "", err
+		}
+		letters[i] = unicode.ToUpper(letters[i])
+	}
+	password := string(letters)
+	if l.symbol {
+		s, err := randomString(opts.filter(Symbols), 1)
+		if err != nil {
+			return "", err
+		}
+		password += s
+	}
+	digits, err := randomString(opts.filter(Digits), l.digits)
+	if err != nil {
+		return "", err
+	}
+	return password + digits, nil
+}
+
+// PassphraseOptions - параметры парольной фразы.
+type PassphraseOptions struct {
+	Words     int
+	Separator string
+	// Capitalize - начинать слова с заглавной буквы.
+	Capitalize bool
+	// Number - добавить цифру в конец случайного слова.
+	Number bool
+}
+
+// DefaultPassphraseOptions - возвращает параметры парольной фразы по умолчанию: 6 слов через дефис.
+func DefaultPassphraseOptions() PassphraseOptions {
+	return PassphraseOptions{Words: 6, Separator: "-"}
+}
+
+// Passphrase - генерирует парольную фразу из случайных слов встроенного списка (EFF large wordlist, 7776 слов).
+func Passphrase(opts PassphraseOptions) (string, error) {
+	if opts.Words < MinWords || opts.Words > MaxWords {
+		return "", ErrWords
+	}
+
+	words := make([]string, opts.Words)
+	for i := range words {
+		index, err := randomIndex(len(wordlist))
+		if err != nil {
+			return "", err
+		}
+		words[i] = wordlist[index]
+		if opts.Capitalize {
+			words[i] = strings.ToUpper(words[i][:1]) + words[i][1:]
+		}
+	}
+	if opts.Number {
+		word, err := randomIndex(len(words))
+		if err != nil {
+			return "", err
+		}
+		digit, err := randomString(Digits, 1)
+		if err != nil {
+			return "", err
+		}
+		words[word] += digit
+	}
+	return strings.Join(words, opts.Separator), nil
+}
+
+// Entropy - возвращает энтропию парольной фразы с параметрами opts в битах.
+func (opts PassphraseOptions) Entropy() float64 {
+	bits := float64(opts.Words) * math.Log2(float64(len(wordlist)))
+	if opts.Number && opts.Words > 0 {
+		bits += math.Log2(float64(len(Digits))) + math.Log2(float64(opts.Words))
+	}
+	return bits
+}
+
+// Estimate - оценивает энтропию произвольного пароля в битах по размеру алфавита использованных классов символов.
+// Повторяющиеся подряд символы не учитываются. Для паролей из словарных слов оценка завышена.
+func Estimate(password string) float64 {
+	pool := 0
+	for _, class := range []string{Lower, Upper, Digits, Symbols} {
+		if strings.ContainsAny(password, class) {
+			pool += len(class)
+		}
+	}
+	length, other := 0, false
+	var previous rune
+	for i, r := range password {
+		if !strings.ContainsRune(Lower+Upper+Digits+Symbols, r) {
+			other = true
+		}
+		if i == 0 || r != previous {
+			length++
+		}
+		previous = r
+	}
+	if other {
+		// прочие символы (буквы других алфавитов, пробелы, кавычки) считаются одним классом
+		pool += otherPool
+	}
+	if pool == 0 {
+		return 0
+	}
+	return float64(length) * math.Log2(float64(pool))
+}
+
+// Strength - надежность пароля.
+type Strength int
+
+const (
+	VeryWeak Strength = iota
+	Weak
+	Fair
+	Strong
+	VeryStrong
+)
+
+// StrengthOf - возвращает надежность пароля по его энтропии в битах.
+func StrengthOf(bits float64) Strength {
+	switch {
+	case bits < 28:
+		return VeryWeak
+	case bits < 36:
+		return Weak
+	case bits < 60:
+		return Fair
+	case bits < 128:
+		return Strong
+	}
+	return VeryStrong
+}
+
+func (s Strength) String() string {
+	switch s {
+	case VeryWeak:
+		return "очень слабый"
+	case Weak:
+		return "слабый"
+	case Fair:
+		return "средний"
+	case Strong:
+		return "надежный"
+	}
+	return "очень надежный"
+}
+
+// randomIndex - возвращает равномерно распределенное случайное число от 0 до n-1.
+func randomIndex(n int) (int, error) {
+	index, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, fmt.Errorf("ошибка генератора случайных чисел: %w", err)
+	}
+	return int(index.Int64()), nil
+}
+
+// randomString - возвращает строку из length случайных символов набора chars.
+func randomString(chars string, length int) (string, error) {
+	runes := []rune(chars)
+	result := make([]rune, length)
+	for i := range result {
+		index, err := randomIndex(len(runes))
+		if err != nil {
+			return "", err
+		}
+		result[i] = runes[index]
+	}
+	return string(result), nil
+}
+
+// containsAll - сообщает, что строка содержит хотя бы один символ каждого класса.
+func containsAll(s string, classes []string) bool {
+	for _, class := range classes {
+		if !strings.ContainsAny(s, class) {
+			return false
+		}
+	}
+	return true
+}
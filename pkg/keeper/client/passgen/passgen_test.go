@@ -0,0 +1,107 @@
+package passgen_test
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/client/passgen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPassword(t *testing.T) {
+	opts := passgen.DefaultOptions()
+	password, err := passgen.Password(opts)
+	require.NoError(t, err)
+	assert.Len(t, password, opts.Length)
+	// каждый выбранный класс символов присутствует в пароле
+	for _, class := range []string{passgen.Lower, passgen.Upper, passgen.Digits, passgen.Symbols} {
+		assert.True(t, strings.ContainsAny(password, class), "пароль %q без символов %q", password, class)
+	}
+
+	other, err := passgen.Password(opts)
+	require.NoError(t, err)
+	assert.NotEqual(t, password, other)
+}
+
+func TestPassword_Classes(t *testing.T) {
+	password, err := passgen.Password(passgen.Options{Length: 64, Digits: true, ExcludeAmbiguous: true})
+	require.NoError(t, err)
+	assert.Regexp(t, regexp.MustCompile(`^[2-9]{64}$`), password)
+
+	password, err = passgen.Password(passgen.Options{Length: 128, Lower: true, Upper: true, ExcludeAmbiguous: true})
+	require.NoError(t, err)
+	assert.False(t, strings.ContainsAny(password, passgen.Ambiguous))
+}
+
+func TestPassword_Errors(t *testing.T) {
+	_, err := passgen.Password(passgen.Options{Length: 3, Lower: true})
+	assert.ErrorIs(t, err, passgen.ErrLength)
+	_, err = passgen.Password(passgen.Options{Length: 200, Lower: true})
+	assert.ErrorIs(t, err, passgen.ErrLength)
+	_, err = passgen.Password(passgen.Options{Length: 10})
+	assert.ErrorIs(t, err, passgen.ErrNoClasses)
+	_, err = passgen.Passphrase(passgen.PassphraseOptions{Words: 2})
+	assert.ErrorIs(t, err, passgen.ErrWords)
+}
+
+func TestPassword_Pronounceable(t *testing.T) {
+	opts := passgen.Options{Length: 12, Pronounceable: true}
+	password, err := passgen.Password(opts)
+	require.NoError(t, err)
+	assert.Regexp(t, regexp.MustCompile(`^([bcdfghjkmnprstvwxz][aeiou]){6}$`), password)
+	assert.InDelta(t, 6*math.Log2(18)+6*math.Log2(5), opts.Entropy(), 0.001)
+
+	opts = passgen.Options{Length: 12, Pronounceable: true, Upper: true, Digits: true, Symbols: true}
+	password, err = passgen.Password(opts)
+	require.NoError(t, err)
+	assert.Len(t, password, 12)
+	assert.Regexp(t, regexp.MustCompile(`^[a-zA-Z]{9}[^a-zA-Z0-9][0-9]{2}$`), password)
+	assert.Regexp(t, regexp.MustCompile(`[A-Z]`), password)
+
+	// заглавная буква тоже не должна быть неоднозначной: i не используется, так как может стать I
+	opts = passgen.Options{Length: 12, Pronounceable: true, Upper: true, ExcludeAmbiguous: true}
+	for i := 0; i < 200; i++ {
+		password, err = passgen.Password(opts)
+		require.NoError(t, err)
+		assert.False(t, strings.ContainsAny(password, passgen.Ambiguous), password)
+	}
+	assert.InDelta(t, 6*math.Log2(18)+6*math.Log2(3)+math.Log2(12), opts.Entropy(), 0.001)
+}
+
+func TestPassphrase(t *testing.T) {
+	opts := passgen.DefaultPassphraseOptions()
+	phrase, err := passgen.Passphrase(opts)
+	require.NoError(t, err)
+	words := strings.Split(phrase, "-")
+	assert.Len(t, words, 6)
+	for _, word := range words {
+		assert.Regexp(t, regexp.MustCompile(`^[a-z]+$`), word)
+	}
+	// 7776 слов списка EFF: 12.9 бит на слово
+	assert.InDelta(t, 6*math.Log2(7776), opts.Entropy(), 0.001)
+
+	phrase, err = passgen.Passphrase(passgen.PassphraseOptions{Words: 4, Separator: " ", Capitalize: true, Number: true})
+	require.NoError(t, err)
+	assert.Regexp(t, regexp.MustCompile(`^[A-Z][a-z]*\d? [A-Z][a-z]*\d? [A-Z][a-z]*\d? [A-Z][a-z]*\d?$`), phrase)
+	assert.Regexp(t, regexp.MustCompile(`\d`), phrase)
+}
+
+func TestEntropy(t *testing.T) {
+	assert.InDelta(t, 20*math.Log2(90), passgen.DefaultOptions().Entropy(), 0.001)
+	assert.InDelta(t, 10*math.Log2(8), passgen.Options{Length: 10, Digits: true, ExcludeAmbiguous: true}.Entropy(), 0.001)
+}
+
+func TestEstimate(t *testing.T) {
+	assert.Equal(t, 0.0, passgen.Estimate(""))
+	assert.InDelta(t, 6*math.Log2(10), passgen.Estimate("123456"), 0.001)
+	// повторяющиеся подряд символы не добавляют энтропии
+	assert.InDelta(t, passgen.Estimate("ab"), passgen.Estimate("aaabbb"), 0.001)
+	assert.Greater(t, passgen.Estimate("Tr0ub4dor&3"), passgen.Estimate("troubador"))
+
+	assert.Equal(t, passgen.VeryWeak, passgen.StrengthOf(passgen.Estimate("123456")))
+	assert.Equal(t, passgen.VeryStrong, passgen.StrengthOf(passgen.DefaultOptions().Entropy()))
+	assert.Equal(t, "надежный", passgen.Strong.String())
+}
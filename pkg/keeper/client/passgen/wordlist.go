@@ -0,0 +1,25 @@
+package passgen
+
+import (
+	_ "embed"
+	"strings"
+)
+
+// wordlistFile - список слов EFF для парольных фраз (https://www.eff.org/dice): номер броска пяти костей и слово через табуляцию.
+//
+//go:embed eff_large_wordlist.txt
+var wordlistFile string
+
+// wordlist - слова списка EFF.
+var wordlist = parseWordlist(wordlistFile)
+
+// parseWordlist - возвращает слова из списка в формате EFF.
+func parseWordlist(file string) []string {
+	var words []string
+	for _, line := range strings.Split(file, "\n") {
+		if fields := strings.Fields(line); len(fields) == 2 {
+			words = append(words, fields[1])
+		}
+	}
+	return words
+}
@@ -0,0 +1,317 @@
+// Package search - полнотекстовый индекс данных пользователя на клиенте: поиск по префиксу слова,
+// нечеткий поиск с опечатками и ранжирование результатов по полям, в которых найдены слова.
+package search
+
+import (
+	"bytes"
+	"encoding/gob"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Field - поле данных, в котором найдено слово. Поля объединяются побитовым ИЛИ.
+type Field uint8
+
+// Поля данных, попадающие в индекс.
+const (
+	FieldDescription Field = 1 << iota
+	FieldFileName
+	FieldKey
+	FieldValue
+)
+
+// fieldWeights - вклад поля в релевантность: совпадение в описании важнее совпадения в значении.
+var fieldWeights = map[Field]float64{
+	FieldDescription: 4,
+	FieldFileName:    3,
+	FieldKey:         2,
+	FieldValue:       1,
+}
+
+// Множители релевантности для разных видов совпадения слова запроса со словом индекса.
+const (
+	exactFactor  = 1.0
+	prefixFactor = 0.6
+	fuzzyFactor  = 0.4
+)
+
+// minFuzzyLength - слова запроса короче не ищутся нечетко, иначе совпадает почти все.
+const minFuzzyLength = 3
+
+// Document - текст данных, добавляемый в индекс.
+type Document struct {
+	ID          int64
+	Description string
+	FileName    string
+	Keys        []string
+	Values      []string
+}
+
+// Result - найденные данные и их релевантность.
+type Result struct {
+	ID    int64
+	Score float64
+}
+
+// Index - инвертированный индекс: для каждого слова хранятся данные, в которых оно встречается, и поля совпадения.
+// Index не безопасен для одновременного использования из нескольких горутин.
+type Index struct {
+	terms map[string]map[int64]Field
+	docs  map[int64][]string
+	// sorted - отсортированный список слов для поиска по префиксу, nil после изменения индекса.
+	sorted []string
+}
+
+// NewIndex - возвращает пустой индекс.
+func NewIndex() *Index {
+	return &Index{
+		terms: make(map[string]map[int64]Field),
+		docs:  make(map[int64][]string),
+	}
+}
+
+// Len - возвращает количество данных в индексе.
+func (x *Index) Len() int {
+	return len(x.docs)
+}
+
+// Add - добавляет данные в индекс, заменяя ранее добавленные данные с тем же ID.
+func (x *Index) Add(doc Document) {
+	x.Remove(doc.ID)
+
+	fields := make(map[string]Field)
+	addText := func(field Field, text string) {
+		for _, term := range Tokenize(text) {
+			fields[term] |= field
+		}
+	}
+	addText(FieldDescription, doc.Description)
+	addText(FieldFileName, doc.FileName)
+	for _, key := range doc.Keys {
+		addText(FieldKey, key)
+	}
+	for _, value := range doc.Values {
+		addText(FieldValue, value)
+	}
+	if len(fields) == 0 {
+		return
+	}
+
+	terms := make([]string, 0, len(fields))
+	for term, field := range fields {
+		postings, ok := x.terms[term]
+		if !ok {
+			postings = make(map[int64]Field)
+			x.terms[term] = postings
+			x.sorted = nil
+		}
+		postings[doc.ID] = field
+		terms = append(terms, term)
+	}
+	x.docs[doc.ID] = terms
+}
+
+// Remove - удаляет данные из индекса.
+func (x *Index) Remove(id int64) {
+	for _, term := range x.docs[id] {
+		delete(x.terms[term], id)
+		if len(x.terms[term]) == 0 {
+			delete(x.terms, term)
+			x.sorted = nil
+		}
+	}
+	delete(x.docs, id)
+}
+
+// Search - ищет данные, содержащие все слова запроса, и возвращает не более limit результатов
+// (0 - без ограничения) в порядке убывания релевантности. Слово запроса совпадает со словом индекса
+// полностью, как префикс или с опечатками: одной для коротких слов и двумя для слов длиннее пяти букв.
+func (x *Index) Search(query string, limit int) []Result {
+	words := Tokenize(query)
+	if len(words) == 0 {
+		return nil
+	}
+
+	scores := make(map[int64]float64)
+	for i, word := range words {
+		best := make(map[int64]float64)
+		for term, factor := range x.candidates(word) {
+			for id, fields := range x.terms[term] {
+				if score := factor * fields.weight(); score > best[id] {
+					best[id] = score
+				}
+			}
+		}
+
+		// в результат попадают только данные, содержащие все слова запроса
+		next := make(map[int64]float64, len(best))
+		for id, score := range best {
+			if previous, ok := scores[id]; ok || i == 0 {
+				next[id] = previous + score
+			}
+		}
+		scores = next
+	}
+
+	results := make([]Result, 0, len(scores))
+	for id, score := range scores {
+		results = append(results, Result{ID: id, Score: score})
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].ID < results[j].ID
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
+// candidates - возвращает слова индекса, совпадающие со словом запроса, и множитель релевантности совпадения.
+func (x *Index) candidates(word string) map[string]float64 {
+	candidates := make(map[string]float64)
+	if _, ok := x.terms[word]; ok {
+		candidates[word] = exactFactor
+	}
+
+	terms := x.sortedTerms()
+	for i := sort.SearchStrings(terms, word); i < len(terms) && strings.HasPrefix(terms[i], word); i++ {
+		if terms[i] != word {
+			// чем большую часть слова занимает префикс, тем выше релевантность
+			candidates[terms[i]] = prefixFactor * float64(utf8.RuneCountInString(word)) / float64(utf8.RuneCountInString(terms[i]))
+		}
+	}
+
+	length := utf8.RuneCountInString(word)
+	if length < minFuzzyLength {
+		return candidates
+	}
+	maxDistance := 1
+	if length > 5 {
+		maxDistance = 2
+	}
+	source := []rune(word)
+	for _, term := range terms {
+		if _, ok := candidates[term]; ok {
+			continue
+		}
+		target := []rune(term)
+		if abs(len(target)-len(source)) > maxDistance {
+			continue
+		}
+		if distance := editDistance(source, target); distance <= maxDistance {
+			candidates[term] = fuzzyFactor / float64(distance)
+		}
+	}
+	return candidates
+}
+
+// sortedTerms - возвращает отсортированный список слов индекса.
+func (x *Index) sortedTerms() []string {
+	if x.sorted == nil {
+		x.sorted = make([]string, 0, len(x.terms))
+		for term := range x.terms {
+			x.sorted = append(x.sorted, term)
+		}
+		sort.Strings(x.sorted)
+	}
+	return x.sorted
+}
+
+// MarshalBinary - сериализует индекс для сохранения на диск.
+func (x *Index) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(x.terms); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary - восстанавливает индекс, сериализованный MarshalBinary.
+func (x *Index) UnmarshalBinary(data []byte) error {
+	terms := make(map[string]map[int64]Field)
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&terms); err != nil {
+		return err
+	}
+
+	x.terms = terms
+	x.docs = make(map[int64][]string)
+	x.sorted = nil
+	for term, postings := range terms {
+		for id := range postings {
+			x.docs[id] = append(x.docs[id], term)
+		}
+	}
+	return nil
+}
+
+// Tokenize - разбивает текст на слова в нижнем регистре. Словом считается последовательность букв и цифр,
+// буква ё заменяется на е.
+func Tokenize(text string) []string {
+	text = strings.ReplaceAll(strings.ToLower(text), "ё", "е")
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// weight - возвращает суммарный вклад полей в релевантность.
+func (f Field) weight() float64 {
+	var weight float64
+	for field, w := range fieldWeights {
+		if f&field != 0 {
+			weight += w
+		}
+	}
+	return weight
+}
+
+// editDistance - возвращает расстояние Дамерау-Левенштейна между словами: количество вставок,
+// удалений, замен и перестановок соседних букв, превращающих одно слово в другое.
+func editDistance(a, b []rune) int {
+	// d[i][j] - расстояние между первыми i буквами a и первыми j буквами b
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(a)][len(b)]
+}
+
+// min - возвращает наименьшее из чисел.
+func min(values ...int) int {
+	result := values[0]
+	for _, v := range values[1:] {
+		if v < result {
+			result = v
+		}
+	}
+	return result
+}
+
+// abs - возвращает модуль числа.
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,95 @@
+package search_test
+
+import (
+	"testing"
+
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/client/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ids - возвращает ID найденных данных в порядке релевантности.
+func ids(results []search.Result) []int64 {
+	result := make([]int64, 0, len(results))
+	for _, r := range results {
+		result = append(result, r.ID)
+	}
+	return result
+}
+
+func newIndex() *search.Index {
+	index := search.NewIndex()
+	index.Add(search.Document{ID: 1, Description: "Google почта", Keys: []string{"recovery email"}})
+	index.Add(search.Document{ID: 2, Description: "Банковская карта", Values: []string{"google pay"}})
+	index.Add(search.Document{ID: 3, Description: "Паспорт", FileName: "passport-scan.pdf"})
+	index.Add(search.Document{ID: 4, Description: "Ёлка на новый год"})
+	return index
+}
+
+func TestTokenize(t *testing.T) {
+	assert.Equal(t, []string{"mail", "google", "com", "пароль", "ежик"}, search.Tokenize("Mail.Google.com: Пароль, ёжик!"))
+	assert.Empty(t, search.Tokenize(" -- "))
+}
+
+func TestSearch_Ranking(t *testing.T) {
+	index := newIndex()
+
+	// совпадение в описании важнее совпадения в значении
+	assert.Equal(t, []int64{1, 2}, ids(index.Search("google", 0)))
+	assert.Equal(t, []int64{1}, ids(index.Search("google", 1)))
+
+	// в результат попадают данные, содержащие все слова запроса
+	assert.Equal(t, []int64{1}, ids(index.Search("google email", 0)))
+	assert.Empty(t, index.Search("google паспорт", 0))
+	assert.Empty(t, index.Search("", 0))
+}
+
+func TestSearch_PrefixAndFuzzy(t *testing.T) {
+	index := newIndex()
+
+	assert.Equal(t, []int64{3}, ids(index.Search("pass", 0)))
+	assert.Equal(t, []int64{2}, ids(index.Search("банк", 0)))
+	assert.Equal(t, []int64{4}, ids(index.Search("елка", 0)))
+
+	// опечатки: пропущенная буква и перестановка соседних букв
+	assert.Equal(t, []int64{1, 2}, ids(index.Search("gogle", 0)))
+	assert.Equal(t, []int64{3}, ids(index.Search("пасопрт", 0)))
+	// короткие слова нечетко не ищутся
+	index.Add(search.Document{ID: 8, Description: "ab"})
+	assert.Equal(t, []int64{8}, ids(index.Search("ab", 0)))
+	assert.Empty(t, index.Search("ac", 0))
+
+	// точное совпадение выше префикса, префикс выше совпадения с опечаткой,
+	// равные по релевантности данные упорядочиваются по ID
+	index.Add(search.Document{ID: 5, Description: "googles"})
+	index.Add(search.Document{ID: 6, Description: "googel"})
+	index.Add(search.Document{ID: 7, Description: "google"})
+	assert.Equal(t, []int64{1, 7, 5, 6}, ids(index.Search("google", 4)))
+}
+
+func TestIndex_ReplaceAndRemove(t *testing.T) {
+	index := newIndex()
+	require.Equal(t, 4, index.Len())
+
+	index.Add(search.Document{ID: 1, Description: "Yandex почта"})
+	assert.Equal(t, []int64{2}, ids(index.Search("google", 0)))
+	assert.Equal(t, []int64{1}, ids(index.Search("yandex", 0)))
+
+	index.Remove(2)
+	assert.Empty(t, index.Search("google", 0))
+	assert.Equal(t, 3, index.Len())
+}
+
+func TestIndex_Marshal(t *testing.T) {
+	index := newIndex()
+	data, err := index.MarshalBinary()
+	require.NoError(t, err)
+
+	restored := search.NewIndex()
+	require.NoError(t, restored.UnmarshalBinary(data))
+	assert.Equal(t, index.Len(), restored.Len())
+	assert.Equal(t, index.Search("gogle", 0), restored.Search("gogle", 0))
+
+	restored.Remove(1)
+	assert.Equal(t, []int64{2}, ids(restored.Search("google", 0)))
+}
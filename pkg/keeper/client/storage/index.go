@@ -0,0 +1,135 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/gob"
+	"io/ioutil"
+
+	"github.com/bubu256/gophkeeper_pet/internal/proto/pb"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/client/search"
+	"github.com/pkg/errors"
+)
+
+// indexFile - содержимое файла поискового индекса. Ревизия и настройка поиска по значениям сохраняются,
+// чтобы индекс, не соответствующий загруженным данным или построенный с другой настройкой, был перестроен.
+type indexFile struct {
+	Revision    int64
+	IndexValues bool
+	Index       []byte
+}
+
+// SetIndexValues - включает или выключает поиск по значениям пар ключ-значение и полям содержимого.
+// Секретные поля (пароли, номера карт, коды безопасности) в индекс не попадают никогда.
+func (s *Storage) SetIndexValues(enabled bool) {
+	if s.indexValues != enabled {
+		s.indexValues = enabled
+		s.index = nil
+	}
+}
+
+// Search - ищет данные по описанию, имени файла, ключам и, если включено, значениям.
+// Возвращает не более limit результатов (0 - без ограничения) в порядке убывания релевантности.
+func (s *Storage) Search(query string, limit int) []search.Result {
+	return s.searchIndex().Search(query, limit)
+}
+
+// searchIndex - возвращает поисковый индекс, создавая его при первом обращении.
+func (s *Storage) searchIndex() *search.Index {
+	if s.index == nil {
+		s.rebuildIndex()
+	}
+	return s.index
+}
+
+// indexCell - обновляет ячейку в поисковом индексе, если он уже построен.
+func (s *Storage) indexCell(cell *pb.MemoryCell) {
+	if s.index != nil {
+		s.index.Add(s.document(cell))
+	}
+}
+
+// rebuildIndex - строит поисковый индекс по всем данным хранилища.
+func (s *Storage) rebuildIndex() {
+	s.index = search.NewIndex()
+	for _, cell := range s.data {
+		s.index.Add(s.document(cell))
+	}
+}
+
+// document - возвращает текст ячейки для поискового индекса. У зашифрованной ячейки индексируется только описание.
+func (s *Storage) document(cell *pb.MemoryCell) search.Document {
+	doc := search.Document{ID: cell.Info.Id, Description: cell.Info.Description}
+	if cell.Encrypted {
+		return doc
+	}
+
+	doc.FileName = cell.FileName
+	for key, value := range cell.KeyValuePairs {
+		doc.Keys = append(doc.Keys, key)
+		if s.indexValues {
+			doc.Values = append(doc.Values, value)
+		}
+	}
+	if s.indexValues {
+		doc.Values = append(doc.Values, searchablePayload(cell)...)
+	}
+	return doc
+}
+
+// searchablePayload - возвращает несекретные поля содержимого ячейки.
+func searchablePayload(cell *pb.MemoryCell) []string {
+	switch payload := cell.Payload.(type) {
+	case *pb.MemoryCell_Login:
+		return []string{payload.Login.Url, payload.Login.Username}
+	case *pb.MemoryCell_Card:
+		return []string{payload.Card.Holder}
+	case *pb.MemoryCell_Note:
+		return []string{payload.Note.Text}
+	case *pb.MemoryCell_Otp:
+		return []string{payload.Otp.Issuer, payload.Otp.Account}
+	}
+	return nil
+}
+
+// saveIndex - сохраняет поисковый индекс в файл, зашифровав его ключом key.
+func (s *Storage) saveIndex(path string, key []byte) error {
+	data, err := s.searchIndex().MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal search index")
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(indexFile{Revision: s.revision, IndexValues: s.indexValues, Index: data}); err != nil {
+		return errors.Wrap(err, "failed to marshal search index")
+	}
+	encrypted, err := Encrypt(buf.Bytes(), key)
+	if err != nil {
+		return errors.Wrap(err, "failed to encrypt search index")
+	}
+
+	return errors.Wrap(writeFileAtomic(path, encrypted), "failed to write search index")
+}
+
+// loadIndex - загружает поисковый индекс, сохраненный saveIndex вместе с данными. Если файла нет,
+// он поврежден или не соответствует данным, индекс будет построен заново при первом поиске.
+func (s *Storage) loadIndex(path string, key []byte) {
+	s.index = nil
+	encrypted, err := ioutil.ReadFile(path)
+	if err != nil {
+		return
+	}
+	data, err := Decrypt(encrypted, key)
+	if err != nil {
+		return
+	}
+
+	var file indexFile
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&file); err != nil || file.Revision != s.revision || file.IndexValues != s.indexValues {
+		return
+	}
+	index := search.NewIndex()
+	if err := index.UnmarshalBinary(file.Index); err != nil || index.Len() > len(s.data) {
+		return
+	}
+	s.index = index
+}
@@ -0,0 +1,200 @@
+package storage
+
+import (
+	stderrors "errors"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/bubu256/gophkeeper_pet/internal/proto/pb"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Виды операций журнала.
+const (
+	OpAdd    = "add"
+	OpUpdate = "update"
+	OpDelete = "delete"
+)
+
+// ErrReplayInterrupted - возвращается функцией применения операции, если сервер стал недоступен.
+// Воспроизведение журнала останавливается, оставшиеся операции сохраняются.
+var ErrReplayInterrupted = stderrors.New("replay interrupted")
+
+// Journal - журнал изменений, сделанных без подключения к серверу.
+// Журнал хранится на диске в зашифрованном виде и сохраняется после каждого изменения.
+// Новые данные до отправки на сервер получают временные отрицательные InfoID.
+type Journal struct {
+	path string
+	key  []byte
+	data *pb.PendingOperations
+}
+
+// ReplayResult - результат отправки на сервер одной операции журнала.
+type ReplayResult struct {
+	Operation *pb.PendingOperation
+	// InfoID, назначенный сервером новым данным.
+	NewID int64
+	Err   error
+}
+
+// OpenJournal - открывает журнал, зашифрованный ключом key. Если файла нет, журнал пуст.
+func OpenJournal(path string, key []byte) (*Journal, error) {
+	j := &Journal{path: path, key: key, data: &pb.PendingOperations{}}
+
+	encryptedData, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return j, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read journal")
+	}
+
+	data, err := Decrypt(encryptedData, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decrypt journal")
+	}
+	if err := proto.Unmarshal(data, j.data); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal journal")
+	}
+
+	return j, nil
+}
+
+// Operations - возвращает операции журнала в порядке их выполнения.
+func (j *Journal) Operations() []*pb.PendingOperation {
+	return j.data.Operations
+}
+
+// Len - возвращает количество операций, ожидающих отправки.
+func (j *Journal) Len() int {
+	return len(j.data.Operations)
+}
+
+// NextTempID - выделяет временный InfoID для новых данных.
+func (j *Journal) NextTempID() int64 {
+	j.data.LastTempId--
+	return j.data.LastTempId
+}
+
+// RecordAdd - записывает добавление данных. InfoID ячейки должен быть временным.
+func (j *Journal) RecordAdd(cell *pb.MemoryCell) error {
+	j.append(OpAdd, proto.Clone(cell).(*pb.MemoryCell), nil)
+	return j.save()
+}
+
+// RecordUpdate - записывает изменение данных. base - версия данных до изменения, она нужна
+// для слияния при конфликте с изменениями на сервере.
+// Повторное изменение тех же данных заменяет данные в уже записанной операции, сохраняя исходную base.
+func (j *Journal) RecordUpdate(cell, base *pb.MemoryCell) error {
+	cell = proto.Clone(cell).(*pb.MemoryCell)
+	for _, op := range j.data.Operations {
+		if op.Kind != OpDelete && op.Cell.Info.Id == cell.Info.Id {
+			op.Cell = cell
+			return j.save()
+		}
+	}
+
+	op := j.append(OpUpdate, cell, nil)
+	if base != nil {
+		op.Base = proto.Clone(base).(*pb.MemoryCell)
+	}
+	return j.save()
+}
+
+// RecordDelete - записывает удаление данных.
+// Удаление еще не отправленных данных убирает из журнала все операции с ними.
+func (j *Journal) RecordDelete(infoIDs []int64) error {
+	serverIDs := make([]int64, 0, len(infoIDs))
+	for _, id := range infoIDs {
+		if id >= 0 {
+			serverIDs = append(serverIDs, id)
+			continue
+		}
+		operations := j.data.Operations[:0]
+		for _, op := range j.data.Operations {
+			if op.Cell == nil || op.Cell.Info.Id != id {
+				operations = append(operations, op)
+			}
+		}
+		j.data.Operations = operations
+	}
+
+	if len(serverIDs) > 0 {
+		j.append(OpDelete, nil, serverIDs)
+	}
+	return j.save()
+}
+
+// Replay - по порядку передает операции журнала в apply и возвращает результаты.
+// apply возвращает InfoID, назначенный сервером новым данным; временный InfoID заменяется им
+// в оставшихся операциях. Операция удаляется из журнала после успешного выполнения или ошибки сервера.
+// Если сервер отказался создать новые данные, вызывающий должен удалить данные с временным InfoID из локальной копии.
+// Если apply возвращает ErrReplayInterrupted, воспроизведение останавливается и операция остается в журнале.
+func (j *Journal) Replay(apply func(op *pb.PendingOperation) (int64, error)) ([]ReplayResult, error) {
+	var results []ReplayResult
+	for len(j.data.Operations) > 0 {
+		op := j.data.Operations[0]
+		newID, err := apply(op)
+		if stderrors.Is(err, ErrReplayInterrupted) {
+			return results, err
+		}
+
+		j.data.Operations = j.data.Operations[1:]
+		if err == nil && op.Kind == OpAdd {
+			j.remapID(op.Cell.Info.Id, newID)
+		}
+		results = append(results, ReplayResult{Operation: op, NewID: newID, Err: err})
+
+		if err := j.save(); err != nil {
+			return results, err
+		}
+	}
+
+	return results, nil
+}
+
+// append - добавляет операцию в конец журнала.
+func (j *Journal) append(kind string, cell *pb.MemoryCell, infoIDs []int64) *pb.PendingOperation {
+	j.data.LastId++
+	op := &pb.PendingOperation{
+		Id:        j.data.LastId,
+		Kind:      kind,
+		Cell:      cell,
+		Ids:       infoIDs,
+		CreatedAt: timestamppb.New(time.Now()),
+	}
+	j.data.Operations = append(j.data.Operations, op)
+	return op
+}
+
+// remapID - заменяет временный InfoID на назначенный сервером в оставшихся операциях.
+func (j *Journal) remapID(tempID, newID int64) {
+	for _, op := range j.data.Operations {
+		if op.Cell != nil && op.Cell.Info.Id == tempID {
+			op.Cell.Info.Id = newID
+		}
+		for i, id := range op.Ids {
+			if id == tempID {
+				op.Ids[i] = newID
+			}
+		}
+	}
+}
+
+// save - шифрует и сохраняет журнал на диск.
+func (j *Journal) save() error {
+	data, err := proto.Marshal(j.data)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal journal")
+	}
+
+	encryptedData, err := Encrypt(data, j.key)
+	if err != nil {
+		return errors.Wrap(err, "failed to encrypt journal")
+	}
+
+	return errors.Wrap(writeFileAtomic(j.path, encryptedData), "failed to write journal")
+}
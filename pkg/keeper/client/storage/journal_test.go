@@ -0,0 +1,189 @@
+package storage_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bubu256/gophkeeper_pet/internal/proto/pb"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/client/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJournal_RecordAndReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".journal")
+	key := storage.GenerateKeyFromPassword("mypassword")
+
+	journal, err := storage.OpenJournal(path, key)
+	require.NoError(t, err)
+	assert.Equal(t, 0, journal.Len())
+
+	tempID := journal.NextTempID()
+	assert.Less(t, tempID, int64(0))
+	require.NoError(t, journal.RecordAdd(&pb.MemoryCell{Info: &pb.InfoCell{Id: tempID, Description: "new"}}))
+	require.NoError(t, journal.RecordUpdate(&pb.MemoryCell{Info: &pb.InfoCell{Id: 5, Description: "changed"}}, &pb.MemoryCell{Info: &pb.InfoCell{Id: 5, Description: "original"}}))
+	require.NoError(t, journal.RecordDelete([]int64{7}))
+
+	// журнал сохраняется на диск в зашифрованном виде
+	reopened, err := storage.OpenJournal(path, key)
+	require.NoError(t, err)
+	require.Equal(t, 3, reopened.Len())
+	assert.Equal(t, storage.OpAdd, reopened.Operations()[0].Kind)
+	assert.Equal(t, storage.OpUpdate, reopened.Operations()[1].Kind)
+	assert.Equal(t, "original", reopened.Operations()[1].Base.Info.Description)
+	assert.Equal(t, []int64{7}, reopened.Operations()[2].Ids)
+	assert.Less(t, reopened.NextTempID(), tempID)
+
+	_, err = storage.OpenJournal(path, storage.GenerateKeyFromPassword("wrong"))
+	assert.Error(t, err)
+}
+
+func TestJournal_PendingCellsCollapse(t *testing.T) {
+	journal, err := storage.OpenJournal(filepath.Join(t.TempDir(), ".journal"), storage.GenerateKeyFromPassword("mypassword"))
+	require.NoError(t, err)
+
+	tempID := journal.NextTempID()
+	require.NoError(t, journal.RecordAdd(&pb.MemoryCell{Info: &pb.InfoCell{Id: tempID, Description: "new"}}))
+
+	// изменение неотправленных данных заменяет запись о добавлении
+	require.NoError(t, journal.RecordUpdate(&pb.MemoryCell{Info: &pb.InfoCell{Id: tempID, Description: "edited"}}, nil))
+	require.Equal(t, 1, journal.Len())
+	assert.Equal(t, "edited", journal.Operations()[0].Cell.Info.Description)
+
+	// удаление неотправленных данных убирает их из журнала
+	require.NoError(t, journal.RecordDelete([]int64{tempID}))
+	assert.Equal(t, 0, journal.Len())
+}
+
+func TestJournal_RepeatedUpdateKeepsBase(t *testing.T) {
+	journal, err := storage.OpenJournal(filepath.Join(t.TempDir(), ".journal"), storage.GenerateKeyFromPassword("mypassword"))
+	require.NoError(t, err)
+
+	base := &pb.MemoryCell{Info: &pb.InfoCell{Id: 5, Description: "original"}}
+	require.NoError(t, journal.RecordUpdate(&pb.MemoryCell{Info: &pb.InfoCell{Id: 5, Description: "first"}}, base))
+	require.NoError(t, journal.RecordUpdate(&pb.MemoryCell{Info: &pb.InfoCell{Id: 5, Description: "second"}}, nil))
+
+	require.Equal(t, 1, journal.Len())
+	assert.Equal(t, "second", journal.Operations()[0].Cell.Info.Description)
+	assert.Equal(t, "original", journal.Operations()[0].Base.Info.Description)
+}
+
+func TestJournal_Replay(t *testing.T) {
+	journal, err := storage.OpenJournal(filepath.Join(t.TempDir(), ".journal"), storage.GenerateKeyFromPassword("mypassword"))
+	require.NoError(t, err)
+
+	tempID := journal.NextTempID()
+	require.NoError(t, journal.RecordAdd(&pb.MemoryCell{Info: &pb.InfoCell{Id: tempID}}))
+	require.NoError(t, journal.RecordDelete([]int64{3}))
+	require.NoError(t, journal.RecordUpdate(&pb.MemoryCell{Info: &pb.InfoCell{Id: 4}}, nil))
+
+	// сервер недоступен на третьей операции
+	var applied []string
+	results, err := journal.Replay(func(op *pb.PendingOperation) (int64, error) {
+		switch op.Kind {
+		case storage.OpAdd:
+			applied = append(applied, op.Kind)
+			return 100, nil
+		case storage.OpDelete:
+			applied = append(applied, op.Kind)
+			return 0, errors.New("access denied")
+		default:
+			return 0, storage.ErrReplayInterrupted
+		}
+	})
+	assert.ErrorIs(t, err, storage.ErrReplayInterrupted)
+	require.Len(t, results, 2)
+	assert.Equal(t, int64(100), results[0].NewID)
+	assert.NoError(t, results[0].Err)
+	assert.Error(t, results[1].Err)
+	assert.Equal(t, []string{storage.OpAdd, storage.OpDelete}, applied)
+	assert.Equal(t, 1, journal.Len())
+
+	// после восстановления связи оставшаяся операция отправляется
+	results, err = journal.Replay(func(op *pb.PendingOperation) (int64, error) {
+		return 0, nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, 0, journal.Len())
+}
+
+func TestJournal_ReplayRejectedAdd(t *testing.T) {
+	journal, err := storage.OpenJournal(filepath.Join(t.TempDir(), ".journal"), storage.GenerateKeyFromPassword("mypassword"))
+	require.NoError(t, err)
+
+	tempID := journal.NextTempID()
+	require.NoError(t, journal.RecordAdd(&pb.MemoryCell{Info: &pb.InfoCell{Id: tempID}}))
+	require.NoError(t, journal.RecordUpdate(&pb.MemoryCell{Info: &pb.InfoCell{Id: 4}}, nil))
+
+	// сервер отклоняет новые данные: операция убирается из журнала, остальные отправляются
+	results, err := journal.Replay(func(op *pb.PendingOperation) (int64, error) {
+		if op.Kind == storage.OpAdd {
+			return 0, errors.New("invalid data")
+		}
+		return 0, nil
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Error(t, results[0].Err)
+	assert.Equal(t, tempID, results[0].Operation.Cell.Info.Id)
+	assert.NoError(t, results[1].Err)
+	assert.Equal(t, 0, journal.Len())
+
+	// отклоненные данные не отправляются повторно
+	results, err = journal.Replay(func(op *pb.PendingOperation) (int64, error) {
+		return 100, nil
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestSaveAndLoadLocal(t *testing.T) {
+	s := storage.NewStorage()
+	s.SetProfile("journal-test-user")
+	key := storage.GenerateKeyFromPassword("mypassword")
+
+	s.ApplyDelta([]*pb.MemoryCell{{Info: &pb.InfoCell{Id: 1, Description: "Cell 1"}}}, nil, 3)
+	require.NoError(t, s.SaveLocal(key))
+	assert.True(t, s.HasLocal())
+
+	loaded := storage.NewStorage()
+	loaded.SetProfile("journal-test-user")
+	require.NoError(t, loaded.LoadLocal(key))
+	assert.Len(t, loaded.GetData(), 1)
+	assert.Equal(t, int64(3), loaded.Revision())
+
+	// неверный мастер-пароль
+	assert.Error(t, loaded.LoadLocal(storage.GenerateKeyFromPassword("wrong")))
+}
+
+func TestSaveAndLoadSession(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	s := storage.NewStorage()
+	s.SetProfile("session-test-user")
+	key := storage.GenerateKeyFromPassword("mypassword")
+
+	_, err := s.LoadSession(key)
+	assert.True(t, errors.Is(err, storage.ErrNoSession))
+	assert.Empty(t, s.LastUser())
+
+	session := storage.Session{Token: "token", RefreshToken: "refresh", ExpiresAt: time.Now().Add(time.Hour).UTC().Truncate(time.Second)}
+	require.NoError(t, s.SaveSession(session, key))
+	assert.Equal(t, "session-test-user", s.LastUser())
+
+	loaded, err := s.LoadSession(key)
+	require.NoError(t, err)
+	assert.Equal(t, session, loaded)
+
+	// неверный мастер-пароль
+	_, err = s.LoadSession(storage.GenerateKeyFromPassword("wrong"))
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, storage.ErrNoSession))
+
+	require.NoError(t, s.RemoveSession())
+	_, err = s.LoadSession(key)
+	assert.True(t, errors.Is(err, storage.ErrNoSession))
+	assert.Empty(t, s.LastUser())
+}
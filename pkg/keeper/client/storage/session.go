@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// lastUserFile - файл в директории приложения с именем пользователя, чья сессия сохранена последней.
+const lastUserFile = ".last_user"
+
+// ErrNoSession возвращается, если сохраненной сессии пользователя нет.
+var ErrNoSession = errors.New("no saved session")
+
+// Session - токены сессии на сервере, сохраняемые между запусками клиента.
+// Курсор синхронизации (ревизия) хранится вместе с локальной копией данных, см. SaveLocal.
+type Session struct {
+	Token        string    `json:"token"`
+	RefreshToken string    `json:"refresh_token"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+// SaveSession - сохраняет токены сессии текущего пользователя, зашифровав их ключом хранилища,
+// и запоминает пользователя как последнего вошедшего.
+func (s *Storage) SaveSession(session Session, key []byte) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal session")
+	}
+
+	encrypted, err := Encrypt(data, key)
+	if err != nil {
+		return errors.Wrap(err, "failed to encrypt session")
+	}
+
+	if err := writeFileAtomic(s.ProfilePath(".session"), encrypted); err != nil {
+		return errors.Wrap(err, "failed to write session")
+	}
+	return errors.Wrap(writeFileAtomic(filepath.Join(s.dir, lastUserFile), []byte(s.username)), "failed to write last user")
+}
+
+// LoadSession - загружает токены сессии текущего пользователя, сохраненные SaveSession.
+// Если сессия не сохранена, возвращается ErrNoSession. Ошибка расшифровки означает, что ключ (мастер-пароль) не подходит.
+func (s *Storage) LoadSession(key []byte) (Session, error) {
+	var session Session
+	encrypted, err := ioutil.ReadFile(s.ProfilePath(".session"))
+	if os.IsNotExist(err) {
+		return session, ErrNoSession
+	}
+	if err != nil {
+		return session, errors.Wrap(err, "failed to read session")
+	}
+
+	data, err := Decrypt(encrypted, key)
+	if err != nil {
+		return session, errors.Wrap(err, "failed to decrypt session")
+	}
+	if err := json.Unmarshal(data, &session); err != nil {
+		return session, errors.Wrap(err, "failed to unmarshal session")
+	}
+	return session, nil
+}
+
+// RemoveSession - удаляет сохраненную сессию текущего пользователя.
+func (s *Storage) RemoveSession() error {
+	if err := os.Remove(s.ProfilePath(".session")); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "failed to remove session")
+	}
+	if s.LastUser() == s.username {
+		if err := os.Remove(filepath.Join(s.dir, lastUserFile)); err != nil && !os.IsNotExist(err) {
+			return errors.Wrap(err, "failed to remove last user")
+		}
+	}
+	return nil
+}
+
+// LastUser - возвращает пользователя, чья сессия сохранена последней, или пустую строку.
+func (s *Storage) LastUser() string {
+	data, err := ioutil.ReadFile(filepath.Join(s.dir, lastUserFile))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
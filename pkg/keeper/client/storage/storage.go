@@ -6,6 +6,7 @@ import (
 	"crypto/cipher"
 	"crypto/rand"
 	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -13,14 +14,24 @@ import (
 	"path/filepath"
 
 	"github.com/bubu256/gophkeeper_pet/internal/proto/pb"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/client/search"
 	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
 	"google.golang.org/protobuf/proto"
 )
 
 // Storage представляет структуру для хранения в памяти набора MemoryCell.
 type Storage struct {
 	data         []*pb.MemoryCell
+	revision     int64
+	folders      []*pb.Folder
+	dir          string
+	username     string
+	profile      string
 	dumpFilePath string
+	// index - поисковый индекс данных, строится при первом поиске после полной замены данных.
+	index       *search.Index
+	indexValues bool
 }
 
 // NewStorage - возвращает экземпляр хранилища
@@ -31,14 +42,149 @@ func NewStorage() *Storage {
 	}
 	dumpFilePath := filepath.Join(dirPath, ".dump")
 	return &Storage{
+		dir:          dirPath,
 		dumpFilePath: dumpFilePath,
 	}
 }
 
+// Dir - возвращает директорию приложения, в которой хранятся файлы клиента.
+func (s *Storage) Dir() string {
+	return s.dir
+}
+
 // Sync - сохраняет данные в памяти.
 func (s *Storage) Sync(cells []*pb.MemoryCell) {
 	// s.data = append(s.data, cells...)
 	s.data = cells
+	s.index = nil
+}
+
+// SetProfile - задает пользователя, к которому относятся локальная копия данных и журнал операций.
+// Файлы разных пользователей хранятся в директории приложения раздельно.
+func (s *Storage) SetProfile(username string) {
+	hash := sha256.Sum256([]byte(username))
+	s.username = username
+	s.profile = hex.EncodeToString(hash[:8])
+}
+
+// ProfilePath - возвращает путь к файлу текущего пользователя с указанным расширением.
+func (s *Storage) ProfilePath(ext string) string {
+	return filepath.Join(s.dir, s.profile+ext)
+}
+
+// SaveLocal - сохраняет локальную копию данных вместе с ревизией, зашифровав ее ключом хранилища.
+// Локальная копия позволяет работать без подключения к серверу.
+func (s *Storage) SaveLocal(key []byte) error {
+	data, err := proto.Marshal(&pb.Snapshot{Data: s.data, Revision: s.revision, Folders: s.folders})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal data to binary")
+	}
+
+	encryptedData, err := Encrypt(data, key)
+	if err != nil {
+		return errors.Wrap(err, "failed to encrypt data")
+	}
+
+	if err := writeFileAtomic(s.ProfilePath(".cache"), encryptedData); err != nil {
+		return errors.Wrap(err, "failed to write local copy")
+	}
+
+	return s.saveIndex(s.ProfilePath(".index"), key)
+}
+
+// HasLocal - сообщает, есть ли локальная копия данных текущего пользователя.
+func (s *Storage) HasLocal() bool {
+	_, err := os.Stat(s.ProfilePath(".cache"))
+	return err == nil
+}
+
+// LoadLocal - загружает локальную копию данных, сохраненную SaveLocal.
+// Ошибка расшифровки означает, что ключ (мастер-пароль) не подходит.
+func (s *Storage) LoadLocal(key []byte) error {
+	encryptedData, err := ioutil.ReadFile(s.ProfilePath(".cache"))
+	if err != nil {
+		return errors.Wrap(err, "failed to read local copy")
+	}
+
+	data, err := Decrypt(encryptedData, key)
+	if err != nil {
+		return errors.Wrap(err, "failed to decrypt local copy")
+	}
+
+	var snapshot pb.Snapshot
+	if err := proto.Unmarshal(data, &snapshot); err != nil {
+		return errors.Wrap(err, "failed to unmarshal data from binary")
+	}
+
+	s.data = snapshot.Data
+	s.revision = snapshot.Revision
+	s.folders = snapshot.Folders
+	s.loadIndex(s.ProfilePath(".index"), key)
+	return nil
+}
+
+// Revision - возвращает ревизию сервера, до которой синхронизированы данные хранилища.
+func (s *Storage) Revision() int64 {
+	return s.revision
+}
+
+// ApplyDelta - применяет изменения, полученные от сервера после ревизии хранилища:
+// добавляет или заменяет измененные ячейки, удаляет удаленные и запоминает новую ревизию.
+func (s *Storage) ApplyDelta(changed []*pb.MemoryCell, deletedIDs []int64, revision int64) {
+	for _, cell := range changed {
+		s.Put(cell)
+	}
+	if len(deletedIDs) > 0 {
+		s.Remove(deletedIDs...)
+	}
+	s.revision = revision
+}
+
+// Reset - очищает хранилище, следующая синхронизация загрузит все данные заново.
+func (s *Storage) Reset() {
+	s.data = nil
+	s.revision = 0
+	s.folders = nil
+	s.index = nil
+}
+
+// SetFolders - сохраняет папки пользователя, полученные с сервера, для работы без подключения к серверу.
+func (s *Storage) SetFolders(folders []*pb.Folder) {
+	s.folders = folders
+}
+
+// Folders - возвращает папки пользователя, полученные при последнем подключении к серверу.
+func (s *Storage) Folders() []*pb.Folder {
+	return s.folders
+}
+
+// Put - добавляет ячейку в хранилище или заменяет ячейку с тем же InfoID.
+func (s *Storage) Put(cell *pb.MemoryCell) {
+	for i, c := range s.data {
+		if c.Info.Id == cell.Info.Id {
+			s.data[i] = cell
+			s.indexCell(cell)
+			return
+		}
+	}
+	s.data = append(s.data, cell)
+	s.indexCell(cell)
+}
+
+// Remove - удаляет из хранилища ячейки с указанными InfoID.
+func (s *Storage) Remove(infoIDs ...int64) {
+	data := s.data[:0]
+	for _, cell := range s.data {
+		if !slices.Contains(infoIDs, cell.Info.Id) {
+			data = append(data, cell)
+		}
+	}
+	s.data = data
+	if s.index != nil {
+		for _, infoID := range infoIDs {
+			s.index.Remove(infoID)
+		}
+	}
 }
 
 // Получить информацию: возвращает слайс pb.InfoCell, сформированный из набора MemoryCell.
@@ -68,7 +214,7 @@ func (s *Storage) GetData() []*pb.MemoryCell {
 // Сделать дамп на диск: шифрует данные и сохраняет их в файл на диске.
 func (s *Storage) DumpToFile(password string) error {
 	// Преобразование данных в бинарный формат
-	data, err := proto.Marshal(&pb.RetrieveDataResponse{Data: s.data})
+	data, err := proto.Marshal(&pb.Snapshot{Data: s.data, Revision: s.revision})
 	if err != nil {
 		return errors.Wrap(err, "failed to marshal data to binary")
 	}
@@ -95,7 +241,7 @@ func (s *Storage) DumpToFile(password string) error {
 		return errors.Wrap(err, "failed to write data to file")
 	}
 
-	return nil
+	return s.saveIndex(s.dumpFilePath+".index", key)
 }
 
 // Загрузить из дампа на диске: считывает и дешифрует данные из файла на диске и сохраняет их в структуре.
@@ -115,19 +261,32 @@ func (s *Storage) LoadFromDump(password string) error {
 		return errors.Wrap(err, "failed to decrypt data")
 	}
 
-	// Преобразование данных из бинарного формата
-	var response pb.RetrieveDataResponse
-	err = proto.Unmarshal(decryptedData, &response)
+	// Преобразование данных из бинарного формата.
+	// Дампы старых версий без ревизии загружаются с нулевой ревизией и будут синхронизированы полностью.
+	var snapshot pb.Snapshot
+	err = proto.Unmarshal(decryptedData, &snapshot)
 	if err != nil {
 		return errors.Wrap(err, "failed to unmarshal data from binary")
 	}
 
 	// Обновление данных в структуре
-	s.data = response.Data
+	s.data = snapshot.Data
+	s.revision = snapshot.Revision
+	s.loadIndex(s.dumpFilePath+".index", key)
 
 	return nil
 }
 
+// writeFileAtomic - записывает файл, доступный только владельцу, через временный файл,
+// чтобы прерванная запись не повредила предыдущую версию.
+func writeFileAtomic(path string, data []byte) error {
+	tmpPath := path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
 // Функция для генерации ключа из пароля
 func GenerateKeyFromPassword(password string) []byte {
 	hash := sha256.Sum256([]byte(password))
@@ -1,11 +1,13 @@
 package storage_test
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/bubu256/gophkeeper_pet/internal/proto/pb"
 	"github.com/bubu256/gophkeeper_pet/pkg/keeper/client/storage"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestSync(t *testing.T) {
@@ -170,3 +172,239 @@ func TestEncryptAndDecrypt(t *testing.T) {
 	// Проверяем, что исходные данные совпадают с расшифрованными данными
 	assert.Equal(t, data, decryptedData)
 }
+
+func TestPutAndRemove(t *testing.T) {
+	s := storage.NewStorage()
+
+	cells := []*pb.MemoryCell{
+		{Info: &pb.InfoCell{Id: 1, Description: "Cell 1"}},
+		{Info: &pb.InfoCell{Id: 2, Description: "Cell 2"}},
+	}
+	s.Sync(cells)
+
+	// Замена существующей ячейки
+	updated := &pb.MemoryCell{Info: &pb.InfoCell{Id: 2, Description: "Cell 2 updated"}}
+	s.Put(updated)
+	cell, err := s.GetDataByID(2)
+	assert.NoError(t, err)
+	assert.Equal(t, "Cell 2 updated", cell.Info.Description)
+	assert.Len(t, s.GetData(), 2)
+
+	// Добавление новой ячейки
+	s.Put(&pb.MemoryCell{Info: &pb.InfoCell{Id: 3, Description: "Cell 3"}})
+	assert.Len(t, s.GetData(), 3)
+
+	// Удаление ячеек
+	s.Remove(1, 3)
+	assert.Len(t, s.GetData(), 1)
+	_, err = s.GetDataByID(1)
+	assert.Error(t, err)
+	_, err = s.GetDataByID(2)
+	assert.NoError(t, err)
+}
+
+func TestApplyDelta(t *testing.T) {
+	s := storage.NewStorage()
+	assert.Equal(t, int64(0), s.Revision())
+
+	// первая синхронизация загружает все данные
+	s.ApplyDelta([]*pb.MemoryCell{
+		{Info: &pb.InfoCell{Id: 1, Description: "Cell 1", Revision: 1}},
+		{Info: &pb.InfoCell{Id: 2, Description: "Cell 2", Revision: 2}},
+	}, nil, 2)
+	assert.Len(t, s.GetData(), 2)
+	assert.Equal(t, int64(2), s.Revision())
+
+	// изменение, добавление и удаление данных
+	s.ApplyDelta([]*pb.MemoryCell{
+		{Info: &pb.InfoCell{Id: 2, Description: "Cell 2 updated", Revision: 3}},
+		{Info: &pb.InfoCell{Id: 3, Description: "Cell 3", Revision: 4}},
+	}, []int64{1}, 5)
+	assert.Len(t, s.GetData(), 2)
+	assert.Equal(t, int64(5), s.Revision())
+	cell, err := s.GetDataByID(2)
+	assert.NoError(t, err)
+	assert.Equal(t, "Cell 2 updated", cell.Info.Description)
+	_, err = s.GetDataByID(1)
+	assert.Error(t, err)
+
+	// ревизия сохраняется в дампе
+	err = s.DumpToFile("mypassword")
+	assert.NoError(t, err)
+	loaded := storage.NewStorage()
+	err = loaded.LoadFromDump("mypassword")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), loaded.Revision())
+	assert.Len(t, loaded.GetData(), 2)
+
+	s.Reset()
+	assert.Empty(t, s.GetData())
+	assert.Equal(t, int64(0), s.Revision())
+}
+
+func TestDeriveVaultKey(t *testing.T) {
+	key := storage.DeriveVaultKey("user", "mypassword")
+	assert.Len(t, key, 32)
+
+	// Ключ воспроизводим и зависит от логина и пароля
+	assert.Equal(t, key, storage.DeriveVaultKey("user", "mypassword"))
+	assert.NotEqual(t, key, storage.DeriveVaultKey("other", "mypassword"))
+	assert.NotEqual(t, key, storage.DeriveVaultKey("user", "otherpassword"))
+}
+
+func TestEncryptAndDecryptCell(t *testing.T) {
+	key := storage.GenerateKeyFromPassword("mypassword")
+	cell := &pb.MemoryCell{
+		Info:          &pb.InfoCell{Id: 1, DataType: "login", Description: "Cell 1"},
+		KeyValuePairs: map[string]string{"login": "user", "password": "secret"},
+		BinaryData:    []byte("file content"),
+		FileName:      "secret.txt",
+	}
+
+	encrypted, err := storage.EncryptCell(cell, key)
+	assert.NoError(t, err)
+	assert.True(t, encrypted.Encrypted)
+	assert.Equal(t, cell.Info.Description, encrypted.Info.Description)
+	assert.NotContains(t, encrypted.KeyValuePairs, "password")
+	assert.NotEqual(t, cell.FileName, encrypted.FileName)
+	assert.NotEqual(t, cell.BinaryData, encrypted.BinaryData)
+	assert.False(t, cell.Encrypted, "исходная ячейка не должна изменяться")
+
+	decrypted, err := storage.DecryptCell(encrypted, key)
+	assert.NoError(t, err)
+	assert.False(t, decrypted.Encrypted)
+	assert.Equal(t, cell.KeyValuePairs, decrypted.KeyValuePairs)
+	assert.Equal(t, cell.BinaryData, decrypted.BinaryData)
+	assert.Equal(t, cell.FileName, decrypted.FileName)
+
+	// Расшифровка чужим ключом завершается ошибкой
+	_, err = storage.DecryptCell(encrypted, storage.GenerateKeyFromPassword("other"))
+	assert.Error(t, err)
+}
+
+func TestDeriveKeys(t *testing.T) {
+	keys := storage.DeriveKeys("user", "mypassword")
+
+	// Ключ шифрования совпадает с ключом хранилища, ключ аутентификации от него отличается
+	assert.Equal(t, storage.DeriveVaultKey("user", "mypassword"), keys.Vault)
+	assert.NotEmpty(t, keys.Auth)
+	assert.NotContains(t, keys.Auth, "mypassword")
+	assert.Equal(t, keys, storage.DeriveKeys("user", "mypassword"))
+	assert.NotEqual(t, keys.Auth, storage.DeriveKeys("user", "otherpassword").Auth)
+}
+
+func TestEncryptAndDecryptStream(t *testing.T) {
+	key := bytes.Repeat([]byte{7}, 32)
+	sizes := []int{0, 1, storage.StreamChunkSize, 2*storage.StreamChunkSize + 100}
+
+	for _, size := range sizes {
+		data := bytes.Repeat([]byte{'a'}, size)
+
+		var encrypted bytes.Buffer
+		err := storage.EncryptStream(&encrypted, bytes.NewReader(data), key)
+		assert.NoError(t, err)
+		assert.Equal(t, storage.EncryptedStreamSize(int64(size)), int64(encrypted.Len()))
+
+		var decrypted bytes.Buffer
+		err = storage.DecryptStream(&decrypted, bytes.NewReader(encrypted.Bytes()), key)
+		assert.NoError(t, err)
+		assert.Equal(t, string(data), decrypted.String())
+	}
+}
+
+func TestDecryptStream_Tampered(t *testing.T) {
+	key := bytes.Repeat([]byte{7}, 32)
+	data := bytes.Repeat([]byte{'a'}, 2*storage.StreamChunkSize+100)
+
+	var encrypted bytes.Buffer
+	err := storage.EncryptStream(&encrypted, bytes.NewReader(data), key)
+	assert.NoError(t, err)
+
+	// измененные данные
+	tampered := append([]byte(nil), encrypted.Bytes()...)
+	tampered[100] ^= 1
+	err = storage.DecryptStream(&bytes.Buffer{}, bytes.NewReader(tampered), key)
+	assert.Error(t, err)
+
+	// поток без последней части
+	truncated := encrypted.Bytes()[:encrypted.Len()-int(storage.EncryptedStreamSize(100))]
+	err = storage.DecryptStream(&bytes.Buffer{}, bytes.NewReader(truncated), key)
+	assert.Error(t, err)
+
+	// неверный ключ
+	err = storage.DecryptStream(&bytes.Buffer{}, bytes.NewReader(encrypted.Bytes()), bytes.Repeat([]byte{8}, 32))
+	assert.Error(t, err)
+}
+
+func TestEncryptAndDecryptCell_Payload(t *testing.T) {
+	key := storage.GenerateKeyFromPassword("mypassword")
+	cell := &pb.MemoryCell{
+		Info: &pb.InfoCell{Id: 1, DataType: "card", Description: "Card"},
+		Payload: &pb.MemoryCell_Card{Card: &pb.CardPayload{
+			Number: "4111111111111111",
+			Expiry: "07/27",
+			Cvv:    "123",
+		}},
+	}
+
+	encrypted, err := storage.EncryptCell(cell, key)
+	assert.NoError(t, err)
+	card := encrypted.GetCard()
+	assert.NotEqual(t, "4111111111111111", card.Number)
+	assert.NotEqual(t, "123", card.Cvv)
+	// пустые поля остаются пустыми
+	assert.Empty(t, card.Holder)
+	assert.Equal(t, "4111111111111111", cell.GetCard().Number, "исходная ячейка не должна изменяться")
+
+	decrypted, err := storage.DecryptCell(encrypted, key)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(cell.GetCard(), decrypted.GetCard()))
+}
+
+func TestSearch(t *testing.T) {
+	s := storage.NewStorage()
+	s.Sync([]*pb.MemoryCell{
+		{Info: &pb.InfoCell{Id: 1, Description: "Банк карта"}, Payload: &pb.MemoryCell_Card{Card: &pb.CardPayload{
+			Number: "4111111111111111",
+			Holder: "IVAN PETROV",
+		}}},
+		{Info: &pb.InfoCell{Id: 2, Description: "Почта"}, KeyValuePairs: map[string]string{"server": "imap.example.com"}},
+		{Info: &pb.InfoCell{Id: 3, Description: "Договор"}, FileName: "contract.pdf"},
+	})
+
+	assert.Equal(t, []int64{1}, searchIDs(s, "банк"))
+	assert.Equal(t, []int64{2}, searchIDs(s, "serv"))
+	assert.Equal(t, []int64{3}, searchIDs(s, "contarct"))
+
+	// значения ищутся только после явного включения, секретные поля не ищутся никогда
+	assert.Empty(t, searchIDs(s, "imap"))
+	s.SetIndexValues(true)
+	assert.Equal(t, []int64{2}, searchIDs(s, "imap"))
+	assert.Equal(t, []int64{1}, searchIDs(s, "petrov"))
+	assert.Empty(t, searchIDs(s, "4111111111111111"))
+
+	// индекс обновляется при изменении и удалении данных
+	s.Put(&pb.MemoryCell{Info: &pb.InfoCell{Id: 2, Description: "Рабочая почта"}})
+	s.Put(&pb.MemoryCell{Info: &pb.InfoCell{Id: 4, Description: "Личная почта"}})
+	assert.Equal(t, []int64{2, 4}, searchIDs(s, "почта"))
+	assert.Empty(t, searchIDs(s, "imap"))
+	s.Remove(2)
+	assert.Equal(t, []int64{4}, searchIDs(s, "почта"))
+
+	// индекс сохраняется рядом с дампом и загружается вместе с ним
+	assert.NoError(t, s.DumpToFile("mypassword"))
+	loaded := storage.NewStorage()
+	loaded.SetIndexValues(true)
+	assert.NoError(t, loaded.LoadFromDump("mypassword"))
+	assert.Equal(t, []int64{4}, searchIDs(loaded, "почта"))
+	assert.Equal(t, []int64{1}, searchIDs(loaded, "petrov"))
+}
+
+// searchIDs - возвращает InfoID найденных данных в порядке релевантности.
+func searchIDs(s *storage.Storage, query string) []int64 {
+	var ids []int64
+	for _, result := range s.Search(query, 0) {
+		ids = append(ids, result.ID)
+	}
+	return ids
+}
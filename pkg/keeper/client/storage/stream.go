@@ -0,0 +1,118 @@
+package storage
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// Размеры блоков потокового шифрования: данные шифруются частями по StreamChunkSize байт,
+// каждая часть дополняется nonce и тегом аутентификации AES-GCM.
+const (
+	StreamChunkSize     = 256 << 10
+	streamNonceSize     = 12
+	streamFrameOverhead = streamNonceSize + 16
+)
+
+// EncryptStream шифрует поток src частями и записывает результат в dst.
+// Номер части и признак последней части входят в аутентифицируемые данные,
+// поэтому перестановка, удаление или обрезка частей обнаруживаются при расшифровке.
+func EncryptStream(dst io.Writer, src io.Reader, key []byte) error {
+	aead, err := newGCM(key)
+	if err != nil {
+		return err
+	}
+
+	reader := bufio.NewReaderSize(src, StreamChunkSize)
+	chunk := make([]byte, StreamChunkSize)
+	for index := uint64(0); ; index++ {
+		n, err := io.ReadFull(reader, chunk)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return errors.Wrap(err, "failed to read data")
+		}
+		_, peekErr := reader.Peek(1)
+		last := peekErr == io.EOF
+
+		nonce := make([]byte, streamNonceSize)
+		if _, err := rand.Read(nonce); err != nil {
+			return errors.Wrap(err, "failed to generate nonce")
+		}
+		frame := aead.Seal(nonce, nonce, chunk[:n], streamAAD(index, last))
+		if _, err := dst.Write(frame); err != nil {
+			return errors.Wrap(err, "failed to write encrypted data")
+		}
+
+		if last {
+			return nil
+		}
+	}
+}
+
+// DecryptStream расшифровывает поток, зашифрованный EncryptStream, и записывает результат в dst.
+func DecryptStream(dst io.Writer, src io.Reader, key []byte) error {
+	aead, err := newGCM(key)
+	if err != nil {
+		return err
+	}
+
+	frameSize := StreamChunkSize + streamFrameOverhead
+	reader := bufio.NewReaderSize(src, frameSize)
+	frame := make([]byte, frameSize)
+	for index := uint64(0); ; index++ {
+		n, err := io.ReadFull(reader, frame)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return errors.Wrap(err, "failed to read encrypted data")
+		}
+		if n < streamFrameOverhead {
+			return errors.New("encrypted data is truncated")
+		}
+		_, peekErr := reader.Peek(1)
+		last := peekErr == io.EOF
+
+		nonce, ciphertext := frame[:streamNonceSize], frame[streamNonceSize:n]
+		plaintext, err := aead.Open(nil, nonce, ciphertext, streamAAD(index, last))
+		if err != nil {
+			return errors.Wrap(err, "failed to decrypt data")
+		}
+		if _, err := dst.Write(plaintext); err != nil {
+			return errors.Wrap(err, "failed to write data")
+		}
+
+		if last {
+			return nil
+		}
+	}
+}
+
+// EncryptedStreamSize возвращает размер результата EncryptStream для данных размером size.
+func EncryptedStreamSize(size int64) int64 {
+	frames := size/StreamChunkSize + 1
+	if size > 0 && size%StreamChunkSize == 0 {
+		frames--
+	}
+	return size + frames*streamFrameOverhead
+}
+
+// streamAAD - аутентифицируемые данные части: ее номер и признак последней части.
+func streamAAD(index uint64, last bool) []byte {
+	aad := make([]byte, 9)
+	binary.BigEndian.PutUint64(aad, index)
+	if last {
+		aad[8] = 1
+	}
+	return aad
+}
+
+// newGCM - создает AES-GCM с указанным ключом.
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,208 @@
+package storage
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+
+	"github.com/bubu256/gophkeeper_pet/internal/proto/pb"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/argon2"
+	"google.golang.org/protobuf/proto"
+)
+
+// Параметры Argon2id для получения ключа хранилища.
+// Ключ должен совпадать на всех устройствах пользователя, поэтому параметры фиксированы.
+const (
+	vaultKeyTime    uint32 = 3
+	vaultKeyMemory  uint32 = 64 * 1024
+	vaultKeyThreads uint8  = 2
+	vaultKeyLength  uint32 = 32
+)
+
+// Keys - ключи, получаемые клиентом из мастер-пароля.
+type Keys struct {
+	// Auth - ключ аутентификации, передается серверу вместо мастер-пароля.
+	Auth string
+	// Vault - ключ шифрования данных, никогда не покидает клиент.
+	Vault []byte
+}
+
+// DeriveKeys - получает из мастер-пароля ключ шифрования данных и независимый от него ключ аутентификации.
+// Ключ аутентификации вычисляется из ключа шифрования односторонней функцией HMAC-SHA256,
+// поэтому сервер, зная его, не может восстановить ни мастер-пароль, ни ключ шифрования.
+func DeriveKeys(username, password string) Keys {
+	vault := DeriveVaultKey(username, password)
+	mac := hmac.New(sha256.New, vault)
+	mac.Write([]byte("gophkeeper-auth"))
+	return Keys{
+		Auth:  base64.StdEncoding.EncodeToString(mac.Sum(nil)),
+		Vault: vault,
+	}
+}
+
+// DeriveVaultKey - получает ключ шифрования данных пользователя из мастер-пароля.
+// Соль вычисляется из логина, поэтому ключ воспроизводится на любом устройстве без обращения к серверу.
+func DeriveVaultKey(username, password string) []byte {
+	salt := sha256.Sum256([]byte("gophkeeper:" + username))
+	return argon2.IDKey([]byte(password), salt[:], vaultKeyTime, vaultKeyMemory, vaultKeyThreads, vaultKeyLength)
+}
+
+// EncryptCell - возвращает копию ячейки с зашифрованными парами ключ-значение, содержимым, именем файла и бинарными данными.
+// Тип и описание данных остаются открытыми, чтобы сервер мог показывать список данных.
+// Уже зашифрованная ячейка возвращается без изменений.
+func EncryptCell(cell *pb.MemoryCell, key []byte) (*pb.MemoryCell, error) {
+	if cell.Encrypted {
+		return cell, nil
+	}
+	encrypted := proto.Clone(cell).(*pb.MemoryCell)
+
+	if len(cell.KeyValuePairs) > 0 {
+		encrypted.KeyValuePairs = make(map[string]string, len(cell.KeyValuePairs))
+		for k, v := range cell.KeyValuePairs {
+			encKey, err := encryptString(k, key)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to encrypt key")
+			}
+			encValue, err := encryptString(v, key)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to encrypt value")
+			}
+			encrypted.KeyValuePairs[encKey] = encValue
+		}
+	}
+
+	if cell.FileName != "" {
+		fileName, err := encryptString(cell.FileName, key)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to encrypt file name")
+		}
+		encrypted.FileName = fileName
+	}
+
+	for _, field := range payloadFields(encrypted) {
+		if *field == "" {
+			continue
+		}
+		value, err := encryptString(*field, key)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to encrypt payload")
+		}
+		*field = value
+	}
+
+	if len(cell.BinaryData) > 0 {
+		binaryData, err := Encrypt(cell.BinaryData, key)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to encrypt binary data")
+		}
+		encrypted.BinaryData = binaryData
+	}
+
+	encrypted.Encrypted = true
+	return encrypted, nil
+}
+
+// DecryptCell - возвращает расшифрованную копию ячейки. Незашифрованная ячейка возвращается без изменений.
+func DecryptCell(cell *pb.MemoryCell, key []byte) (*pb.MemoryCell, error) {
+	if !cell.Encrypted {
+		return cell, nil
+	}
+	decrypted := proto.Clone(cell).(*pb.MemoryCell)
+
+	if len(cell.KeyValuePairs) > 0 {
+		decrypted.KeyValuePairs = make(map[string]string, len(cell.KeyValuePairs))
+		for k, v := range cell.KeyValuePairs {
+			plainKey, err := decryptString(k, key)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to decrypt key")
+			}
+			plainValue, err := decryptString(v, key)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to decrypt value")
+			}
+			decrypted.KeyValuePairs[plainKey] = plainValue
+		}
+	}
+
+	if cell.FileName != "" {
+		fileName, err := decryptString(cell.FileName, key)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decrypt file name")
+		}
+		decrypted.FileName = fileName
+	}
+
+	for _, field := range payloadFields(decrypted) {
+		if *field == "" {
+			continue
+		}
+		value, err := decryptString(*field, key)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decrypt payload")
+		}
+		*field = value
+	}
+
+	if len(cell.BinaryData) > 0 {
+		binaryData, err := Decrypt(cell.BinaryData, key)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decrypt binary data")
+		}
+		decrypted.BinaryData = binaryData
+	}
+
+	decrypted.Encrypted = false
+	return decrypted, nil
+}
+
+// payloadFields - возвращает указатели на строковые поля содержимого ячейки.
+// Пустые поля не шифруются, чтобы сервер мог проверить наличие обязательных полей.
+func payloadFields(cell *pb.MemoryCell) []*string {
+	switch payload := cell.Payload.(type) {
+	case *pb.MemoryCell_Login:
+		if payload.Login != nil {
+			return []*string{&payload.Login.Url, &payload.Login.Username, &payload.Login.Password}
+		}
+	case *pb.MemoryCell_Card:
+		if payload.Card != nil {
+			return []*string{&payload.Card.Number, &payload.Card.Holder, &payload.Card.Expiry, &payload.Card.Cvv}
+		}
+	case *pb.MemoryCell_Note:
+		if payload.Note != nil {
+			return []*string{&payload.Note.Text}
+		}
+	case *pb.MemoryCell_File:
+		if payload.File != nil {
+			return []*string{&payload.File.MediaType}
+		}
+	case *pb.MemoryCell_Otp:
+		if payload.Otp != nil {
+			otp := payload.Otp
+			return []*string{&otp.Type, &otp.Issuer, &otp.Account, &otp.Secret, &otp.Algorithm, &otp.Digits, &otp.Period, &otp.Counter}
+		}
+	}
+	return nil
+}
+
+// encryptString - шифрует строку и кодирует результат в base64.
+func encryptString(s string, key []byte) (string, error) {
+	ciphertext, err := Encrypt([]byte(s), key)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+// decryptString - декодирует строку из base64 и расшифровывает ее.
+func decryptString(s string, key []byte) (string, error) {
+	ciphertext, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := Decrypt(ciphertext, key)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
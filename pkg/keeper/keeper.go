@@ -5,10 +5,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/bubu256/gophkeeper_pet/config"
 	"github.com/bubu256/gophkeeper_pet/internal/schema"
-	_ "github.com/jackc/pgconn"
+	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
@@ -16,16 +18,55 @@ import (
 // Keeper представляет интерфейс для взаимодействия с базой данных.
 type Keeper interface {
 	GetUserDataInfo(userID int64) ([]*schema.InfoCell, error)
+	QueryUserDataInfo(userID int64, query schema.InfoQuery) ([]*schema.InfoCell, error)
 	GetDataByInfoIDs(infoIDs []int64) ([]*schema.MemoryCell, error)
 	UpdateMemoryCell(memoryCell schema.MemoryCell) (bool, error)
 	UpdateInfoCell(infoCell schema.InfoCell) (bool, error)
+	UpdateData(infoCell schema.InfoCell, memoryCell schema.MemoryCell, expectedRevision int64) (int64, error)
 	AddData(infoCell schema.InfoCell, memoryCell *schema.MemoryCell) (int64, error)
 	DeleteData(infoIDs []int64) (bool, error)
 	GetUserByUsername(username string) (*schema.User, error)
+	GetUserByID(userID int64) (*schema.User, error)
 	CreateUser(user *schema.User) error
+	UpdateUserPassword(userID int64, passwordHash string) error
+	UpdateUserCredentials(userID int64, passwordHash, clientKDF string) error
+	CreateSession(session *schema.Session) error
+	GetSession(sessionID string) (*schema.Session, error)
+	RotateRefreshToken(sessionID, oldHash, newHash string, expiresAt time.Time) (bool, error)
+	RevokeSession(sessionID string) error
+	RevokeUserSessions(userID int64) error
+	GetBlob(infoID int64) (*schema.Blob, error)
+	CreateBlob(blob schema.Blob) error
+	AppendBlobChunk(infoID, offset int64, data []byte) error
+	CompleteBlob(infoID int64) error
+	DeleteBlob(infoID int64) error
+	ReadBlob(infoID, offset int64, fn func(offset int64, data []byte) error) error
+	GetChangesSince(userID, revision int64) (*schema.Changes, error)
+	GetTrash(userID int64) ([]*schema.InfoCell, error)
+	RestoreData(infoIDs []int64) error
+	EmptyTrash(userID int64) (int64, error)
+	PurgeTrash(before time.Time) (int64, error)
+	GetVersions(infoID int64) ([]*schema.Version, error)
+	GetVersion(infoID, revision int64) (*schema.Version, error)
+	GetFolders(userID int64) ([]*schema.Folder, error)
+	CreateFolder(folder *schema.Folder) error
+	UpdateFolder(folder schema.Folder) error
+	MoveFolder(userID, folderID, parentID int64) error
+	MoveData(infoIDs []int64, folderID int64) error
+	TagData(infoIDs []int64, add, remove []string) error
+	GetTags(userID int64) ([]*schema.Tag, error)
 	Ping() error
 }
 
+// ErrNotFound возвращается, если запрошенная запись отсутствует в базе данных.
+var ErrNotFound = errors.New("not found")
+
+// ErrConflict возвращается, если запись была изменена параллельно и операция не может быть применена.
+var ErrConflict = errors.New("conflict")
+
+// ErrCycle возвращается при попытке переместить папку в саму себя или во вложенную в нее папку.
+var ErrCycle = errors.New("cycle")
+
 // StoragePG представляет хранилище данных PostgreSQL.
 type StoragePG struct {
 	db  *pgxpool.Pool
@@ -57,9 +98,10 @@ func New(cfg config.ServerConfig) (*StoragePG, error) {
 }
 
 // GetUserByUsername возвращает пользователя по его имени пользователя (username).
+// Если пользователь не найден, возвращает ErrNotFound.
 func (s *StoragePG) GetUserByUsername(username string) (*schema.User, error) {
 	query := `
-			SELECT id, username, password_hash
+			SELECT id, username, password_hash, client_kdf
 			FROM users
 			WHERE username = $1
 		`
@@ -71,11 +113,37 @@ func (s *StoragePG) GetUserByUsername(username string) (*schema.User, error) {
 		&user.ID,
 		&user.Username,
 		&user.Password,
+		&user.ClientKDF,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan row: %w", err)
+	}
+
+	return user, nil
+}
+
+// GetUserByID возвращает пользователя по его ID или ErrNotFound.
+func (s *StoragePG) GetUserByID(userID int64) (*schema.User, error) {
+	query := `
+			SELECT id, username, password_hash, client_kdf
+			FROM users
+			WHERE id = $1
+		`
+
+	user := &schema.User{}
+	err := s.db.QueryRow(context.Background(), query, userID).Scan(
+		&user.ID,
+		&user.Username,
+		&user.Password,
+		&user.ClientKDF,
 	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
 	if err != nil {
-		// if err == pgx.ErrNoRows {
-		// 	return user, nil
-		// }
 		return nil, fmt.Errorf("failed to scan row: %w", err)
 	}
 
@@ -85,8 +153,8 @@ func (s *StoragePG) GetUserByUsername(username string) (*schema.User, error) {
 // CreateUser создает нового пользователя.
 func (s *StoragePG) CreateUser(user *schema.User) error {
 	query := `
-			INSERT INTO users (username, password_hash)
-			VALUES ($1, $2)
+			INSERT INTO users (username, password_hash, client_kdf)
+			VALUES ($1, $2, $3)
 			RETURNING id
 		`
 
@@ -95,6 +163,7 @@ func (s *StoragePG) CreateUser(user *schema.User) error {
 		query,
 		user.Username,
 		user.Password,
+		user.ClientKDF,
 	).Scan(&user.ID)
 	if err != nil {
 		return fmt.Errorf("failed to execute insert query: %w", err)
@@ -103,15 +172,232 @@ func (s *StoragePG) CreateUser(user *schema.User) error {
 	return nil
 }
 
-// GetUserSavedDataInfo возвращает информацию о всех сохраненных данных пользователя по его ID.
-func (s *StoragePG) GetUserDataInfo(userID int64) ([]*schema.InfoCell, error) {
+// UpdateUserPassword заменяет хеш пароля пользователя.
+func (s *StoragePG) UpdateUserPassword(userID int64, passwordHash string) error {
 	query := `
-			SELECT id, data_type, data_size, description, owner_id
-			FROM info_cells
-			WHERE owner_id = $1
+			UPDATE users
+			SET password_hash = $1
+			WHERE id = $2
 		`
 
-	rows, err := s.db.Query(context.Background(), query, userID)
+	result, err := s.db.Exec(context.Background(), query, passwordHash, userID)
+	if err != nil {
+		return fmt.Errorf("failed to execute update query: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return errors.New("no rows affected")
+	}
+
+	return nil
+}
+
+// UpdateUserCredentials заменяет хеш пароля пользователя и способ его получения на клиенте.
+func (s *StoragePG) UpdateUserCredentials(userID int64, passwordHash, clientKDF string) error {
+	query := `
+			UPDATE users
+			SET password_hash = $1, client_kdf = $2
+			WHERE id = $3
+		`
+
+	result, err := s.db.Exec(context.Background(), query, passwordHash, clientKDF, userID)
+	if err != nil {
+		return fmt.Errorf("failed to execute update query: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return errors.New("no rows affected")
+	}
+
+	return nil
+}
+
+// CreateSession сохраняет новую сессию пользователя.
+func (s *StoragePG) CreateSession(session *schema.Session) error {
+	query := `
+			INSERT INTO sessions (id, user_id, refresh_token_hash, expires_at)
+			VALUES ($1, $2, $3, $4)
+			RETURNING created_at
+		`
+
+	err := s.db.QueryRow(
+		context.Background(),
+		query,
+		session.ID,
+		session.UserID,
+		session.RefreshTokenHash,
+		session.ExpiresAt.UTC(),
+	).Scan(&session.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to execute insert query: %w", err)
+	}
+
+	return nil
+}
+
+// GetSession возвращает сессию по ее идентификатору или ErrNotFound.
+func (s *StoragePG) GetSession(sessionID string) (*schema.Session, error) {
+	query := `
+			SELECT id, user_id, refresh_token_hash, created_at, expires_at, revoked_at
+			FROM sessions
+			WHERE id = $1
+		`
+
+	session := &schema.Session{}
+	err := s.db.QueryRow(context.Background(), query, sessionID).Scan(
+		&session.ID,
+		&session.UserID,
+		&session.RefreshTokenHash,
+		&session.CreatedAt,
+		&session.ExpiresAt,
+		&session.RevokedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan row: %w", err)
+	}
+
+	return session, nil
+}
+
+// RotateRefreshToken заменяет хеш токена обновления и продлевает сессию.
+// Замена выполняется, только если текущий хеш совпадает с oldHash и сессия активна;
+// в противном случае возвращается false.
+func (s *StoragePG) RotateRefreshToken(sessionID, oldHash, newHash string, expiresAt time.Time) (bool, error) {
+	query := `
+			UPDATE sessions
+			SET refresh_token_hash = $1, expires_at = $2
+			WHERE id = $3 AND refresh_token_hash = $4
+				AND revoked_at IS NULL AND expires_at > $5
+		`
+
+	result, err := s.db.Exec(context.Background(), query, newHash, expiresAt.UTC(), sessionID, oldHash, time.Now().UTC())
+	if err != nil {
+		return false, fmt.Errorf("failed to execute update query: %w", err)
+	}
+
+	return result.RowsAffected() == 1, nil
+}
+
+// RevokeSession отзывает сессию по ее идентификатору.
+func (s *StoragePG) RevokeSession(sessionID string) error {
+	query := `
+			UPDATE sessions
+			SET revoked_at = CURRENT_TIMESTAMP
+			WHERE id = $1 AND revoked_at IS NULL
+		`
+
+	_, err := s.db.Exec(context.Background(), query, sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to execute update query: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeUserSessions отзывает все активные сессии пользователя.
+func (s *StoragePG) RevokeUserSessions(userID int64) error {
+	query := `
+			UPDATE sessions
+			SET revoked_at = CURRENT_TIMESTAMP
+			WHERE user_id = $1 AND revoked_at IS NULL
+		`
+
+	_, err := s.db.Exec(context.Background(), query, userID)
+	if err != nil {
+		return fmt.Errorf("failed to execute update query: %w", err)
+	}
+
+	return nil
+}
+
+// GetUserSavedDataInfo возвращает информацию о всех сохраненных данных пользователя по его ID.
+func (s *StoragePG) GetUserDataInfo(userID int64) ([]*schema.InfoCell, error) {
+	return s.QueryUserDataInfo(userID, schema.InfoQuery{Sort: schema.SortByID})
+}
+
+// infoSortColumns - выражения, по которым сортируется информация о данных в каждом порядке сортировки.
+var infoSortColumns = map[string]string{
+	schema.SortByID:          "i.id",
+	schema.SortByCreated:     "i.created_at",
+	schema.SortByUpdated:     "i.updated_at",
+	schema.SortByDescription: "COALESCE(i.description, '')",
+}
+
+// QueryUserDataInfo возвращает информацию о данных пользователя вне корзины, отобранную и упорядоченную по query.
+// При равных значениях поля сортировки записи упорядочиваются по ID, поэтому позиция query.After однозначна.
+func (s *StoragePG) QueryUserDataInfo(userID int64, query schema.InfoQuery) ([]*schema.InfoCell, error) {
+	column, ok := infoSortColumns[query.Sort]
+	if !ok {
+		return nil, fmt.Errorf("unknown sort order %q", query.Sort)
+	}
+
+	args := []interface{}{userID}
+	arg := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	conditions := []string{"i.owner_id = $1", "i.deleted_at IS NULL"}
+	filter := query.Filter
+	if len(filter.DataTypes) > 0 {
+		conditions = append(conditions, "i.data_type = ANY("+arg(filter.DataTypes)+")")
+	}
+	if filter.Text != "" {
+		conditions = append(conditions, "i.description ILIKE "+arg("%"+escapeLike(filter.Text)+"%"))
+	}
+	if !filter.CreatedAfter.IsZero() {
+		conditions = append(conditions, "i.created_at >= "+arg(filter.CreatedAfter.UTC()))
+	}
+	if !filter.CreatedBefore.IsZero() {
+		conditions = append(conditions, "i.created_at < "+arg(filter.CreatedBefore.UTC()))
+	}
+	if !filter.UpdatedAfter.IsZero() {
+		conditions = append(conditions, "i.updated_at >= "+arg(filter.UpdatedAfter.UTC()))
+	}
+	if !filter.UpdatedBefore.IsZero() {
+		conditions = append(conditions, "i.updated_at < "+arg(filter.UpdatedBefore.UTC()))
+	}
+	if len(filter.Tags) > 0 {
+		conditions = append(conditions, `(
+				SELECT COUNT(DISTINCT t.name)
+				FROM cell_tags ct
+				INNER JOIN tags t ON t.id = ct.tag_id
+				WHERE ct.info_id = i.id AND t.name = ANY(`+arg(filter.Tags)+`)
+			) = `+arg(len(filter.Tags)))
+	}
+
+	direction, comparison := "ASC", ">"
+	if query.Descending {
+		direction, comparison = "DESC", "<"
+	}
+	if after := query.After; after != nil {
+		switch query.Sort {
+		case schema.SortByID:
+			conditions = append(conditions, fmt.Sprintf("i.id %s %s", comparison, arg(after.ID)))
+		case schema.SortByDescription:
+			conditions = append(conditions, fmt.Sprintf("(%s, i.id) %s (%s, %s)", column, comparison, arg(after.Text), arg(after.ID)))
+		default:
+			conditions = append(conditions, fmt.Sprintf("(%s, i.id) %s (%s, %s)", column, comparison, arg(after.Time.UTC()), arg(after.ID)))
+		}
+	}
+
+	sql := `
+			SELECT i.id, i.data_type, i.data_size, i.description, i.owner_id, i.revision,
+				COALESCE(i.folder_id, 0), ` + cellTagsColumn + `, i.created_at, i.updated_at
+			FROM info_cells i
+			WHERE ` + strings.Join(conditions, " AND ") + `
+			ORDER BY ` + column + ` ` + direction
+	if query.Sort != schema.SortByID {
+		sql += `, i.id ` + direction
+	}
+	if query.Limit > 0 {
+		sql += ` LIMIT ` + arg(query.Limit)
+	}
+
+	rows, err := s.db.Query(context.Background(), sql, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -127,6 +413,11 @@ func (s *StoragePG) GetUserDataInfo(userID int64) ([]*schema.InfoCell, error) {
 			&infoCell.DataSize,
 			&infoCell.Description,
 			&infoCell.OwnerID,
+			&infoCell.Revision,
+			&infoCell.FolderID,
+			&infoCell.Tags,
+			&infoCell.CreatedAt,
+			&infoCell.UpdatedAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan row: %w", err)
@@ -142,28 +433,49 @@ func (s *StoragePG) GetUserDataInfo(userID int64) ([]*schema.InfoCell, error) {
 	return infoCells, nil
 }
 
+// escapeLike - экранирует специальные символы шаблона LIKE, чтобы строка искалась как есть.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
 // GetDataByInfoIDs возвращает данные, соответствующие заданным InfoID.
 func (s *StoragePG) GetDataByInfoIDs(infoIDs []int64) ([]*schema.MemoryCell, error) {
-	memoryCells := make([]*schema.MemoryCell, 0, len(infoIDs))
-
 	if len(infoIDs) == 0 {
-		return memoryCells, nil
+		return make([]*schema.MemoryCell, 0), nil
 	}
 
-	query := `
-			SELECT m.id, m.info_id, m.encrypted, m.key_value_pairs, m.binary_data, m.file_name,
-				i.data_type, i.data_size, i.description, i.owner_id
+	rows, err := s.db.Query(context.Background(), selectMemoryCellsQuery+` WHERE i.id = ANY($1) AND i.deleted_at IS NULL`, infoIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return scanMemoryCells(rows, len(infoIDs))
+}
+
+// selectMemoryCellsQuery - запрос ячеек памяти вместе с информационными ячейками, условие добавляется к запросу.
+const selectMemoryCellsQuery = `
+			SELECT m.id, m.info_id, m.encrypted, m.key_value_pairs, m.binary_data, m.file_name, m.payload,
+				COALESCE(b.complete, FALSE),
+				i.data_type, i.data_size, i.description, i.owner_id, i.revision,
+				COALESCE(i.folder_id, 0), ` + cellTagsColumn + `, i.created_at, i.updated_at
 			FROM memory_cells m
 			INNER JOIN info_cells i ON m.info_id = i.id
-			WHERE i.id = ANY($1)
+			LEFT JOIN blobs b ON b.info_id = i.id
 		`
 
-	rows, err := s.db.Query(context.Background(), query, infoIDs)
-	if err != nil {
-		return nil, fmt.Errorf("failed to execute query: %w", err)
-	}
+// cellTagsColumn - подзапрос отсортированных меток информационной ячейки i.
+const cellTagsColumn = `COALESCE((
+				SELECT array_agg(t.name ORDER BY t.name)
+				FROM cell_tags ct
+				INNER JOIN tags t ON t.id = ct.tag_id
+				WHERE ct.info_id = i.id
+			), '{}')`
+
+// scanMemoryCells - считывает строки запроса selectMemoryCellsQuery и закрывает rows.
+func scanMemoryCells(rows pgx.Rows, capacity int) ([]*schema.MemoryCell, error) {
 	defer rows.Close()
 
+	memoryCells := make([]*schema.MemoryCell, 0, capacity)
 	for rows.Next() {
 		memoryCell := &schema.MemoryCell{}
 		infoCell := &schema.InfoCell{}
@@ -175,10 +487,17 @@ func (s *StoragePG) GetDataByInfoIDs(infoIDs []int64) ([]*schema.MemoryCell, err
 			&memoryCell.KeyValuePairs,
 			&memoryCell.BinaryData,
 			&memoryCell.FileName,
+			&memoryCell.Payload,
+			&memoryCell.Blob,
 			&infoCell.DataType,
 			&infoCell.DataSize,
 			&infoCell.Description,
 			&infoCell.OwnerID,
+			&infoCell.Revision,
+			&infoCell.FolderID,
+			&infoCell.Tags,
+			&infoCell.CreatedAt,
+			&infoCell.UpdatedAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan row: %w", err)
@@ -195,30 +514,54 @@ func (s *StoragePG) GetDataByInfoIDs(infoIDs []int64) ([]*schema.MemoryCell, err
 	return memoryCells, nil
 }
 
-// UpdateMemoryCell обновляет данные ячейки памяти на основе InfoID.
-func (s *StoragePG) UpdateMemoryCell(memoryCell schema.MemoryCell) (bool, error) {
-	query := `
+// updateMemoryCellQuery - запрос обновления ячейки памяти по InfoID.
+const updateMemoryCellQuery = `
 			UPDATE memory_cells
-			SET encrypted = $1, key_value_pairs = $2, binary_data = $3, file_name = $4
-			WHERE info_id = $5
+			SET encrypted = $1, key_value_pairs = $2, binary_data = $3, file_name = $4, payload = $5
+			WHERE info_id = $6
 		`
 
-	result, err := s.db.Exec(
-		context.Background(),
-		query,
-		memoryCell.Encrypted,
-		memoryCell.KeyValuePairs,
-		memoryCell.BinaryData,
-		memoryCell.FileName,
-		memoryCell.InfoID,
-	)
-	if err != nil {
-		return false, fmt.Errorf("failed to execute query: %w", err)
-	}
+// updateInfoCellQuery - запрос обновления информационной ячейки по ID.
+const updateInfoCellQuery = `
+			UPDATE info_cells
+			SET data_type = $1, data_size = $2, description = $3
+			WHERE id = $4
+		`
 
-	rowsAffected := result.RowsAffected()
-	if rowsAffected == 0 {
-		return false, errors.New("no rows affected")
+// UpdateMemoryCell обновляет данные ячейки памяти на основе InfoID.
+func (s *StoragePG) UpdateMemoryCell(memoryCell schema.MemoryCell) (bool, error) {
+	ctx := context.Background()
+	err := s.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if _, err := lockRevision(ctx, tx, memoryCell.InfoID); err != nil {
+			return err
+		}
+		if err := saveVersion(ctx, tx, memoryCell.InfoID); err != nil {
+			return err
+		}
+		if _, err := bumpRevision(ctx, tx, memoryCell.InfoID); err != nil {
+			return err
+		}
+
+		result, err := tx.Exec(
+			ctx,
+			updateMemoryCellQuery,
+			memoryCell.Encrypted,
+			memoryCell.KeyValuePairs,
+			memoryCell.BinaryData,
+			memoryCell.FileName,
+			memoryCell.Payload,
+			memoryCell.InfoID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to execute query: %w", err)
+		}
+		if result.RowsAffected() == 0 {
+			return errors.New("no rows affected")
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
 	}
 
 	return true, nil
@@ -226,33 +569,118 @@ func (s *StoragePG) UpdateMemoryCell(memoryCell schema.MemoryCell) (bool, error)
 
 // UpdateInfoCell обновляет данные информационной ячейки на основе ID.
 func (s *StoragePG) UpdateInfoCell(infoCell schema.InfoCell) (bool, error) {
-	query := `
-			UPDATE info_cells
-			SET data_type = $1, data_size = $2, description = $3
-			WHERE id = $4
-		`
+	ctx := context.Background()
+	err := s.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if _, err := lockRevision(ctx, tx, infoCell.ID); err != nil {
+			return err
+		}
+		if err := saveVersion(ctx, tx, infoCell.ID); err != nil {
+			return err
+		}
+		if _, err := bumpRevision(ctx, tx, infoCell.ID); err != nil {
+			return err
+		}
 
-	result, err := s.db.Exec(
-		context.Background(),
-		query,
-		infoCell.DataType,
-		infoCell.DataSize,
-		infoCell.Description,
-		infoCell.ID,
-	)
+		result, err := tx.Exec(
+			ctx,
+			updateInfoCellQuery,
+			infoCell.DataType,
+			infoCell.DataSize,
+			infoCell.Description,
+			infoCell.ID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to execute query: %w", err)
+		}
+		if result.RowsAffected() == 0 {
+			return errors.New("no rows affected")
+		}
+		return nil
+	})
 	if err != nil {
-		return false, fmt.Errorf("failed to execute query: %w", err)
+		return false, err
 	}
 
-	rowsAffected := result.RowsAffected()
-	if rowsAffected == 0 {
-		return false, errors.New("no rows affected")
+	return true, nil
+}
+
+// UpdateData обновляет информационную ячейку и ячейку памяти в одной транзакции и возвращает новую ревизию данных.
+// Текущая версия данных сохраняется в истории версий.
+// Если expectedRevision не равна нулю и не совпадает с текущей ревизией данных, возвращает ErrConflict:
+// данные были изменены параллельно. Если данных нет, возвращает ErrNotFound.
+// Если memoryCell.Blob не установлен, бинарные данные, загруженные потоком, удаляются.
+func (s *StoragePG) UpdateData(infoCell schema.InfoCell, memoryCell schema.MemoryCell, expectedRevision int64) (int64, error) {
+	ctx := context.Background()
+	var revision int64
+	err := s.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		current, err := lockRevision(ctx, tx, infoCell.ID)
+		if err != nil {
+			return err
+		}
+		if expectedRevision != 0 && current != expectedRevision {
+			return ErrConflict
+		}
+		if err := saveVersion(ctx, tx, infoCell.ID); err != nil {
+			return err
+		}
+
+		revision, err = bumpRevision(ctx, tx, infoCell.ID)
+		if err != nil {
+			return err
+		}
+
+		result, err := tx.Exec(
+			ctx,
+			updateInfoCellQuery,
+			infoCell.DataType,
+			infoCell.DataSize,
+			infoCell.Description,
+			infoCell.ID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update info cell: %w", err)
+		}
+		if result.RowsAffected() == 0 {
+			return errors.New("info cell: no rows affected")
+		}
+
+		result, err = tx.Exec(
+			ctx,
+			updateMemoryCellQuery,
+			memoryCell.Encrypted,
+			memoryCell.KeyValuePairs,
+			memoryCell.BinaryData,
+			memoryCell.FileName,
+			memoryCell.Payload,
+			infoCell.ID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update memory cell: %w", err)
+		}
+		if result.RowsAffected() == 0 {
+			return errors.New("memory cell: no rows affected")
+		}
+
+		if !memoryCell.Blob {
+			_, err = tx.Exec(ctx, `DELETE FROM blobs WHERE info_id = $1`, infoCell.ID)
+			if err != nil {
+				return fmt.Errorf("failed to delete blob: %w", err)
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, ErrConflict) || errors.Is(err, ErrNotFound) {
+		return 0, err
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to update data: %w", err)
 	}
 
-	return true, nil
+	return revision, nil
 }
 
 // AddData добавляет новые данные в базу данных.
+// В memoryCell записываются назначенные данным InfoID и ревизия.
 func (s *StoragePG) AddData(infoCell schema.InfoCell, memoryCell *schema.MemoryCell) (int64, error) {
 	insertQuery := `
 		WITH inserted_info AS (
@@ -260,45 +688,92 @@ func (s *StoragePG) AddData(infoCell schema.InfoCell, memoryCell *schema.MemoryC
 			VALUES ($1, $2, $3, $4)
 			RETURNING id
 		)
-		INSERT INTO memory_cells (info_id, encrypted, key_value_pairs, binary_data, file_name)
-		SELECT id, $5, $6, $7, $8
+		INSERT INTO memory_cells (info_id, encrypted, key_value_pairs, binary_data, file_name, payload)
+		SELECT id, $5, $6, $7, $8, $9
 		FROM inserted_info
 		RETURNING info_id
 	`
 
-	var infoID int64
-	err := s.db.QueryRow(
-		context.Background(),
-		insertQuery,
-		infoCell.DataType,
-		infoCell.DataSize,
-		infoCell.Description,
-		infoCell.OwnerID,
-		memoryCell.Encrypted,
-		memoryCell.KeyValuePairs,
-		memoryCell.BinaryData,
-		memoryCell.FileName,
-	).Scan(&infoID)
+	ctx := context.Background()
+	var infoID, revision int64
+	err := s.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		err := tx.QueryRow(
+			ctx,
+			insertQuery,
+			infoCell.DataType,
+			infoCell.DataSize,
+			infoCell.Description,
+			infoCell.OwnerID,
+			memoryCell.Encrypted,
+			memoryCell.KeyValuePairs,
+			memoryCell.BinaryData,
+			memoryCell.FileName,
+			memoryCell.Payload,
+		).Scan(&infoID)
+		if err != nil {
+			return fmt.Errorf("failed to execute insert query: %w", err)
+		}
+
+		revision, err = bumpRevision(ctx, tx, infoID)
+		return err
+	})
 	if err != nil {
-		return 0, fmt.Errorf("failed to execute insert query: %w", err)
+		return 0, err
 	}
 
+	memoryCell.InfoID = infoID
+	if memoryCell.InfoCell != nil {
+		memoryCell.InfoCell.ID = infoID
+		memoryCell.InfoCell.Revision = revision
+	}
 	return infoID, nil
 }
 
-// DeleteData удаляет данные из базы данных на основе заданных InfoID.
+// DeleteData перемещает данные с заданными InfoID в корзину.
+// Для данных в корзине сохраняется запись об удалении, чтобы клиенты удалили их из локальной копии.
 func (s *StoragePG) DeleteData(infoIDs []int64) (bool, error) {
-	query := `
-			DELETE FROM info_cells
-			WHERE id = ANY($1)
-		`
+	ctx := context.Background()
+	var rowsAffected int64
+	err := s.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		for _, infoID := range infoIDs {
+			// данные, которых нет или которые уже в корзине, пропускаются
+			_, err := lockRevision(ctx, tx, infoID)
+			if errors.Is(err, ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			revision, err := bumpRevision(ctx, tx, infoID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tx.Exec(ctx, `UPDATE info_cells SET deleted_at = CURRENT_TIMESTAMP WHERE id = $1`, infoID)
+			if err != nil {
+				return fmt.Errorf("failed to move to trash: %w", err)
+			}
 
-	result, err := s.db.Exec(context.Background(), query, infoIDs)
+			_, err = tx.Exec(
+				ctx,
+				`INSERT INTO deleted_cells (info_id, owner_id, revision)
+				SELECT id, owner_id, $2 FROM info_cells WHERE id = $1
+				ON CONFLICT (info_id) DO UPDATE SET revision = EXCLUDED.revision, deleted_at = CURRENT_TIMESTAMP`,
+				infoID,
+				revision,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to save deleted cell: %w", err)
+			}
+			rowsAffected++
+		}
+		return nil
+	})
 	if err != nil {
 		return false, fmt.Errorf("failed to execute query: %w", err)
 	}
 
-	rowsAffected := result.RowsAffected()
 	if rowsAffected == 0 {
 		return false, errors.New("no rows affected")
 	}
@@ -306,6 +781,809 @@ func (s *StoragePG) DeleteData(infoIDs []int64) (bool, error) {
 	return true, nil
 }
 
+// GetTrash возвращает информацию о данных пользователя в корзине, начиная с последних удаленных.
+func (s *StoragePG) GetTrash(userID int64) ([]*schema.InfoCell, error) {
+	query := `
+			SELECT id, data_type, data_size, description, owner_id, revision, deleted_at
+			FROM info_cells
+			WHERE owner_id = $1 AND deleted_at IS NOT NULL
+			ORDER BY deleted_at DESC
+		`
+
+	rows, err := s.db.Query(context.Background(), query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var infoCells []*schema.InfoCell
+	for rows.Next() {
+		infoCell := &schema.InfoCell{}
+		err := rows.Scan(
+			&infoCell.ID,
+			&infoCell.DataType,
+			&infoCell.DataSize,
+			&infoCell.Description,
+			&infoCell.OwnerID,
+			&infoCell.Revision,
+			&infoCell.DeletedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		infoCells = append(infoCells, infoCell)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate rows: %w", err)
+	}
+
+	return infoCells, nil
+}
+
+// RestoreData восстанавливает данные с заданными InfoID из корзины.
+// Восстановленные данные получают новую ревизию и снова попадают в синхронизацию.
+func (s *StoragePG) RestoreData(infoIDs []int64) error {
+	ctx := context.Background()
+	err := s.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		for _, infoID := range infoIDs {
+			if _, err := bumpRevision(ctx, tx, infoID); err != nil {
+				return err
+			}
+
+			result, err := tx.Exec(ctx, `UPDATE info_cells SET deleted_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL`, infoID)
+			if err != nil {
+				return fmt.Errorf("failed to restore from trash: %w", err)
+			}
+			if result.RowsAffected() == 0 {
+				return ErrNotFound
+			}
+
+			_, err = tx.Exec(ctx, `DELETE FROM deleted_cells WHERE info_id = $1`, infoID)
+			if err != nil {
+				return fmt.Errorf("failed to delete deleted cell: %w", err)
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, ErrNotFound) {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to restore data: %w", err)
+	}
+
+	return nil
+}
+
+// EmptyTrash окончательно удаляет данные пользователя из корзины и возвращает количество удаленных данных.
+func (s *StoragePG) EmptyTrash(userID int64) (int64, error) {
+	result, err := s.db.Exec(
+		context.Background(),
+		`DELETE FROM info_cells WHERE owner_id = $1 AND deleted_at IS NOT NULL`,
+		userID,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to empty trash: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// PurgeTrash окончательно удаляет данные всех пользователей, перемещенные в корзину раньше before,
+// и возвращает количество удаленных данных. Ячейки памяти, файлы и версии удаляются каскадно.
+func (s *StoragePG) PurgeTrash(before time.Time) (int64, error) {
+	result, err := s.db.Exec(
+		context.Background(),
+		`DELETE FROM info_cells WHERE deleted_at < $1`,
+		before.UTC(),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge trash: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// GetFolders возвращает папки пользователя, отсортированные по имени.
+func (s *StoragePG) GetFolders(userID int64) ([]*schema.Folder, error) {
+	query := `
+			SELECT id, owner_id, COALESCE(parent_id, 0), name
+			FROM folders
+			WHERE owner_id = $1
+			ORDER BY name, id
+		`
+
+	rows, err := s.db.Query(context.Background(), query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var folders []*schema.Folder
+	for rows.Next() {
+		folder := &schema.Folder{}
+		if err := rows.Scan(&folder.ID, &folder.OwnerID, &folder.ParentID, &folder.Name); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		folders = append(folders, folder)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate rows: %w", err)
+	}
+
+	return folders, nil
+}
+
+// CreateFolder создает папку и записывает ее ID в folder.ID.
+// Если у родительской папки уже есть папка с таким именем, возвращает ErrConflict.
+func (s *StoragePG) CreateFolder(folder *schema.Folder) error {
+	query := `
+			INSERT INTO folders (owner_id, parent_id, name)
+			VALUES ($1, NULLIF($2, 0), $3)
+			RETURNING id
+		`
+
+	err := s.db.QueryRow(context.Background(), query, folder.OwnerID, folder.ParentID, folder.Name).Scan(&folder.ID)
+	if isUniqueViolation(err) {
+		return ErrConflict
+	}
+	if err != nil {
+		return fmt.Errorf("failed to execute insert query: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateFolder изменяет имя и родительскую папку папки. Вложенность папок не проверяется,
+// для перемещения папки используется MoveFolder.
+// Если папки нет, возвращает ErrNotFound, если у родительской папки уже есть папка с таким именем - ErrConflict.
+func (s *StoragePG) UpdateFolder(folder schema.Folder) error {
+	query := `
+			UPDATE folders
+			SET parent_id = NULLIF($1, 0), name = $2
+			WHERE id = $3
+		`
+
+	result, err := s.db.Exec(context.Background(), query, folder.ParentID, folder.Name, folder.ID)
+	if isUniqueViolation(err) {
+		return ErrConflict
+	}
+	if err != nil {
+		return fmt.Errorf("failed to execute update query: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// MoveFolder перемещает папку пользователя в папку parentID (0 - на верхний уровень).
+// Проверка вложенности и перемещение выполняются под блокировкой строки пользователя, поэтому параллельные
+// перемещения его папок не образуют цикл. Если папки или родительской папки нет среди папок пользователя,
+// возвращает ErrNotFound, если parentID - сама папка или вложенная в нее папка - ErrCycle,
+// если у родительской папки уже есть папка с таким именем - ErrConflict.
+func (s *StoragePG) MoveFolder(userID, folderID, parentID int64) error {
+	ctx := context.Background()
+	err := s.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, `SELECT id FROM users WHERE id = $1 FOR UPDATE`, userID)
+		if err != nil {
+			return fmt.Errorf("failed to lock owner: %w", err)
+		}
+
+		// parentID и его родительские папки; UNION останавливает обход на уже пройденной папке
+		var found, parentFound, cycle bool
+		err = tx.QueryRow(
+			ctx,
+			`WITH RECURSIVE ancestors (id, parent_id) AS (
+				SELECT id, parent_id FROM folders WHERE id = $2 AND owner_id = $3
+				UNION
+				SELECT f.id, f.parent_id FROM folders f INNER JOIN ancestors a ON f.id = a.parent_id
+			)
+			SELECT
+				EXISTS (SELECT 1 FROM folders WHERE id = $1 AND owner_id = $3),
+				EXISTS (SELECT 1 FROM ancestors WHERE id = $2),
+				EXISTS (SELECT 1 FROM ancestors WHERE id = $1)`,
+			folderID, parentID, userID,
+		).Scan(&found, &parentFound, &cycle)
+		if err != nil {
+			return fmt.Errorf("failed to check folders: %w", err)
+		}
+		if !found || (parentID != 0 && !parentFound) {
+			return ErrNotFound
+		}
+		if cycle {
+			return ErrCycle
+		}
+
+		_, err = tx.Exec(ctx, `UPDATE folders SET parent_id = NULLIF($1, 0) WHERE id = $2`, parentID, folderID)
+		if isUniqueViolation(err) {
+			return ErrConflict
+		}
+		if err != nil {
+			return fmt.Errorf("failed to execute update query: %w", err)
+		}
+		return nil
+	})
+	if errors.Is(err, ErrNotFound) || errors.Is(err, ErrCycle) || errors.Is(err, ErrConflict) {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to move folder: %w", err)
+	}
+
+	return nil
+}
+
+// MoveData перемещает данные с заданными InfoID в папку, folderID 0 убирает данные из папок.
+// Перемещенные данные получают новую ревизию, чтобы клиенты получили изменение при синхронизации.
+func (s *StoragePG) MoveData(infoIDs []int64, folderID int64) error {
+	ctx := context.Background()
+	err := s.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		for _, infoID := range infoIDs {
+			if _, err := lockRevision(ctx, tx, infoID); err != nil {
+				return err
+			}
+			if _, err := bumpRevision(ctx, tx, infoID); err != nil {
+				return err
+			}
+
+			_, err := tx.Exec(ctx, `UPDATE info_cells SET folder_id = NULLIF($1, 0) WHERE id = $2`, folderID, infoID)
+			if err != nil {
+				return fmt.Errorf("failed to move data: %w", err)
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, ErrNotFound) {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to move data: %w", err)
+	}
+
+	return nil
+}
+
+// TagData добавляет данным с заданными InfoID метки add и снимает метки remove.
+// Новые метки создаются, метки, которыми не отмечены никакие данные, удаляются.
+// Данные получают новую ревизию, чтобы клиенты получили изменение при синхронизации.
+func (s *StoragePG) TagData(infoIDs []int64, add, remove []string) error {
+	ctx := context.Background()
+	err := s.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		for _, infoID := range infoIDs {
+			if _, err := lockRevision(ctx, tx, infoID); err != nil {
+				return err
+			}
+			if _, err := bumpRevision(ctx, tx, infoID); err != nil {
+				return err
+			}
+
+			_, err := tx.Exec(
+				ctx,
+				`INSERT INTO tags (owner_id, name)
+				SELECT i.owner_id, unnest($2::text[]) FROM info_cells i WHERE i.id = $1
+				ON CONFLICT (owner_id, name) DO NOTHING`,
+				infoID,
+				add,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to create tags: %w", err)
+			}
+
+			_, err = tx.Exec(
+				ctx,
+				`INSERT INTO cell_tags (info_id, tag_id)
+				SELECT i.id, t.id FROM info_cells i
+				INNER JOIN tags t ON t.owner_id = i.owner_id
+				WHERE i.id = $1 AND t.name = ANY($2)
+				ON CONFLICT (info_id, tag_id) DO NOTHING`,
+				infoID,
+				add,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to add tags: %w", err)
+			}
+
+			_, err = tx.Exec(
+				ctx,
+				`DELETE FROM cell_tags ct USING tags t
+				WHERE ct.tag_id = t.id AND ct.info_id = $1 AND t.name = ANY($2)`,
+				infoID,
+				remove,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to remove tags: %w", err)
+			}
+		}
+
+		_, err := tx.Exec(
+			ctx,
+			`DELETE FROM tags t
+			WHERE t.owner_id IN (SELECT owner_id FROM info_cells WHERE id = ANY($1))
+				AND NOT EXISTS (SELECT 1 FROM cell_tags ct WHERE ct.tag_id = t.id)`,
+			infoIDs,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to delete unused tags: %w", err)
+		}
+		return nil
+	})
+	if errors.Is(err, ErrNotFound) {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to tag data: %w", err)
+	}
+
+	return nil
+}
+
+// GetTags возвращает метки пользователя, отсортированные по имени, с количеством отмеченных данных вне корзины.
+func (s *StoragePG) GetTags(userID int64) ([]*schema.Tag, error) {
+	query := `
+			SELECT t.name, COUNT(i.id)
+			FROM tags t
+			LEFT JOIN cell_tags ct ON ct.tag_id = t.id
+			LEFT JOIN info_cells i ON i.id = ct.info_id AND i.deleted_at IS NULL
+			WHERE t.owner_id = $1
+			GROUP BY t.name
+			ORDER BY t.name
+		`
+
+	rows, err := s.db.Query(context.Background(), query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var tags []*schema.Tag
+	for rows.Next() {
+		tag := &schema.Tag{}
+		if err := rows.Scan(&tag.Name, &tag.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate rows: %w", err)
+	}
+
+	return tags, nil
+}
+
+// isUniqueViolation - сообщает, что запрос нарушил ограничение уникальности.
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+
+// GetBlob возвращает состояние загрузки бинарных данных по InfoID или ErrNotFound.
+func (s *StoragePG) GetBlob(infoID int64) (*schema.Blob, error) {
+	query := `
+			SELECT info_id, size, total_size, sha256, complete
+			FROM blobs
+			WHERE info_id = $1
+		`
+
+	blob := &schema.Blob{}
+	err := s.db.QueryRow(context.Background(), query, infoID).Scan(
+		&blob.InfoID,
+		&blob.Size,
+		&blob.TotalSize,
+		&blob.SHA256,
+		&blob.Complete,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan row: %w", err)
+	}
+
+	return blob, nil
+}
+
+// CreateBlob начинает новую загрузку бинарных данных. Ранее загруженные данные для InfoID удаляются.
+func (s *StoragePG) CreateBlob(blob schema.Blob) error {
+	ctx := context.Background()
+	err := s.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if _, err := bumpRevision(ctx, tx, blob.InfoID); err != nil {
+			return err
+		}
+
+		_, err := tx.Exec(ctx, `DELETE FROM blobs WHERE info_id = $1`, blob.InfoID)
+		if err != nil {
+			return fmt.Errorf("failed to delete previous blob: %w", err)
+		}
+
+		_, err = tx.Exec(
+			ctx,
+			`INSERT INTO blobs (info_id, total_size, sha256) VALUES ($1, $2, $3)`,
+			blob.InfoID,
+			blob.TotalSize,
+			blob.SHA256,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert blob: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create blob: %w", err)
+	}
+
+	return nil
+}
+
+// AppendBlobChunk дописывает часть бинарных данных, начинающуюся с offset.
+// Если offset не совпадает с уже загруженным размером, возвращается ErrConflict.
+func (s *StoragePG) AppendBlobChunk(infoID, offset int64, data []byte) error {
+	ctx := context.Background()
+	err := s.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		result, err := tx.Exec(
+			ctx,
+			`UPDATE blobs SET size = size + $1 WHERE info_id = $2 AND size = $3 AND NOT complete`,
+			len(data),
+			infoID,
+			offset,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update blob size: %w", err)
+		}
+		if result.RowsAffected() == 0 {
+			return ErrConflict
+		}
+
+		_, err = tx.Exec(
+			ctx,
+			`INSERT INTO blob_chunks (info_id, chunk_offset, data) VALUES ($1, $2, $3)`,
+			infoID,
+			offset,
+			data,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert blob chunk: %w", err)
+		}
+		return nil
+	})
+	if errors.Is(err, ErrConflict) {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to append blob chunk: %w", err)
+	}
+
+	return nil
+}
+
+// CompleteBlob отмечает загрузку завершенной и записывает размер данных в информационную ячейку.
+func (s *StoragePG) CompleteBlob(infoID int64) error {
+	ctx := context.Background()
+	err := s.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if _, err := bumpRevision(ctx, tx, infoID); err != nil {
+			return err
+		}
+
+		var totalSize int64
+		err := tx.QueryRow(
+			ctx,
+			`UPDATE blobs SET complete = TRUE WHERE info_id = $1 AND size = total_size RETURNING total_size`,
+			infoID,
+		).Scan(&totalSize)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrConflict
+		}
+		if err != nil {
+			return fmt.Errorf("failed to complete blob: %w", err)
+		}
+
+		_, err = tx.Exec(ctx, `UPDATE info_cells SET data_size = $1 WHERE id = $2`, totalSize, infoID)
+		if err != nil {
+			return fmt.Errorf("failed to update data size: %w", err)
+		}
+		return nil
+	})
+	if errors.Is(err, ErrConflict) {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to complete blob: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteBlob удаляет бинарные данные, загруженные для InfoID.
+func (s *StoragePG) DeleteBlob(infoID int64) error {
+	ctx := context.Background()
+	err := s.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if _, err := bumpRevision(ctx, tx, infoID); err != nil {
+			return err
+		}
+
+		_, err := tx.Exec(ctx, `DELETE FROM blobs WHERE info_id = $1`, infoID)
+		if err != nil {
+			return fmt.Errorf("failed to execute delete query: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete blob: %w", err)
+	}
+
+	return nil
+}
+
+// ReadBlob последовательно передает в fn загруженные части бинарных данных начиная с offset.
+func (s *StoragePG) ReadBlob(infoID, offset int64, fn func(offset int64, data []byte) error) error {
+	query := `
+			SELECT chunk_offset, data
+			FROM blob_chunks
+			WHERE info_id = $1 AND chunk_offset + length(data) > $2
+			ORDER BY chunk_offset
+		`
+
+	rows, err := s.db.Query(context.Background(), query, infoID, offset)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var chunkOffset int64
+		var data []byte
+		if err := rows.Scan(&chunkOffset, &data); err != nil {
+			return fmt.Errorf("failed to scan row: %w", err)
+		}
+		// первая часть может начинаться раньше запрошенного смещения
+		if chunkOffset < offset {
+			data = data[offset-chunkOffset:]
+			chunkOffset = offset
+		}
+		if err := fn(chunkOffset, data); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to iterate rows: %w", err)
+	}
+
+	return nil
+}
+
+// GetChangesSince возвращает данные пользователя, измененные после указанной ревизии,
+// InfoID удаленных после нее данных и текущую ревизию пользователя.
+// Все значения читаются из одного снимка базы данных.
+func (s *StoragePG) GetChangesSince(userID, revision int64) (*schema.Changes, error) {
+	ctx := context.Background()
+	changes := &schema.Changes{}
+	txOptions := pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly}
+	err := s.db.BeginTxFunc(ctx, txOptions, func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx, `SELECT revision FROM users WHERE id = $1`, userID).Scan(&changes.Revision)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to retrieve revision: %w", err)
+		}
+
+		rows, err := tx.Query(
+			ctx,
+			selectMemoryCellsQuery+` WHERE i.owner_id = $1 AND i.revision > $2 AND i.deleted_at IS NULL ORDER BY i.revision`,
+			userID,
+			revision,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to execute query: %w", err)
+		}
+		changes.Changed, err = scanMemoryCells(rows, 0)
+		if err != nil {
+			return err
+		}
+
+		rows, err = tx.Query(
+			ctx,
+			`SELECT info_id FROM deleted_cells WHERE owner_id = $1 AND revision > $2 ORDER BY revision`,
+			userID,
+			revision,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to execute query: %w", err)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var infoID int64
+			if err := rows.Scan(&infoID); err != nil {
+				return fmt.Errorf("failed to scan row: %w", err)
+			}
+			changes.DeletedIDs = append(changes.DeletedIDs, infoID)
+		}
+		return rows.Err()
+	})
+	if errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve changes: %w", err)
+	}
+
+	return changes, nil
+}
+
+// bumpRevision - увеличивает ревизию владельца данных и назначает ее информационной ячейке и ячейке памяти,
+// время изменения данных обновляется.
+// Строка пользователя остается заблокированной до конца транзакции, поэтому ревизии одного пользователя
+// фиксируются в порядке возрастания. Чтобы избежать взаимных блокировок, транзакции изменения данных
+// вызывают ее (или lockRevision) до изменения остальных таблиц. Если данных нет, возвращает ErrNotFound.
+func bumpRevision(ctx context.Context, tx pgx.Tx, infoID int64) (int64, error) {
+	var revision int64
+	err := tx.QueryRow(
+		ctx,
+		`UPDATE users SET revision = revision + 1
+		WHERE id = (SELECT owner_id FROM info_cells WHERE id = $1)
+		RETURNING revision`,
+		infoID,
+	).Scan(&revision)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, ErrNotFound
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to bump revision: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE info_cells SET revision = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2`, revision, infoID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update info cell revision: %w", err)
+	}
+	_, err = tx.Exec(ctx, `UPDATE memory_cells SET revision = $1 WHERE info_id = $2`, revision, infoID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update memory cell revision: %w", err)
+	}
+
+	return revision, nil
+}
+
+// saveVersion - сохраняет текущую версию данных в истории версий перед их изменением.
+// Файлы, загруженные потоком, в историю не входят.
+func saveVersion(ctx context.Context, tx pgx.Tx, infoID int64) error {
+	_, err := tx.Exec(
+		ctx,
+		`INSERT INTO cell_versions (info_id, revision, data_type, data_size, description,
+			encrypted, key_value_pairs, binary_data, file_name, payload)
+		SELECT i.id, i.revision, i.data_type, i.data_size, i.description,
+			m.encrypted, m.key_value_pairs, m.binary_data, m.file_name, m.payload
+		FROM info_cells i
+		INNER JOIN memory_cells m ON m.info_id = i.id
+		WHERE i.id = $1
+		ON CONFLICT (info_id, revision) DO NOTHING`,
+		infoID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save version: %w", err)
+	}
+	return nil
+}
+
+// lockRevision - блокирует строку владельца данных до конца транзакции и возвращает текущую ревизию данных.
+// Для данных в корзине возвращает ErrNotFound.
+// Ревизия читается после получения блокировки, поэтому параллельные изменения уже учтены.
+func lockRevision(ctx context.Context, tx pgx.Tx, infoID int64) (int64, error) {
+	_, err := tx.Exec(
+		ctx,
+		`SELECT id FROM users WHERE id = (SELECT owner_id FROM info_cells WHERE id = $1) FOR UPDATE`,
+		infoID,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to lock owner: %w", err)
+	}
+
+	var revision int64
+	err = tx.QueryRow(ctx, `SELECT revision FROM info_cells WHERE id = $1 AND deleted_at IS NULL`, infoID).Scan(&revision)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, ErrNotFound
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to retrieve revision: %w", err)
+	}
+
+	return revision, nil
+}
+
+// selectVersionsQuery - запрос версий данных, условие добавляется к запросу.
+const selectVersionsQuery = `
+			SELECT v.info_id, v.revision, v.replaced_at, v.data_type, v.data_size, v.description,
+				v.encrypted, v.key_value_pairs, v.binary_data, v.file_name, v.payload, i.owner_id
+			FROM cell_versions v
+			INNER JOIN info_cells i ON i.id = v.info_id
+		`
+
+// GetVersions возвращает предыдущие версии данных, начиная с последней.
+func (s *StoragePG) GetVersions(infoID int64) ([]*schema.Version, error) {
+	rows, err := s.db.Query(
+		context.Background(),
+		selectVersionsQuery+` WHERE v.info_id = $1 ORDER BY v.revision DESC`,
+		infoID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var versions []*schema.Version
+	for rows.Next() {
+		version, err := scanVersion(rows)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate rows: %w", err)
+	}
+
+	return versions, nil
+}
+
+// GetVersion возвращает версию данных с указанной ревизией. Если версии нет, возвращает ErrNotFound.
+func (s *StoragePG) GetVersion(infoID, revision int64) (*schema.Version, error) {
+	row := s.db.QueryRow(
+		context.Background(),
+		selectVersionsQuery+` WHERE v.info_id = $1 AND v.revision = $2`,
+		infoID,
+		revision,
+	)
+
+	version, err := scanVersion(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return version, nil
+}
+
+// scanVersion - считывает строку запроса selectVersionsQuery.
+func scanVersion(row pgx.Row) (*schema.Version, error) {
+	version := &schema.Version{}
+	memoryCell := &schema.MemoryCell{}
+	infoCell := &schema.InfoCell{}
+
+	err := row.Scan(
+		&infoCell.ID,
+		&version.Revision,
+		&version.ReplacedAt,
+		&infoCell.DataType,
+		&infoCell.DataSize,
+		&infoCell.Description,
+		&memoryCell.Encrypted,
+		&memoryCell.KeyValuePairs,
+		&memoryCell.BinaryData,
+		&memoryCell.FileName,
+		&memoryCell.Payload,
+		&infoCell.OwnerID,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan version: %w", err)
+	}
+
+	infoCell.Revision = version.Revision
+	memoryCell.InfoID = infoCell.ID
+	memoryCell.InfoCell = infoCell
+	version.Cell = memoryCell
+	return version, nil
+}
+
 // Ping проверяет доступность соединения с базой данных.
 func (s *StoragePG) Ping() error {
 	conn, err := s.db.Acquire(context.Background())
@@ -0,0 +1,174 @@
+package keeper_test
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bubu256/gophkeeper_pet/config"
+	"github.com/bubu256/gophkeeper_pet/internal/schema"
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestStorage - подключается к базе данных из TEST_DATABASE_DSN с примененными миграциями,
+// устанавливая часовой пояс сессии timeZone. Без TEST_DATABASE_DSN тест пропускается.
+func newTestStorage(t *testing.T, timeZone string) *keeper.StoragePG {
+	t.Helper()
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_DSN is not set")
+	}
+
+	if strings.Contains(dsn, "://") {
+		u, err := url.Parse(dsn)
+		require.NoError(t, err)
+		query := u.Query()
+		query.Set("timezone", timeZone)
+		u.RawQuery = query.Encode()
+		dsn = u.String()
+	} else {
+		dsn += " timezone=" + timeZone
+	}
+
+	s, err := keeper.New(config.ServerConfig{DatabaseDSN: dsn})
+	require.NoError(t, err)
+	return s
+}
+
+// newTestUser - создает пользователя, данные которого удаляются после теста.
+func newTestUser(t *testing.T, s *keeper.StoragePG) *schema.User {
+	t.Helper()
+	user := &schema.User{Username: fmt.Sprintf("keeper-test-%d", time.Now().UnixNano()), Password: "hash"}
+	require.NoError(t, s.CreateUser(user))
+	t.Cleanup(func() {
+		infoCells, err := s.GetUserDataInfo(user.ID)
+		require.NoError(t, err)
+		var infoIDs []int64
+		for _, infoCell := range infoCells {
+			infoIDs = append(infoIDs, infoCell.ID)
+		}
+		if len(infoIDs) > 0 {
+			_, err = s.DeleteData(infoIDs)
+			require.NoError(t, err)
+		}
+		_, err = s.EmptyTrash(user.ID)
+		require.NoError(t, err)
+	})
+	return user
+}
+
+func TestQueryUserDataInfo_TimeZone(t *testing.T) {
+	// пояс сессии базы данных не совпадает с UTC
+	s := newTestStorage(t, "Asia/Yekaterinburg")
+	user := newTestUser(t, s)
+
+	start := time.Now()
+	var infoIDs []int64
+	for _, description := range []string{"first", "second"} {
+		infoID, err := s.AddData(
+			schema.InfoCell{DataType: "note", Description: description, OwnerID: user.ID},
+			&schema.MemoryCell{Payload: &schema.Payload{Note: &schema.NotePayload{Text: description}}},
+		)
+		require.NoError(t, err)
+		infoIDs = append(infoIDs, infoID)
+	}
+	end := time.Now()
+
+	// время данных возвращается без смещения на разницу поясов
+	infoCells, err := s.QueryUserDataInfo(user.ID, schema.InfoQuery{Sort: schema.SortByCreated})
+	require.NoError(t, err)
+	require.Len(t, infoCells, 2)
+	for _, infoCell := range infoCells {
+		assert.WithinDuration(t, start, infoCell.CreatedAt, time.Minute)
+		assert.WithinDuration(t, start, infoCell.UpdatedAt, time.Minute)
+	}
+
+	// отбор по времени, переданному в UTC
+	filter := schema.InfoFilter{
+		CreatedAfter:  start.Add(-time.Second),
+		CreatedBefore: end.Add(time.Second),
+		UpdatedAfter:  start.Add(-time.Second),
+		UpdatedBefore: end.Add(time.Second),
+	}
+	infoCells, err = s.QueryUserDataInfo(user.ID, schema.InfoQuery{Filter: filter})
+	require.NoError(t, err)
+	assert.Len(t, infoCells, 2)
+
+	infoCells, err = s.QueryUserDataInfo(user.ID, schema.InfoQuery{Filter: schema.InfoFilter{CreatedAfter: end.Add(time.Second)}})
+	require.NoError(t, err)
+	assert.Empty(t, infoCells)
+
+	// постраничное получение в порядке времени создания
+	first, err := s.QueryUserDataInfo(user.ID, schema.InfoQuery{Sort: schema.SortByCreated, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, first, 1)
+	assert.Equal(t, infoIDs[0], first[0].ID)
+
+	after := &schema.InfoCursor{Time: first[0].CreatedAt, ID: first[0].ID}
+	second, err := s.QueryUserDataInfo(user.ID, schema.InfoQuery{Sort: schema.SortByCreated, Limit: 1, After: after})
+	require.NoError(t, err)
+	require.Len(t, second, 1)
+	assert.Equal(t, infoIDs[1], second[0].ID)
+}
+
+func TestGetVersions_TimeZone(t *testing.T) {
+	s := newTestStorage(t, "America/New_York")
+	user := newTestUser(t, s)
+
+	infoCell := schema.InfoCell{DataType: "note", Description: "note", OwnerID: user.ID}
+	infoID, err := s.AddData(infoCell, &schema.MemoryCell{Payload: &schema.Payload{Note: &schema.NotePayload{Text: "first"}}})
+	require.NoError(t, err)
+
+	start := time.Now()
+	infoCell.ID = infoID
+	memoryCell := schema.MemoryCell{InfoID: infoID, Payload: &schema.Payload{Note: &schema.NotePayload{Text: "second"}}}
+	_, err = s.UpdateData(infoCell, memoryCell, 0)
+	require.NoError(t, err)
+
+	versions, err := s.GetVersions(infoID)
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	assert.WithinDuration(t, start, versions[0].ReplacedAt, time.Minute)
+}
+
+func TestMoveFolder_ConcurrentCycle(t *testing.T) {
+	s := newTestStorage(t, "UTC")
+	user := newTestUser(t, s)
+
+	for i := 0; i < 10; i++ {
+		a := &schema.Folder{OwnerID: user.ID, Name: fmt.Sprintf("a%d", i)}
+		require.NoError(t, s.CreateFolder(a))
+		b := &schema.Folder{OwnerID: user.ID, Name: fmt.Sprintf("b%d", i)}
+		require.NoError(t, s.CreateFolder(b))
+
+		// встречные перемещения: выполняется только одно, второе обнаруживает цикл
+		var wg sync.WaitGroup
+		errs := make([]error, 2)
+		for j, move := range [][2]int64{{a.ID, b.ID}, {b.ID, a.ID}} {
+			wg.Add(1)
+			go func(j int, folderID, parentID int64) {
+				defer wg.Done()
+				errs[j] = s.MoveFolder(user.ID, folderID, parentID)
+			}(j, move[0], move[1])
+		}
+		wg.Wait()
+
+		if errs[0] == nil {
+			assert.ErrorIs(t, errs[1], keeper.ErrCycle)
+		} else {
+			assert.ErrorIs(t, errs[0], keeper.ErrCycle)
+			assert.NoError(t, errs[1])
+		}
+	}
+
+	folder := &schema.Folder{OwnerID: user.ID, Name: "folder"}
+	require.NoError(t, s.CreateFolder(folder))
+	assert.ErrorIs(t, s.MoveFolder(user.ID, folder.ID, folder.ID), keeper.ErrCycle)
+	assert.ErrorIs(t, s.MoveFolder(user.ID+1, folder.ID, 0), keeper.ErrNotFound)
+}
@@ -0,0 +1,243 @@
+// Package otp - одноразовые коды двухфакторной аутентификации: генерация кодов HOTP (RFC 4226) и TOTP (RFC 6238),
+// разбор ссылок otpauth:// и пакетов otpauth-migration:// из экспорта Google Authenticator.
+// Используется и сервером для проверки данных, и клиентом для генерации кодов.
+package otp
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Типы одноразовых кодов.
+const (
+	// TOTP - код зависит от времени и меняется каждые Period секунд.
+	TOTP = "totp"
+	// HOTP - код зависит от счетчика, который увеличивается после каждого кода.
+	HOTP = "hotp"
+)
+
+// Алгоритмы HMAC.
+const (
+	SHA1   = "SHA1"
+	SHA256 = "SHA256"
+	SHA512 = "SHA512"
+)
+
+// Параметры по умолчанию, если они не указаны.
+const (
+	DefaultDigits = 6
+	DefaultPeriod = 30
+	// maxPeriod - наибольший шаг TOTP в секундах.
+	maxPeriod = 24 * 60 * 60
+)
+
+// Ошибки проверки параметров.
+var (
+	ErrType      = errors.New("invalid otp type")
+	ErrSecret    = errors.New("invalid otp secret")
+	ErrAlgorithm = errors.New("invalid otp algorithm")
+	ErrDigits    = errors.New("invalid otp digits")
+	ErrPeriod    = errors.New("invalid otp period")
+	ErrCounter   = errors.New("invalid otp counter")
+)
+
+// secretEncoding - кодировка секретного ключа: base32 без дополнения.
+var secretEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Key - параметры генерации одноразовых кодов.
+type Key struct {
+	Type    string
+	Issuer  string
+	Account string
+	// Secret - секретный ключ в кодировке base32 в верхнем регистре, без пробелов и дополнения.
+	Secret    string
+	Algorithm string
+	Digits    int
+	// Period - шаг TOTP в секундах.
+	Period int
+	// Counter - счетчик HOTP: значение для следующего кода.
+	Counter uint64
+}
+
+// ParseType - проверяет тип кодов без учета регистра. Пустой тип - TOTP.
+func ParseType(s string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", TOTP:
+		return TOTP, nil
+	case HOTP:
+		return HOTP, nil
+	}
+	return "", ErrType
+}
+
+// NormalizeSecret - удаляет из ключа пробелы, дефисы и дополнение, переводит его в верхний регистр и проверяет кодировку base32.
+func NormalizeSecret(s string) (string, error) {
+	s = strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' || r == '=' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(s))
+	if s == "" {
+		return "", ErrSecret
+	}
+	if _, err := secretEncoding.DecodeString(s); err != nil {
+		return "", ErrSecret
+	}
+	return s, nil
+}
+
+// ParseAlgorithm - проверяет алгоритм HMAC без учета регистра и дефиса ("sha-256"). Пустой алгоритм - SHA1.
+func ParseAlgorithm(s string) (string, error) {
+	switch strings.ReplaceAll(strings.ToUpper(strings.TrimSpace(s)), "-", "") {
+	case "", SHA1:
+		return SHA1, nil
+	case SHA256:
+		return SHA256, nil
+	case SHA512:
+		return SHA512, nil
+	}
+	return "", ErrAlgorithm
+}
+
+// ParseDigits - проверяет количество цифр кода: 6 или 8. Пустое значение - DefaultDigits.
+func ParseDigits(s string) (int, error) {
+	if s = strings.TrimSpace(s); s == "" {
+		return DefaultDigits, nil
+	}
+	digits, err := strconv.Atoi(s)
+	if err != nil || digits != 6 && digits != 8 {
+		return 0, ErrDigits
+	}
+	return digits, nil
+}
+
+// ParsePeriod - проверяет шаг TOTP в секундах. Пустое значение - DefaultPeriod.
+func ParsePeriod(s string) (int, error) {
+	if s = strings.TrimSpace(s); s == "" {
+		return DefaultPeriod, nil
+	}
+	period, err := strconv.Atoi(s)
+	if err != nil || period <= 0 || period > maxPeriod {
+		return 0, ErrPeriod
+	}
+	return period, nil
+}
+
+// ParseCounter - проверяет счетчик HOTP. Пустое значение - 0.
+func ParseCounter(s string) (uint64, error) {
+	if s = strings.TrimSpace(s); s == "" {
+		return 0, nil
+	}
+	counter, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, ErrCounter
+	}
+	return counter, nil
+}
+
+// Normalize - проверяет параметры, приводит их к единому виду и заполняет незаданные значениями по умолчанию.
+func (k Key) Normalize() (Key, error) {
+	var err error
+	if k.Type, err = ParseType(k.Type); err != nil {
+		return Key{}, err
+	}
+	if k.Secret, err = NormalizeSecret(k.Secret); err != nil {
+		return Key{}, err
+	}
+	if k.Algorithm, err = ParseAlgorithm(k.Algorithm); err != nil {
+		return Key{}, err
+	}
+	if k.Digits == 0 {
+		k.Digits = DefaultDigits
+	}
+	if k.Digits != 6 && k.Digits != 8 {
+		return Key{}, ErrDigits
+	}
+	if k.Period == 0 {
+		k.Period = DefaultPeriod
+	}
+	if k.Period < 0 || k.Period > maxPeriod {
+		return Key{}, ErrPeriod
+	}
+	k.Issuer, k.Account = strings.TrimSpace(k.Issuer), strings.TrimSpace(k.Account)
+	return k, nil
+}
+
+// HOTPCode - возвращает код для значения счетчика counter (RFC 4226).
+func HOTPCode(k Key, counter uint64) (string, error) {
+	k, err := k.Normalize()
+	if err != nil {
+		return "", err
+	}
+	secret, err := secretEncoding.DecodeString(k.Secret)
+	if err != nil {
+		return "", ErrSecret
+	}
+
+	mac := hmac.New(hashFunc(k.Algorithm), secret)
+	var message [8]byte
+	binary.BigEndian.PutUint64(message[:], counter)
+	mac.Write(message[:])
+	sum := mac.Sum(nil)
+
+	// динамическое усечение: 31 бит начиная со смещения из младших 4 бит последнего байта
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	modulo := uint32(1)
+	for i := 0; i < k.Digits; i++ {
+		modulo *= 10
+	}
+	return fmt.Sprintf("%0*d", k.Digits, value%modulo), nil
+}
+
+// TOTPCode - возвращает код для момента now (RFC 6238).
+func TOTPCode(k Key, now time.Time) (string, error) {
+	k, err := k.Normalize()
+	if err != nil {
+		return "", err
+	}
+	return HOTPCode(k, uint64(now.Unix())/uint64(k.Period))
+}
+
+// Code - возвращает текущий код: для TOTP - на момент now, для HOTP - для значения счетчика Counter.
+func Code(k Key, now time.Time) (string, error) {
+	typ, err := ParseType(k.Type)
+	if err != nil {
+		return "", err
+	}
+	if typ == HOTP {
+		return HOTPCode(k, k.Counter)
+	}
+	return TOTPCode(k, now)
+}
+
+// Remaining - возвращает, сколько еще действует код TOTP, полученный в момент now.
+func Remaining(k Key, now time.Time) time.Duration {
+	period := time.Duration(k.Period) * time.Second
+	if period <= 0 {
+		period = DefaultPeriod * time.Second
+	}
+	return period - time.Duration(now.UnixNano())%period
+}
+
+// hashFunc - возвращает хеш-функцию алгоритма HMAC.
+func hashFunc(algorithm string) func() hash.Hash {
+	switch algorithm {
+	case SHA256:
+		return sha256.New
+	case SHA512:
+		return sha512.New
+	}
+	return sha1.New
+}
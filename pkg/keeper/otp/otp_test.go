@@ -0,0 +1,190 @@
+package otp_test
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/bubu256/gophkeeper_pet/pkg/keeper/otp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// secret - возвращает ключ в кодировке base32 из тестовых векторов RFC.
+func secret(s string) string {
+	return base32.StdEncoding.EncodeToString([]byte(s))
+}
+
+func TestHOTPCode(t *testing.T) {
+	// тестовые векторы RFC 4226, приложение D
+	key := otp.Key{Type: otp.HOTP, Secret: secret("12345678901234567890")}
+	want := []string{"755224", "287082", "359152", "969429", "338314", "254676", "287922", "162583", "399871", "520489"}
+	for counter, code := range want {
+		got, err := otp.HOTPCode(key, uint64(counter))
+		require.NoError(t, err)
+		assert.Equal(t, code, got, counter)
+	}
+}
+
+func TestTOTPCode(t *testing.T) {
+	// тестовые векторы RFC 6238, приложение B
+	keys := map[string]otp.Key{
+		otp.SHA1:   {Secret: secret("12345678901234567890"), Algorithm: otp.SHA1, Digits: 8},
+		otp.SHA256: {Secret: secret("12345678901234567890123456789012"), Algorithm: otp.SHA256, Digits: 8},
+		otp.SHA512: {Secret: secret("1234567890123456789012345678901234567890123456789012345678901234"), Algorithm: otp.SHA512, Digits: 8},
+	}
+	tests := []struct {
+		unix  int64
+		codes map[string]string
+	}{
+		{59, map[string]string{otp.SHA1: "94287082", otp.SHA256: "46119246", otp.SHA512: "90693936"}},
+		{1111111109, map[string]string{otp.SHA1: "07081804", otp.SHA256: "68084774", otp.SHA512: "25091201"}},
+		{1234567890, map[string]string{otp.SHA1: "89005924", otp.SHA256: "91819424", otp.SHA512: "93441116"}},
+		{2000000000, map[string]string{otp.SHA1: "69279037", otp.SHA256: "90698825", otp.SHA512: "38618901"}},
+	}
+	for _, tt := range tests {
+		for algorithm, code := range tt.codes {
+			got, err := otp.Code(keys[algorithm], time.Unix(tt.unix, 0))
+			require.NoError(t, err)
+			assert.Equal(t, code, got, "%s at %d", algorithm, tt.unix)
+		}
+	}
+
+	// шесть цифр - младшие цифры восьмизначного кода
+	key := keys[otp.SHA1]
+	key.Digits = 6
+	got, err := otp.Code(key, time.Unix(59, 0))
+	require.NoError(t, err)
+	assert.Equal(t, "287082", got)
+}
+
+func TestRemaining(t *testing.T) {
+	key := otp.Key{Period: 30}
+	assert.Equal(t, 30*time.Second, otp.Remaining(key, time.Unix(60, 0)))
+	assert.Equal(t, 13*time.Second, otp.Remaining(key, time.Unix(77, 0)))
+	assert.Equal(t, 500*time.Millisecond, otp.Remaining(key, time.Unix(89, 500_000_000)))
+}
+
+func TestKey_Normalize(t *testing.T) {
+	key, err := otp.Key{Type: "TOTP", Secret: "jbsw y3dp-ehpk 3pxp==", Algorithm: "sha-256", Issuer: " Example "}.Normalize()
+	require.NoError(t, err)
+	assert.Equal(t, otp.Key{Type: otp.TOTP, Issuer: "Example", Secret: "JBSWY3DPEHPK3PXP", Algorithm: otp.SHA256, Digits: 6, Period: 30}, key)
+
+	invalid := []struct {
+		key otp.Key
+		err error
+	}{
+		{otp.Key{Type: "sms", Secret: "JBSWY3DPEHPK3PXP"}, otp.ErrType},
+		{otp.Key{Secret: ""}, otp.ErrSecret},
+		{otp.Key{Secret: "not base32!"}, otp.ErrSecret},
+		{otp.Key{Secret: "JBSWY3DPEHPK3PXP", Algorithm: "MD5"}, otp.ErrAlgorithm},
+		{otp.Key{Secret: "JBSWY3DPEHPK3PXP", Digits: 7}, otp.ErrDigits},
+		{otp.Key{Secret: "JBSWY3DPEHPK3PXP", Period: -1}, otp.ErrPeriod},
+	}
+	for _, tt := range invalid {
+		_, err := tt.key.Normalize()
+		assert.ErrorIs(t, err, tt.err)
+	}
+}
+
+func TestParseURI(t *testing.T) {
+	key, err := otp.ParseURI("otpauth://totp/ACME%20Co:john.doe@email.com?secret=HXDMVJECJJWSRB3HWIZR4IFUGFTMXBOZ&issuer=ACME%20Co&algorithm=SHA512&digits=8&period=60")
+	require.NoError(t, err)
+	assert.Equal(t, otp.Key{
+		Type: otp.TOTP, Issuer: "ACME Co", Account: "john.doe@email.com", Secret: "HXDMVJECJJWSRB3HWIZR4IFUGFTMXBOZ",
+		Algorithm: otp.SHA512, Digits: 8, Period: 60,
+	}, key)
+
+	// ссылка, собранная из ключа, разбирается в тот же ключ
+	again, err := otp.ParseURI(key.URI())
+	require.NoError(t, err)
+	assert.Equal(t, key, again)
+
+	key, err = otp.ParseURI("otpauth://hotp/alice?secret=JBSWY3DPEHPK3PXP&counter=42")
+	require.NoError(t, err)
+	assert.Equal(t, otp.Key{Type: otp.HOTP, Account: "alice", Secret: "JBSWY3DPEHPK3PXP", Algorithm: otp.SHA1, Digits: 6, Period: 30, Counter: 42}, key)
+	again, err = otp.ParseURI(key.URI())
+	require.NoError(t, err)
+	assert.Equal(t, key, again)
+
+	for _, raw := range []string{
+		"https://example.com/?secret=JBSWY3DPEHPK3PXP",
+		"otpauth://totp/alice",
+		"otpauth://totp/alice?secret=JBSWY3DPEHPK3PXP&digits=7",
+		"otpauth://sms/alice?secret=JBSWY3DPEHPK3PXP",
+	} {
+		_, err := otp.ParseURI(raw)
+		assert.Error(t, err, raw)
+	}
+}
+
+// migrationURI - собирает ссылку экспорта Google Authenticator из сообщений OtpParameters.
+func migrationURI(parameters ...[]byte) string {
+	var payload []byte
+	for _, p := range parameters {
+		payload = protowire.AppendTag(payload, 1, protowire.BytesType)
+		payload = protowire.AppendBytes(payload, p)
+	}
+	// version, batch_size, batch_index
+	for number, value := range map[protowire.Number]uint64{2: 1, 3: 1, 4: 0} {
+		payload = protowire.AppendTag(payload, number, protowire.VarintType)
+		payload = protowire.AppendVarint(payload, value)
+	}
+	return "otpauth-migration://offline?data=" + url.QueryEscape(base64.StdEncoding.EncodeToString(payload))
+}
+
+// migrationParameters - собирает сообщение OtpParameters.
+func migrationParameters(secret []byte, name, issuer string, algorithm, digits, typ, counter uint64) []byte {
+	var p []byte
+	p = protowire.AppendTag(p, 1, protowire.BytesType)
+	p = protowire.AppendBytes(p, secret)
+	p = protowire.AppendTag(p, 2, protowire.BytesType)
+	p = protowire.AppendString(p, name)
+	if issuer != "" {
+		p = protowire.AppendTag(p, 3, protowire.BytesType)
+		p = protowire.AppendString(p, issuer)
+	}
+	for number, value := range map[protowire.Number]uint64{4: algorithm, 5: digits, 6: typ, 7: counter} {
+		p = protowire.AppendTag(p, number, protowire.VarintType)
+		p = protowire.AppendVarint(p, value)
+	}
+	return p
+}
+
+func TestParse_Migration(t *testing.T) {
+	raw := migrationURI(
+		migrationParameters([]byte("12345678901234567890"), "GitHub:alice", "", 1, 1, 2, 0),
+		migrationParameters([]byte("hello!"), "bob@example.com", "Example", 3, 2, 1, 7),
+	)
+
+	keys, err := otp.Parse(raw)
+	require.NoError(t, err)
+	assert.Equal(t, []otp.Key{
+		{Type: otp.TOTP, Issuer: "GitHub", Account: "alice", Secret: "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ", Algorithm: otp.SHA1, Digits: 6, Period: 30},
+		{Type: otp.HOTP, Issuer: "Example", Account: "bob@example.com", Secret: "NBSWY3DPEE", Algorithm: otp.SHA512, Digits: 8, Period: 30, Counter: 7},
+	}, keys)
+
+	code, err := otp.Code(keys[0], time.Unix(59, 0))
+	require.NoError(t, err)
+	assert.Equal(t, "287082", code)
+
+	// неэкранированный base64 со знаками "+" тоже разбирается
+	unescaped, err := url.QueryUnescape(raw)
+	require.NoError(t, err)
+	again, err := otp.Parse(unescaped)
+	require.NoError(t, err)
+	assert.Equal(t, keys, again)
+
+	for _, raw := range []string{
+		"otpauth-migration://offline?data=",
+		"otpauth-migration://offline?data=%%%",
+		migrationURI(migrationParameters([]byte("x"), "md5", "", 4, 1, 2, 0)),
+		"mailto:alice@example.com",
+	} {
+		_, err := otp.Parse(raw)
+		assert.Error(t, err, raw)
+	}
+}
@@ -0,0 +1,248 @@
+package otp
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Схемы ссылок с параметрами одноразовых кодов.
+const (
+	schemeOTPAuth   = "otpauth"
+	schemeMigration = "otpauth-migration"
+)
+
+// ErrURI возвращается, если ссылка не является ссылкой otpauth:// или otpauth-migration://.
+var ErrURI = errors.New("invalid otpauth uri")
+
+// Parse - разбирает ссылку otpauth:// (один ключ) или otpauth-migration:// (пакет ключей из экспорта Google Authenticator).
+func Parse(raw string) ([]Key, error) {
+	raw = strings.TrimSpace(raw)
+	scheme, _, _ := strings.Cut(raw, ":")
+	switch strings.ToLower(scheme) {
+	case schemeOTPAuth:
+		key, err := ParseURI(raw)
+		if err != nil {
+			return nil, err
+		}
+		return []Key{key}, nil
+	case schemeMigration:
+		return ParseMigration(raw)
+	}
+	return nil, ErrURI
+}
+
+// ParseURI - разбирает ссылку вида otpauth://totp/Issuer:account?secret=...&issuer=...&algorithm=...&digits=...&period=...
+// (формат Key Uri Format). Сервис из параметра issuer важнее сервиса из метки.
+func ParseURI(raw string) (Key, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || !strings.EqualFold(u.Scheme, schemeOTPAuth) {
+		return Key{}, ErrURI
+	}
+	query := u.Query()
+	key := Key{
+		Type:      u.Host,
+		Secret:    query.Get("secret"),
+		Algorithm: query.Get("algorithm"),
+	}
+
+	label := strings.TrimPrefix(u.Path, "/")
+	if issuer, account, ok := strings.Cut(label, ":"); ok {
+		key.Issuer, key.Account = issuer, account
+	} else {
+		key.Account = label
+	}
+	if issuer := query.Get("issuer"); issuer != "" {
+		key.Issuer = issuer
+	}
+
+	if key.Digits, err = ParseDigits(query.Get("digits")); err != nil {
+		return Key{}, err
+	}
+	if key.Period, err = ParsePeriod(query.Get("period")); err != nil {
+		return Key{}, err
+	}
+	if key.Counter, err = ParseCounter(query.Get("counter")); err != nil {
+		return Key{}, err
+	}
+	return key.Normalize()
+}
+
+// URI - возвращает ссылку otpauth:// с параметрами ключа.
+func (k Key) URI() string {
+	label := k.Account
+	if k.Issuer != "" {
+		label = k.Issuer + ":" + k.Account
+	}
+	query := url.Values{}
+	query.Set("secret", k.Secret)
+	if k.Issuer != "" {
+		query.Set("issuer", k.Issuer)
+	}
+	query.Set("algorithm", k.Algorithm)
+	query.Set("digits", strconv.Itoa(k.Digits))
+	if k.Type == HOTP {
+		query.Set("counter", strconv.FormatUint(k.Counter, 10))
+	} else {
+		query.Set("period", strconv.Itoa(k.Period))
+	}
+	u := url.URL{Scheme: schemeOTPAuth, Host: k.Type, Path: "/" + label, RawQuery: query.Encode()}
+	return u.String()
+}
+
+// Поля сообщения MigrationPayload экспорта Google Authenticator.
+const (
+	migrationParameters = 1
+	parameterSecret     = 1
+	parameterName       = 2
+	parameterIssuer     = 3
+	parameterAlgorithm  = 4
+	parameterDigits     = 5
+	parameterType       = 6
+	parameterCounter    = 7
+)
+
+// ParseMigration - разбирает ссылку otpauth-migration://offline?data=... экспорта Google Authenticator.
+// Параметр data - сообщение protobuf MigrationPayload в кодировке base64. Экспорт большого количества ключей
+// разбивается на несколько ссылок, каждая разбирается отдельно.
+func ParseMigration(raw string) ([]Key, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || !strings.EqualFold(u.Scheme, schemeMigration) {
+		return nil, ErrURI
+	}
+	// знак "+" в неэкранированной ссылке при разборе параметров превращается в пробел
+	data := strings.ReplaceAll(u.Query().Get("data"), " ", "+")
+	payload, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		if payload, err = base64.RawStdEncoding.DecodeString(strings.TrimRight(data, "=")); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrURI, err)
+		}
+	}
+
+	var keys []Key
+	err = consumeFields(payload, func(number protowire.Number, value interface{}) error {
+		parameters, ok := value.([]byte)
+		if number != migrationParameters || !ok {
+			return nil
+		}
+		key, err := parseMigrationParameters(parameters)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, key)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%w: no keys in migration payload", ErrURI)
+	}
+	return keys, nil
+}
+
+// parseMigrationParameters - разбирает сообщение OtpParameters с параметрами одного ключа.
+func parseMigrationParameters(message []byte) (Key, error) {
+	var secret []byte
+	var name string
+	key := Key{Type: TOTP, Algorithm: SHA1, Digits: DefaultDigits, Period: DefaultPeriod}
+	err := consumeFields(message, func(number protowire.Number, value interface{}) error {
+		bytes, _ := value.([]byte)
+		varint, _ := value.(uint64)
+		switch number {
+		case parameterSecret:
+			secret = bytes
+		case parameterName:
+			name = string(bytes)
+		case parameterIssuer:
+			key.Issuer = string(bytes)
+		case parameterAlgorithm:
+			switch varint {
+			case 0, 1:
+				key.Algorithm = SHA1
+			case 2:
+				key.Algorithm = SHA256
+			case 3:
+				key.Algorithm = SHA512
+			default:
+				return ErrAlgorithm
+			}
+		case parameterDigits:
+			switch varint {
+			case 0, 1:
+				key.Digits = 6
+			case 2:
+				key.Digits = 8
+			default:
+				return ErrDigits
+			}
+		case parameterType:
+			switch varint {
+			case 0, 2:
+				key.Type = TOTP
+			case 1:
+				key.Type = HOTP
+			default:
+				return ErrType
+			}
+		case parameterCounter:
+			key.Counter = varint
+		}
+		return nil
+	})
+	if err != nil {
+		return Key{}, err
+	}
+
+	// имя может содержать сервис в виде "Issuer:account"
+	if issuer, account, ok := strings.Cut(name, ":"); ok {
+		if key.Issuer == "" {
+			key.Issuer = issuer
+		}
+		name = account
+	}
+	key.Account = name
+	key.Secret = secretEncoding.EncodeToString(secret)
+	return key.Normalize()
+}
+
+// consumeFields - перебирает поля сообщения protobuf: поля длины передаются в fn как []byte, числа - как uint64.
+func consumeFields(message []byte, fn func(number protowire.Number, value interface{}) error) error {
+	for len(message) > 0 {
+		number, typ, n := protowire.ConsumeTag(message)
+		if n < 0 {
+			return fmt.Errorf("%w: %v", ErrURI, protowire.ParseError(n))
+		}
+		message = message[n:]
+
+		var value interface{}
+		switch typ {
+		case protowire.BytesType:
+			var bytes []byte
+			bytes, n = protowire.ConsumeBytes(message)
+			value = bytes
+		case protowire.VarintType:
+			var varint uint64
+			varint, n = protowire.ConsumeVarint(message)
+			value = varint
+		default:
+			n = protowire.ConsumeFieldValue(number, typ, message)
+		}
+		if n < 0 {
+			return fmt.Errorf("%w: %v", ErrURI, protowire.ParseError(n))
+		}
+		message = message[n:]
+		if value == nil {
+			continue
+		}
+		if err := fn(number, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}